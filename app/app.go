@@ -382,6 +382,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// create evidence Keeper for to register the IBC light client misbehaviour evidence route
@@ -4,6 +4,7 @@ package params
 const (
 	DefaultWeightMsgCreateFixedPriceAuction int = 20
 	DefaultWeightMsgCreateBatchAuction      int = 20
+	DefaultWeightMsgCreateDutchAuction      int = 20
 	DefaultWeightMsgCancelAuction           int = 15
 	DefaultWeightMsgPlaceBid                int = 80
 )
@@ -13,17 +13,43 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	// Get all auctions from the store and execute operations depending on auction status.
-	for _, auction := range k.GetAuctions(ctx) {
-		switch auction.GetStatus() {
-		case types.AuctionStatusStandBy:
-			k.ExecuteStandByStatus(ctx, auction)
-		case types.AuctionStatusStarted:
-			k.ExecuteStartedStatus(ctx, auction)
-		case types.AuctionStatusVesting:
-			k.ExecuteVestingStatus(ctx, auction)
-		default:
+	// Get only the auctions that are due in this block from the time queues.
+	// They are all fetched before any execution, so that an auction is executed at most once per block.
+	auctionsToStart := k.GetAuctionsToStart(ctx, ctx.BlockTime())
+	auctionsToClose := k.GetAuctionsToClose(ctx, ctx.BlockTime())
+	auctionsToSettle := k.GetAuctionsByStatus(ctx, types.AuctionStatusSettling)
+	auctionsToRelease := k.GetAuctionsToRelease(ctx, ctx.BlockTime())
+	auctionsToReleaseToBidders := k.GetAuctionsToReleaseToBidders(ctx, ctx.BlockTime())
+
+	// Each auction is executed in isolation, so that a failed auction is marked as failed
+	// without affecting the other auctions and halting the chain.
+	for _, auction := range auctionsToStart {
+		k.ExecuteIsolated(ctx, auction, k.ExecuteStandByStatus)
+	}
+
+	for _, auction := range auctionsToClose {
+		k.ExecuteIsolated(ctx, auction, k.ExecuteStartedStatus)
+	}
+
+	// The auctions that are settling pay out the next bidders; an auction that starts settling
+	// in this block is not included, so that its first payout starts from the next block.
+	for _, auction := range auctionsToSettle {
+		k.ExecuteIsolated(ctx, auction, k.ExecuteSettlingStatus)
+	}
+
+	for _, auction := range auctionsToRelease {
+		if auction.GetStatus() != types.AuctionStatusVesting {
+			continue
+		}
+		k.ExecuteIsolated(ctx, auction, k.ExecuteVestingStatus)
+	}
+
+	// The auction is read again since its status may be updated by the executions above.
+	for _, auction := range auctionsToReleaseToBidders {
+		auction, found := k.GetAuction(ctx, auction.GetId())
+		if !found || auction.GetStatus() == types.AuctionStatusFailed {
 			continue
 		}
+		k.ExecuteIsolated(ctx, auction, k.ReleaseBidderVestingCoins)
 	}
 }
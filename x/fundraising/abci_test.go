@@ -0,0 +1,70 @@
+package fundraising_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tendermint/fundraising/app"
+	"github.com/tendermint/fundraising/testutil/simapp"
+	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// BenchmarkBeginBlocker measures the per-block cost of BeginBlocker while finished auctions pile up.
+// The cost is expected to stay constant regardless of the number of finished auctions,
+// since only the auctions that are due in the block are read from the store.
+func BenchmarkBeginBlocker(b *testing.B) {
+	for _, numFinished := range []int{100, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("finished=%d", numFinished), func(b *testing.B) {
+			a := simapp.New(app.DefaultNodeHome)
+			ctx := a.BaseApp.NewContext(false, tmproto.Header{})
+			ctx = ctx.WithBlockTime(types.MustParseRFC3339("2022-06-01T00:00:00Z"))
+			k := a.FundraisingKeeper
+
+			for i := 1; i <= numFinished; i++ {
+				k.SetAuction(ctx, newBenchmarkAuction(uint64(i), types.AuctionStatusFinished))
+			}
+
+			// Auctions that are not due yet
+			for i := numFinished + 1; i <= numFinished+10; i++ {
+				k.SetAuction(ctx, newBenchmarkAuction(uint64(i), types.AuctionStatusStarted))
+			}
+			k.SetAuctionId(ctx, uint64(numFinished+10))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				fundraising.BeginBlocker(ctx, k)
+			}
+		})
+	}
+}
+
+func newBenchmarkAuction(id uint64, status types.AuctionStatus) *types.FixedPriceAuction {
+	sellingCoin := sdk.NewInt64Coin("denom1", 1_000_000_000)
+	return types.NewFixedPriceAuction(
+		types.NewBaseAuction(
+			id,
+			types.AuctionTypeFixedPrice,
+			sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+			types.SellingReserveAddress(id).String(),
+			types.PayingReserveAddress(id).String(),
+			sdk.OneDec(),
+			sellingCoin,
+			"denom2",
+			types.VestingReserveAddress(id).String(),
+			[]types.VestingSchedule{},
+			types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+			[]time.Time{types.MustParseRFC3339("2023-01-01T00:00:00Z")},
+			status,
+			false,
+			false,
+			sdk.ZeroInt(),
+		),
+		sellingCoin,
+	)
+}
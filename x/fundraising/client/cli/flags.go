@@ -11,6 +11,7 @@ const (
 	FlagAuctionType   = "type"
 	FlagBidderAddr    = "bidder-addr"
 	FlagIsMatched     = "is-matched"
+	FlagTickSize      = "tick-size"
 )
 
 // flagSetAuctions returns a set of defined flags to query the auctions.
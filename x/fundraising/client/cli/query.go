@@ -37,6 +37,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		NewQueryAllowedBiddersCmd(),
 		NewQueryBidsCmd(),
 		NewQueryVestingsCmd(),
+		NewQuerySimulateBatchMatchCmd(),
+		NewQueryOrderBookCmd(),
+		NewQueryAuctionSettlementCmd(),
+		NewQueryBidderSettlementsCmd(),
+		NewQueryAuctionFailureCmd(),
+		NewQueryBidderVestingsCmd(),
+		NewQueryAllocationClaimsCmd(),
+		NewQueryAllocationClaimCmd(),
+		NewQueryBidCommitmentsCmd(),
+		NewQueryBidderAggregateCmd(),
 	)
 
 	return cmd
@@ -89,7 +99,7 @@ $ %s query %s auctions
 $ %s query %s auctions --status AUCTION_STATUS_STANDBY
 $ %s query %s auctions --type AUCTION_TYPE_FIXED_PRICE
 
-Auction statuses: AUCTION_STATUS_STANDBY, AUCTION_STATUS_STARTED, AUCTION_STATUS_VESTING, AUCTION_STATUS_FINISHED, and AUCTION_STATUS_CANCELLED
+Auction statuses: AUCTION_STATUS_STANDBY, AUCTION_STATUS_STARTED, AUCTION_STATUS_VESTING, AUCTION_STATUS_FINISHED, AUCTION_STATUS_CANCELLED, AUCTION_STATUS_FAILED, AUCTION_STATUS_FAILED_SOFT_CAP, and AUCTION_STATUS_SETTLING
 Auction types: AUCTION_TYPE_FIXED_PRICE and AUCTION_TYPE_ENGLISH
 `,
 				version.AppName, types.ModuleName,
@@ -378,3 +388,512 @@ $ %s query %s vestings 1
 
 	return cmd
 }
+
+func NewQuerySimulateBatchMatchCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "simulate-batch-match [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the matching of the batch auction with the current bids",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the matching of the batch auction with the current bids.
+It returns the matched price, the matched amount and the number of the matched bids that the auction would have if it ended now.
+The allocated amount and the refund amount of the bidder are returned when the bidder is provided.
+Example:
+$ %s query %s simulate-batch-match 1
+$ %s query %s simulate-batch-match 1 --bidder-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidderAddr, _ := cmd.Flags().GetString(FlagBidderAddr)
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySimulateBatchMatchRequest{
+				AuctionId: auctionId,
+				Bidder:    bidderAddr,
+			}
+
+			resp, err := queryClient.SimulateBatchMatch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagBidderAddr, "", "The bech32 address of the bidder account")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryOrderBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the order book of the batch auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the order book of the batch auction.
+The bids are aggregated into price levels in descending order of the price with the cumulative demand of the selling coin.
+The bid prices are rounded down to a multiple of the tick size if it is provided.
+Example:
+$ %s query %s order-book 1
+$ %s query %s order-book 1 --tick-size 0.1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tickSize, _ := cmd.Flags().GetString(FlagTickSize)
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAuctionOrderBookRequest{
+				AuctionId:  auctionId,
+				TickSize:   tickSize,
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.AuctionOrderBook(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagTickSize, "", "The price tick that the bid prices are bucketed into")
+	flags.AddPaginationFlagsToCmd(cmd, "order-book")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryAuctionSettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlement [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the settlement record of the closed auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the settlement record of the closed auction.
+The record holds the final price, the total sold, raised and refunded amounts, the number of winners and when the auction is closed.
+The settlement cursor is also shown while the auction is settling over multiple blocks.
+Example:
+$ %s query %s settlement 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAuctionSettlementRequest{
+				AuctionId: auctionId,
+			}
+
+			resp, err := queryClient.AuctionSettlement(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryBidderSettlementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bidder-settlements [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the settlement records of all bidders for the closed auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the settlement records of all bidders for the closed auction.
+Example:
+$ %s query %s bidder-settlements 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBidderSettlementsRequest{
+				AuctionId:  auctionId,
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.BidderSettlements(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bidder-settlements")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryAuctionFailureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failure [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the failure record of the failed auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the failure record of the failed auction.
+The record holds the status of the auction when the execution failed and the reason.
+Example:
+$ %s query %s failure 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAuctionFailureRequest{
+				AuctionId: auctionId,
+			}
+
+			resp, err := queryClient.AuctionFailure(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryBidderVestingsCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "bidder-vestings [bidder]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending vesting releases of the bidder across all auctions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vesting queues of the bidder that are not released yet across all auctions.
+Example:
+$ %s query %s bidder-vestings %ss1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidderAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBidderVestingsRequest{
+				Bidder:     bidderAddr.String(),
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.BidderVestings(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bidder-vestings")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryAllocationClaimsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocation-claims [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all allocation claims of the auction that are not claimed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all allocation claims of the auction in claim mode that are not claimed yet.
+Example:
+$ %s query %s allocation-claims 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.AllocationClaims(cmd.Context(), &types.QueryAllocationClaimsRequest{
+				AuctionId:  auctionId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "allocation-claims")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryAllocationClaimCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "allocation-claim [auction-id] [bidder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the allocation claim of the bidder for the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the allocated selling coin and the refunded paying coin of the bidder for the auction in claim mode.
+Example:
+$ %s query %s allocation-claim 1 %ss1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			bidderAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.AllocationClaim(cmd.Context(), &types.QueryAllocationClaimRequest{
+				AuctionId: auctionId,
+				Bidder:    bidderAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryBidCommitmentsCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "bid-commitments [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all sealed bid commitments of the auction that are not revealed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all commitments of the sealed bid auction that are not revealed yet.
+Example:
+$ %s query %s bid-commitments 1
+$ %s query %s bid-commitments 1 --bidder-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidderAddr, _ := cmd.Flags().GetString(FlagBidderAddr)
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.BidCommitments(cmd.Context(), &types.QueryBidCommitmentsRequest{
+				AuctionId:  auctionId,
+				Bidder:     bidderAddr,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagBidderAddr, "", "The bech32 address of the bidder account")
+	flags.AddPaginationFlagsToCmd(cmd, "bid-commitments")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryBidderAggregateCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "bidder-aggregate [auction-id] [bidder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the running totals of the bids of the bidder for the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total reserved paying amount, the total requested selling amount and the number of the bids that the bidder placed for the auction.
+Example:
+$ %s query %s bidder-aggregate 1 %ss1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction-id %s is not valid", args[0])
+			}
+
+			bidderAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.BidderAggregate(cmd.Context(), &types.QueryBidderAggregateRequest{
+				AuctionId: auctionId,
+				Bidder:    bidderAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -31,9 +32,18 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateFixedPriceAuctionCmd(),
 		NewCreateBatchAuctionCmd(),
+		NewCreateDutchAuctionCmd(),
 		NewCancelAuctionCmd(),
+		NewClaimVestedCmd(),
+		NewClaimAllocationCmd(),
 		NewPlaceBidCmd(),
 		NewModifyBidCmd(),
+		NewCancelBidCmd(),
+		NewCommitBidCmd(),
+		NewRevealBidCmd(),
+		NewAddAllowedBiddersCmd(),
+		NewUpdateAllowedBidderCmd(),
+		NewRemoveAllowedBidderCmd(),
 	)
 	if keeper.EnableAddAllowedBidder {
 		cmd.AddCommand(NewAddAllowedBidderCmd())
@@ -77,7 +87,17 @@ Where auction.json contains:
     }
   ],
   "start_time": "2021-11-01T00:00:00Z",
-  "end_time": "2021-12-01T00:00:00Z"
+  "end_time": "2021-12-01T00:00:00Z",
+  "auctioneer_managed_allowlist": false,
+  "open_bidding": false,
+  "default_max_bid_amount": "0",
+  "selling_basket": [],
+  "paying_coin_rates": [],
+  "min_raise_amount": "0",
+  "close_when_sold_out": false,
+  "bidder_vesting_schedules": [],
+  "linear_vesting_schedule": null,
+  "claim_mode": false
 }
 
 Description of the parameters:
@@ -88,6 +108,16 @@ Description of the parameters:
 [vesting_schedules]: the vesting schedules that release the paying coins to the auctioneer
 [start_time]: the start time of the auction
 [end_time]: the end time of the auction
+[auctioneer_managed_allowlist]: whether the auctioneer manages the allowed bidders of the auction; if false, an external module manages them
+[open_bidding]: whether any address can place a bid for the auction without being an allowed bidder
+[default_max_bid_amount]: the maximum bid amount per bidder for the open bidding auction; it must be 0 if open_bidding is false
+[selling_basket]: the additional coins sold together with the selling coin in the fixed ratio of their amounts to the selling amount (optional)
+[paying_coin_rates]: the additional paying coin denoms that bidders can use and their fixed amounts of the paying coin denom per unit (optional)
+[min_raise_amount]: the minimum amount of the paying coin denom that the auction must raise; otherwise all the coins are returned and the auction fails (optional)
+[close_when_sold_out]: whether the auction is closed at the next block once the selling coin is sold out (optional)
+[bidder_vesting_schedules]: the vesting schedules that release the allocated selling coin to the bidders (optional)
+[linear_vesting_schedule]: the start_time, end_time and cliff_time of the schedule that vests the paying coin linearly to be claimed by the auctioneer; it cannot be used with vesting_schedules (optional)
+[claim_mode]: whether the allocated selling coin and the refunded paying coin are claimed by the bidders with claim-allocation instead of being distributed when the auction closes (optional)
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -111,6 +141,16 @@ Description of the parameters:
 				auction.VestingSchedules,
 				auction.StartTime,
 				auction.EndTime,
+				auction.AuctioneerManagedAllowlist,
+				auction.OpenBidding,
+				auction.DefaultMaxBidAmount,
+				auction.SellingBasket,
+				auction.PayingCoinRates,
+				auction.MinRaiseAmount,
+				auction.CloseWhenSoldOut,
+				auction.BidderVestingSchedules,
+				auction.LinearVestingSchedule,
+				auction.ClaimMode,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -156,7 +196,18 @@ Where auction.json contains:
   "max_extended_round": 2,
   "extended_round_rate": "0.150000000000000000",
   "start_time": "2022-02-01T00:00:00Z",
-  "end_time": "2022-06-20T00:00:00Z"
+  "end_time": "2022-06-20T00:00:00Z",
+  "auctioneer_managed_allowlist": false,
+  "open_bidding": false,
+  "default_max_bid_amount": "0",
+  "paying_coin_rates": [],
+  "min_raise_amount": "0",
+  "bidder_vesting_schedules": [],
+  "linear_vesting_schedule": null,
+  "claim_mode": false,
+  "sealed_bid_config": null,
+  "partial_fill_mode": "",
+  "pricing_rule": ""
 }
 
 Description of the parameters:
@@ -169,6 +220,17 @@ Description of the parameters:
 [extended_round_rate]: the rate that determines if the auction needs to run another round
 [start_time]: the start time of the auction
 [end_time]: the end time of the auction
+[auctioneer_managed_allowlist]: whether the auctioneer manages the allowed bidders of the auction; if false, an external module manages them
+[open_bidding]: whether any address can place a bid for the auction without being an allowed bidder
+[default_max_bid_amount]: the maximum bid amount per bidder for the open bidding auction; it must be 0 if open_bidding is false
+[paying_coin_rates]: the additional paying coin denoms that bidders can use and their fixed amounts of the paying coin denom per unit (optional)
+[min_raise_amount]: the minimum amount of the paying coin denom that the auction must raise; otherwise all the coins are returned and the auction fails (optional)
+[bidder_vesting_schedules]: the vesting schedules that release the allocated selling coin to the bidders (optional)
+[linear_vesting_schedule]: the start_time, end_time and cliff_time of the schedule that vests the paying coin linearly to be claimed by the auctioneer; it cannot be used with vesting_schedules (optional)
+[claim_mode]: whether the allocated selling coin and the refunded paying coin are claimed by the bidders with claim-allocation instead of being distributed when the auction closes (optional)
+[sealed_bid_config]: the reveal_period before the end time and the unrevealed_penalty_rate of the deposit for the sealed bid auction, e.g. {"reveal_period": "24h", "unrevealed_penalty_rate": "0.1"}; bids are committed with commit-bid and revealed with reveal-bid (optional)
+[partial_fill_mode]: how the bids at the matched price are filled when the remaining selling coin can't fill them fully; pro-rata (pr) or time-priority (tp). If empty, the matched price is raised until all of them are filled fully (optional)
+[pricing_rule]: the price that the winning bids pay; uniform (u) for the matched price or pay-as-bid (pab) for their own bid prices. If empty, the uniform pricing rule is used (optional)
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -184,6 +246,28 @@ Description of the parameters:
 				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
 			}
 
+			var sealedBidConfig *types.SealedBidConfig
+			if auction.SealedBidConfig != nil {
+				revealPeriod, err := time.ParseDuration(auction.SealedBidConfig.RevealPeriod)
+				if err != nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse reveal period due to %v", err)
+				}
+				sealedBidConfig = &types.SealedBidConfig{
+					RevealPeriod:          revealPeriod,
+					UnrevealedPenaltyRate: auction.SealedBidConfig.UnrevealedPenaltyRate,
+				}
+			}
+
+			partialFillMode, err := ParsePartialFillMode(auction.PartialFillMode)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse partial fill mode due to %v", err)
+			}
+
+			pricingRule, err := ParsePricingRule(auction.PricingRule)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse pricing rule due to %v", err)
+			}
+
 			msg := types.NewMsgCreateBatchAuction(
 				clientCtx.GetFromAddress().String(),
 				auction.StartPrice,
@@ -195,6 +279,129 @@ Description of the parameters:
 				auction.ExtendedRoundRate,
 				auction.StartTime,
 				auction.EndTime,
+				auction.AuctioneerManagedAllowlist,
+				auction.OpenBidding,
+				auction.DefaultMaxBidAmount,
+				auction.PayingCoinRates,
+				auction.MinRaiseAmount,
+				auction.BidderVestingSchedules,
+				auction.LinearVestingSchedule,
+				auction.ClaimMode,
+				sealedBidConfig,
+				partialFillMode,
+				pricingRule,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateDutchAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-dutch-auction [file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a dutch auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a dutch auction.
+The auction details must be provided through a JSON file. 
+		
+Example:
+$ %s tx %s create-dutch-auction <path/to/auction.json> --from mykey 
+
+Where auction.json contains:
+
+{
+  "start_price": "2.000000000000000000",
+  "floor_price": "0.500000000000000000",
+  "price_decay_step": "0.100000000000000000",
+  "price_decay_period": "1h",
+  "selling_coin": {
+    "denom": "denom1",
+    "amount": "1000000000000"
+  },
+  "paying_coin_denom": "denom2",
+  "vesting_schedules": [
+    {
+      "release_time": "2023-06-01T00:00:00Z",
+      "weight": "0.500000000000000000"
+    },
+    {
+      "release_time": "2023-12-01T00:00:00Z",
+      "weight": "0.500000000000000000"
+    }
+  ],
+  "start_time": "2022-02-01T00:00:00Z",
+  "end_time": "2022-02-03T00:00:00Z",
+  "auctioneer_managed_allowlist": false,
+  "open_bidding": false,
+  "default_max_bid_amount": "0",
+  "paying_coin_rates": [],
+  "bidder_vesting_schedules": [],
+  "linear_vesting_schedule": null,
+  "claim_mode": false
+}
+
+Description of the parameters:
+
+[start_price]: the start price of the selling coin that is proportional to the paying coin denom 
+[floor_price]: the lowest price that the auction price can decay to
+[price_decay_step]: the amount of price that decays every price decay period
+[price_decay_period]: the period of time that the price decays by the price decay step
+[selling_coin]: the selling amount of coin for the auction
+[paying_coin_denom]: the paying coin denom that the auctioneer wants to exchange with
+[vesting_schedules]: the vesting schedules that release the paying coins to the auctioneer
+[start_time]: the start time of the auction
+[end_time]: the end time of the auction
+[auctioneer_managed_allowlist]: whether the auctioneer manages the allowed bidders of the auction; if false, an external module manages them
+[open_bidding]: whether any address can place a bid for the auction without being an allowed bidder
+[default_max_bid_amount]: the maximum bid amount per bidder for the open bidding auction; it must be 0 if open_bidding is false
+[paying_coin_rates]: the additional paying coin denoms that bidders can use and their fixed amounts of the paying coin denom per unit (optional)
+[bidder_vesting_schedules]: the vesting schedules that release the allocated selling coin to the bidders (optional)
+[linear_vesting_schedule]: the start_time, end_time and cliff_time of the schedule that vests the paying coin linearly to be claimed by the auctioneer; it cannot be used with vesting_schedules (optional)
+[claim_mode]: whether the allocated selling coin and the refunded paying coin are claimed by the bidders with claim-allocation instead of being distributed when the auction closes (optional)
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auction, err := ParseDutchAuctionRequest(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
+			}
+
+			priceDecayPeriod, err := time.ParseDuration(auction.PriceDecayPeriod)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse price decay period due to %v", err)
+			}
+
+			msg := types.NewMsgCreateDutchAuction(
+				clientCtx.GetFromAddress().String(),
+				auction.StartPrice,
+				auction.FloorPrice,
+				auction.PriceDecayStep,
+				priceDecayPeriod,
+				auction.SellingCoin,
+				auction.PayingCoinDenom,
+				auction.VestingSchedules,
+				auction.StartTime,
+				auction.EndTime,
+				auction.AuctioneerManagedAllowlist,
+				auction.OpenBidding,
+				auction.DefaultMaxBidAmount,
+				auction.PayingCoinRates,
+				auction.BidderVestingSchedules,
+				auction.LinearVestingSchedule,
+				auction.ClaimMode,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -245,6 +452,93 @@ $ %s tx %s cancel 1 --from mykey
 	return cmd
 }
 
+func NewClaimVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-vested [auction-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the vested paying coin of the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the paying coin vested so far by the linear vesting schedule of the auction with the id. 
+		
+Example:
+$ %s tx %s claim-vested 1 --from mykey 
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimVested(
+				clientCtx.GetFromAddress().String(),
+				auctionId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimAllocationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-allocation [auction-id] [bidder]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Claim the allocated selling coin and the refunded paying coin of the bidder",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the allocated selling coin and the refunded paying coin of the bidder for the auction in claim mode. 
+The claimed coins are always sent to the bidder. If the bidder is not given, the from address is used as the bidder.
+		
+Example:
+$ %s tx %s claim-allocation 1 --from mykey 
+$ %s tx %s claim-allocation 1 cosmos1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu --from mykey 
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress().String()
+			if len(args) > 1 {
+				bidder = args[1]
+			}
+
+			msg := types.NewMsgClaimAllocation(
+				clientCtx.GetFromAddress().String(),
+				auctionId,
+				bidder,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewPlaceBidCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bid [auction-id] [bid-type] [price] [coin]",
@@ -257,11 +551,13 @@ Bid Type Options:
 1. fixed-price (fp or f)
 2. batch-worth (bw or w) 
 3. batch-many  (bm or m)
+4. dutch       (d)
 
 Example:
 $ %s tx %s bid 1 fixed-price 0.55 100000000denom2 --from mykey 
 $ %s tx %s bid 1 batch-worth 0.55 100000000denom2 --from mykey 
 $ %s tx %s bid 1 batch-many 0.55 100000000denom1 --from mykey 
+$ %s tx %s bid 1 dutch 0.55 100000000denom2 --from mykey 
 $ %s tx %s bid 1 fp 0.55 100000000denom2 --from mykey 
 $ %s tx %s bid 1 bw 0.55 100000000denom2 --from mykey 
 $ %s tx %s bid 1 bm 0.55 100000000denom1 --from mykey 
@@ -270,6 +566,7 @@ Note:
 In case of placing a bid for a fixed price auction, you must provide [price] argument with the same price of the auction. 
 In case of placing a bid for a batch auction, there are two bid type options; batch-worth and batch-many, which you can find more information
 in our technical spec docs. https://github.com/tendermint/fundraising/blob/main/x/fundraising/spec/01_concepts.md
+In case of placing a bid for a dutch auction, [price] is the highest price you are willing to pay and the bid is filled at the current price of the auction.
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
@@ -277,6 +574,7 @@ in our technical spec docs. https://github.com/tendermint/fundraising/blob/main/
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -329,7 +627,8 @@ func NewModifyBidCmd() *cobra.Command {
 		Short: "Modify the bid",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Modify the bid with new price and coin.
-Either price or coin must be higher than the existing bid.
+Either price or coin must be different from the existing bid.
+The price or coin can be lower than the existing bid only before the bid cancellation cutoff of the batch auction.
 
 Example:
 $ %s tx %s bid 1 1 1.0 100000000denom2 --from mykey
@@ -380,6 +679,346 @@ $ %s tx %s bid 1 1 1.0 100000000denom2 --from mykey
 	return cmd
 }
 
+func NewCancelBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-bid [auction-id] [bid-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel the bid",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the bid for the batch auction and get the reserved paying coin refunded.
+The bid can't be cancelled in the final extended round of the auction or after the bid cancellation cutoff.
+
+Example:
+$ %s tx %s cancel-bid 1 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelBid(
+				auctionId,
+				clientCtx.GetFromAddress().String(),
+				bidId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCommitBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bid [auction-id] [bid-type] [price] [coin] [salt] [deposit]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Commit a sealed bid for the sealed bid auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit a sealed bid for the sealed bid batch auction with the deposit as collateral.
+Only the hash of the bid and the salt is submitted, so the price and coin stay hidden until the bid is revealed with reveal-bid
+in the reveal window before the end time of the auction. The same bid type, price, coin and salt must be given to reveal the bid.
+The deposit must cover the paying coin of the bid and the excess is refunded when the bid is revealed.
+A part of the deposit is forfeited at the unrevealed penalty rate of the auction if the bid is not revealed.
+
+Bid Type Options:
+1. batch-worth (bw or w) 
+2. batch-many  (bm or m)
+
+Example:
+$ %s tx %s commit-bid 1 batch-worth 0.55 100000000denom2 mysecretsalt 150000000denom2 --from mykey 
+$ %s tx %s commit-bid 1 batch-many 0.55 100000000denom1 mysecretsalt 60000000denom2 --from mykey 
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidType, err := ParseBidType(args[1])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[5])
+			if err != nil {
+				return err
+			}
+
+			commitment := types.BidCommitmentHash(auctionId, clientCtx.GetFromAddress(), bidType, price, coin, args[4])
+
+			msg := types.NewMsgCommitBid(
+				auctionId,
+				clientCtx.GetFromAddress().String(),
+				commitment,
+				deposit,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevealBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bid [auction-id] [commitment-id] [bid-type] [price] [coin] [salt]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Reveal the committed sealed bid",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal the sealed bid committed with commit-bid in the reveal window of the sealed bid auction.
+The bid type, price, coin and salt must be the same as the ones used to commit the bid.
+The revealed bid is placed with the commitment id as the bid id and takes part in the matching of the auction.
+
+Example:
+$ %s tx %s reveal-bid 1 1 batch-worth 0.55 100000000denom2 mysecretsalt --from mykey 
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			commitmentId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidType, err := ParseBidType(args[2])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(
+				auctionId,
+				clientCtx.GetFromAddress().String(),
+				commitmentId,
+				bidType,
+				price,
+				coin,
+				args[5],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddAllowedBiddersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-allowed-bidders [auction-id] [file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add allowed bidders for the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add allowed bidders for the auction.
+Only the auctioneer of the auction that is created with auctioneer_managed_allowlist can add allowed bidders.
+The allowed bidders must be provided through a JSON file.
+
+Example:
+$ %s tx %s add-allowed-bidders 1 <path/to/allowed_bidders.json> --from mykey
+
+Where allowed_bidders.json contains:
+
+[
+  {
+    "bidder": "cosmos1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu",
+    "max_bid_amount": "10000000000"
+  }
+]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			allowedBidders, err := ParseAllowedBidders(args[1])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[1], err)
+			}
+
+			msg := types.NewMsgAddAllowedBidders(
+				auctionId,
+				clientCtx.GetFromAddress().String(),
+				allowedBidders,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateAllowedBidderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-allowed-bidder [auction-id] [bidder] [max-bid-amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Update the maximum bid amount of the allowed bidder for the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the maximum bid amount of the allowed bidder for the auction.
+Only the auctioneer of the auction that is created with auctioneer_managed_allowlist can update the allowed bidder.
+
+Example:
+$ %s tx %s update-allowed-bidder 1 cosmos1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu 20000000000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidderAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxBidAmt, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "maximum bid amount must be a positive integer")
+			}
+
+			msg := types.NewMsgUpdateAllowedBidder(
+				auctionId,
+				clientCtx.GetFromAddress().String(),
+				bidderAddr.String(),
+				maxBidAmt,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveAllowedBidderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-allowed-bidder [auction-id] [bidder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the allowed bidder from the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the allowed bidder from the auction.
+Only the auctioneer of the auction that is created with auctioneer_managed_allowlist can remove the allowed bidder.
+The bidder can't be removed from a batch auction while the bidder has bids for the auction.
+
+Example:
+$ %s tx %s remove-allowed-bidder 1 cosmos1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidderAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveAllowedBidder(
+				auctionId,
+				clientCtx.GetFromAddress().String(),
+				bidderAddr.String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewAddAllowedBidderCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
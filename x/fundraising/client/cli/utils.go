@@ -14,12 +14,22 @@ import (
 
 // FixedPriceAuctionRequest defines CLI request for a fixed price auction.
 type FixedPriceAuctionRequest struct {
-	StartPrice       sdk.Dec                 `json:"start_price"`
-	SellingCoin      sdk.Coin                `json:"selling_coin"`
-	PayingCoinDenom  string                  `json:"paying_coin_denom"`
-	VestingSchedules []types.VestingSchedule `json:"vesting_schedules"`
-	StartTime        time.Time               `json:"start_time"`
-	EndTime          time.Time               `json:"end_time"`
+	StartPrice                 sdk.Dec                      `json:"start_price"`
+	SellingCoin                sdk.Coin                     `json:"selling_coin"`
+	PayingCoinDenom            string                       `json:"paying_coin_denom"`
+	VestingSchedules           []types.VestingSchedule      `json:"vesting_schedules"`
+	StartTime                  time.Time                    `json:"start_time"`
+	EndTime                    time.Time                    `json:"end_time"`
+	AuctioneerManagedAllowlist bool                         `json:"auctioneer_managed_allowlist"`
+	OpenBidding                bool                         `json:"open_bidding"`
+	DefaultMaxBidAmount        sdk.Int                      `json:"default_max_bid_amount"`
+	SellingBasket              sdk.Coins                    `json:"selling_basket"`
+	PayingCoinRates            sdk.DecCoins                 `json:"paying_coin_rates"`
+	MinRaiseAmount             sdk.Int                      `json:"min_raise_amount"`
+	CloseWhenSoldOut           bool                         `json:"close_when_sold_out"`
+	BidderVestingSchedules     []types.VestingSchedule      `json:"bidder_vesting_schedules"`
+	LinearVestingSchedule      *types.LinearVestingSchedule `json:"linear_vesting_schedule"`
+	ClaimMode                  bool                         `json:"claim_mode"`
 }
 
 // ParseFixedPriceAuctionRequest reads the file and parses FixedPriceAuctionRequest.
@@ -47,15 +57,32 @@ func (req FixedPriceAuctionRequest) String() string {
 
 // BatchAuctionRequest defines CLI request for an batch auction.
 type BatchAuctionRequest struct {
-	StartPrice        sdk.Dec                 `json:"start_price"`
-	MinBidPrice       sdk.Dec                 `json:"min_bid_price"`
-	SellingCoin       sdk.Coin                `json:"selling_coin"`
-	PayingCoinDenom   string                  `json:"paying_coin_denom"`
-	MaxExtendedRound  uint32                  `json:"max_extended_round"`
-	ExtendedRoundRate sdk.Dec                 `json:"extended_round_rate"`
-	VestingSchedules  []types.VestingSchedule `json:"vesting_schedules"`
-	StartTime         time.Time               `json:"start_time"`
-	EndTime           time.Time               `json:"end_time"`
+	StartPrice                 sdk.Dec                      `json:"start_price"`
+	MinBidPrice                sdk.Dec                      `json:"min_bid_price"`
+	SellingCoin                sdk.Coin                     `json:"selling_coin"`
+	PayingCoinDenom            string                       `json:"paying_coin_denom"`
+	MaxExtendedRound           uint32                       `json:"max_extended_round"`
+	ExtendedRoundRate          sdk.Dec                      `json:"extended_round_rate"`
+	VestingSchedules           []types.VestingSchedule      `json:"vesting_schedules"`
+	StartTime                  time.Time                    `json:"start_time"`
+	EndTime                    time.Time                    `json:"end_time"`
+	AuctioneerManagedAllowlist bool                         `json:"auctioneer_managed_allowlist"`
+	OpenBidding                bool                         `json:"open_bidding"`
+	DefaultMaxBidAmount        sdk.Int                      `json:"default_max_bid_amount"`
+	PayingCoinRates            sdk.DecCoins                 `json:"paying_coin_rates"`
+	MinRaiseAmount             sdk.Int                      `json:"min_raise_amount"`
+	BidderVestingSchedules     []types.VestingSchedule      `json:"bidder_vesting_schedules"`
+	LinearVestingSchedule      *types.LinearVestingSchedule `json:"linear_vesting_schedule"`
+	ClaimMode                  bool                         `json:"claim_mode"`
+	SealedBidConfig            *SealedBidConfigRequest      `json:"sealed_bid_config"`
+	PartialFillMode            string                       `json:"partial_fill_mode"`
+	PricingRule                string                       `json:"pricing_rule"`
+}
+
+// SealedBidConfigRequest defines CLI request for the sealed bid configuration of a batch auction.
+type SealedBidConfigRequest struct {
+	RevealPeriod          string  `json:"reveal_period"`
+	UnrevealedPenaltyRate sdk.Dec `json:"unrevealed_penalty_rate"`
 }
 
 // ParseBatchAuctionRequest reads the file and parses BatchAuctionRequest.
@@ -81,6 +108,63 @@ func (req BatchAuctionRequest) String() string {
 	return string(result)
 }
 
+// DutchAuctionRequest defines CLI request for a dutch auction.
+type DutchAuctionRequest struct {
+	StartPrice                 sdk.Dec                      `json:"start_price"`
+	FloorPrice                 sdk.Dec                      `json:"floor_price"`
+	PriceDecayStep             sdk.Dec                      `json:"price_decay_step"`
+	PriceDecayPeriod           string                       `json:"price_decay_period"`
+	SellingCoin                sdk.Coin                     `json:"selling_coin"`
+	PayingCoinDenom            string                       `json:"paying_coin_denom"`
+	VestingSchedules           []types.VestingSchedule      `json:"vesting_schedules"`
+	StartTime                  time.Time                    `json:"start_time"`
+	EndTime                    time.Time                    `json:"end_time"`
+	AuctioneerManagedAllowlist bool                         `json:"auctioneer_managed_allowlist"`
+	OpenBidding                bool                         `json:"open_bidding"`
+	DefaultMaxBidAmount        sdk.Int                      `json:"default_max_bid_amount"`
+	PayingCoinRates            sdk.DecCoins                 `json:"paying_coin_rates"`
+	BidderVestingSchedules     []types.VestingSchedule      `json:"bidder_vesting_schedules"`
+	LinearVestingSchedule      *types.LinearVestingSchedule `json:"linear_vesting_schedule"`
+	ClaimMode                  bool                         `json:"claim_mode"`
+}
+
+// ParseDutchAuctionRequest reads the file and parses DutchAuctionRequest.
+func ParseDutchAuctionRequest(fileName string) (req DutchAuctionRequest, err error) {
+	contents, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return req, err
+	}
+
+	if err = json.Unmarshal(contents, &req); err != nil {
+		return req, err
+	}
+
+	return req, nil
+}
+
+// String returns a human readable string representation of the request.
+func (req DutchAuctionRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+// ParseAllowedBidders reads the file and parses the list of types.AllowedBidder.
+func ParseAllowedBidders(fileName string) (allowedBidders []types.AllowedBidder, err error) {
+	contents, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return allowedBidders, err
+	}
+
+	if err = json.Unmarshal(contents, &allowedBidders); err != nil {
+		return allowedBidders, err
+	}
+
+	return allowedBidders, nil
+}
+
 // ParseBidType parses bid type string and returns types.BidType.
 func ParseBidType(s string) (types.BidType, error) {
 	switch strings.ToLower(s) {
@@ -90,6 +174,34 @@ func ParseBidType(s string) (types.BidType, error) {
 		return types.BidTypeBatchWorth, nil
 	case "batch-many", "bm", "m":
 		return types.BidTypeBatchMany, nil
+	case "dutch", "d":
+		return types.BidTypeDutch, nil
 	}
 	return 0, fmt.Errorf("invalid bid type: %s", s)
 }
+
+// ParsePartialFillMode parses partial fill mode string and returns types.PartialFillMode.
+// An empty string means the bids at the matched price are never filled partially.
+func ParsePartialFillMode(s string) (types.PartialFillMode, error) {
+	switch strings.ToLower(s) {
+	case "", "none":
+		return types.PartialFillModeNil, nil
+	case "pro-rata", "pr":
+		return types.PartialFillModeProRata, nil
+	case "time-priority", "tp":
+		return types.PartialFillModeTimePriority, nil
+	}
+	return 0, fmt.Errorf("invalid partial fill mode: %s", s)
+}
+
+// ParsePricingRule parses pricing rule string and returns types.PricingRule.
+// An empty string means the uniform pricing rule.
+func ParsePricingRule(s string) (types.PricingRule, error) {
+	switch strings.ToLower(s) {
+	case "", "uniform", "u":
+		return types.PricingRuleUniform, nil
+	case "pay-as-bid", "pab":
+		return types.PricingRulePayAsBid, nil
+	}
+	return 0, fmt.Errorf("invalid pricing rule: %s", s)
+}
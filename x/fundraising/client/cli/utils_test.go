@@ -92,7 +92,11 @@ func TestParseBatchAuction(t *testing.T) {
   "max_extended_round": 3,
   "extended_round_rate": "0.200000000000000000",
   "start_time": "2021-11-01T00:00:00Z",
-  "end_time": "2021-12-01T00:00:00Z"
+  "end_time": "2021-12-01T00:00:00Z",
+  "sealed_bid_config": {
+    "reveal_period": "24h",
+    "unrevealed_penalty_rate": "0.100000000000000000"
+  }
 }
 `)
 
@@ -121,6 +125,50 @@ func TestParseBatchAuction(t *testing.T) {
 	require.Equal(t, uint32(3), auction.MaxExtendedRound)
 	require.Equal(t, sdk.MustNewDecFromStr("0.2"), auction.ExtendedRoundRate)
 	require.EqualValues(t, expSchedules, auction.VestingSchedules)
+	require.Equal(t, "24h", auction.SealedBidConfig.RevealPeriod)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), auction.SealedBidConfig.UnrevealedPenaltyRate)
+}
+
+func TestParseDutchAuction(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "start_price": "2.000000000000000000",
+  "floor_price": "0.500000000000000000",
+  "price_decay_step": "0.100000000000000000",
+  "price_decay_period": "1h",
+  "selling_coin": {
+    "denom": "denom1",
+    "amount": "1000000000000"
+  },
+  "paying_coin_denom": "denom2",
+  "vesting_schedules": [
+    {
+      "release_time": "2022-01-01T00:00:00Z",
+      "weight": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-11-01T00:00:00Z",
+  "end_time": "2021-12-01T00:00:00Z"
+}
+`)
+
+	expSchedules := []types.VestingSchedule{
+		{
+			ReleaseTime: types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+			Weight:      sdk.MustNewDecFromStr("1.0"),
+		},
+	}
+
+	auction, err := cli.ParseDutchAuctionRequest(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, auction.String())
+	require.Equal(t, sdk.MustNewDecFromStr("2.0"), auction.StartPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), auction.FloorPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), auction.PriceDecayStep)
+	require.Equal(t, "1h", auction.PriceDecayPeriod)
+	require.Equal(t, sdk.NewInt64Coin("denom1", 1000000000000), auction.SellingCoin)
+	require.Equal(t, "denom2", auction.PayingCoinDenom)
+	require.EqualValues(t, expSchedules, auction.VestingSchedules)
 }
 
 func TestParseBidType(t *testing.T) {
@@ -137,6 +185,8 @@ func TestParseBidType(t *testing.T) {
 		{"batch-many", nil},
 		{"bm", nil},
 		{"m", nil},
+		{"dutch", nil},
+		{"d", nil},
 		{"fixedprice", fmt.Errorf("invalid bid type: %s", "fixedprice")},
 		{"batchworth", fmt.Errorf("invalid bid type: %s", "batchworth")},
 		{"batchmany", fmt.Errorf("invalid bid type: %s", "batchmany")},
@@ -149,3 +199,50 @@ func TestParseBidType(t *testing.T) {
 		}
 	}
 }
+
+func TestParsePartialFillMode(t *testing.T) {
+	for _, tc := range []struct {
+		partialFillMode string
+		expected        types.PartialFillMode
+		expectedErr     error
+	}{
+		{"", types.PartialFillModeNil, nil},
+		{"none", types.PartialFillModeNil, nil},
+		{"pro-rata", types.PartialFillModeProRata, nil},
+		{"pr", types.PartialFillModeProRata, nil},
+		{"time-priority", types.PartialFillModeTimePriority, nil},
+		{"TP", types.PartialFillModeTimePriority, nil},
+		{"prorata", 0, fmt.Errorf("invalid partial fill mode: %s", "prorata")},
+	} {
+		partialFillMode, err := cli.ParsePartialFillMode(tc.partialFillMode)
+		if tc.expectedErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, partialFillMode)
+		} else {
+			require.EqualError(t, err, tc.expectedErr.Error())
+		}
+	}
+}
+
+func TestParsePricingRule(t *testing.T) {
+	for _, tc := range []struct {
+		pricingRule string
+		expected    types.PricingRule
+		expectedErr error
+	}{
+		{"", types.PricingRuleUniform, nil},
+		{"uniform", types.PricingRuleUniform, nil},
+		{"u", types.PricingRuleUniform, nil},
+		{"pay-as-bid", types.PricingRulePayAsBid, nil},
+		{"PAB", types.PricingRulePayAsBid, nil},
+		{"discriminatory", 0, fmt.Errorf("invalid pricing rule: %s", "discriminatory")},
+	} {
+		pricingRule, err := cli.ParsePricingRule(tc.pricingRule)
+		if tc.expectedErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pricingRule)
+		} else {
+			require.EqualError(t, err, tc.expectedErr.Error())
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
@@ -31,13 +32,17 @@ func (k Keeper) CreateFixedPriceAuction(ctx sdk.Context, msg *types.MsgCreateFix
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exceed maximum number of vesting schedules")
 	}
 
+	if err := types.ValidateClaimMode(msg.ClaimMode, msg.BidderVestingSchedules); err != nil {
+		return nil, err
+	}
+
 	nextId := k.GetNextAuctionIdWithUpdate(ctx)
 
 	if err := k.PayCreationFee(ctx, msg.GetAuctioneer()); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to pay auction creation fee")
 	}
 
-	if err := k.ReserveSellingCoin(ctx, nextId, msg.GetAuctioneer(), msg.SellingCoin); err != nil {
+	if err := k.ReserveSellingCoin(ctx, nextId, msg.GetAuctioneer(), sdk.NewCoins(msg.SellingCoin).Add(msg.SellingBasket...)); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to reserve selling coin")
 	}
 
@@ -55,14 +60,25 @@ func (k Keeper) CreateFixedPriceAuction(ctx sdk.Context, msg *types.MsgCreateFix
 		msg.StartTime,
 		[]time.Time{msg.EndTime}, // it is an array data type to handle BatchAuction
 		types.AuctionStatusStandBy,
+		msg.AuctioneerManagedAllowlist,
+		msg.OpenBidding,
+		msg.DefaultMaxBidAmount,
 	)
 
+	_ = ba.SetSellingBasket(msg.SellingBasket)
+	_ = ba.SetPayingCoinRates(msg.PayingCoinRates)
+	_ = ba.SetMinRaiseAmount(msg.MinRaiseAmount)
+	_ = ba.SetBidderVestingSchedules(msg.BidderVestingSchedules)
+	_ = ba.SetLinearVestingSchedule(msg.LinearVestingSchedule)
+	_ = ba.SetClaimMode(msg.ClaimMode)
+
 	// Update status if the start time is already passed over the current time
 	if ba.ShouldAuctionStarted(ctx.BlockTime()) {
 		_ = ba.SetStatus(types.AuctionStatusStarted)
 	}
 
 	auction := types.NewFixedPriceAuction(ba, msg.SellingCoin)
+	auction.CloseWhenSoldOut = msg.CloseWhenSoldOut
 
 	// Call hook before storing an auction
 	k.BeforeFixedPriceAuctionCreated(
@@ -109,6 +125,26 @@ func (k Keeper) CreateFixedPriceAuction(ctx sdk.Context, msg *types.MsgCreateFix
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateAuction{
+		AuctionId:             nextId,
+		AuctionType:           types.AuctionTypeFixedPrice,
+		Auctioneer:            auction.GetAuctioneer().String(),
+		SellingReserveAddress: auction.GetSellingReserveAddress().String(),
+		PayingReserveAddress:  auction.GetPayingReserveAddress().String(),
+		VestingReserveAddress: auction.GetVestingReserveAddress().String(),
+		StartPrice:            auction.GetStartPrice(),
+		SellingCoin:           auction.GetSellingCoin(),
+		PayingCoinDenom:       auction.GetPayingCoinDenom(),
+		StartTime:             auction.GetStartTime(),
+		EndTime:               msg.EndTime,
+		AuctionStatus:         auction.GetStatus(),
+		SellingBasket:         auction.GetSellingBasket(),
+		PayingCoinRates:       auction.GetPayingCoinRates(),
+		MinRaiseAmount:        auction.GetMinRaiseAmount(),
+	}); err != nil {
+		return nil, err
+	}
+
 	return auction, nil
 }
 
@@ -123,6 +159,10 @@ func (k Keeper) CreateBatchAuction(ctx sdk.Context, msg *types.MsgCreateBatchAuc
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exceed maximum number of vesting schedules")
 	}
 
+	if err := types.ValidateClaimMode(msg.ClaimMode, msg.BidderVestingSchedules); err != nil {
+		return nil, err
+	}
+
 	if msg.MaxExtendedRound > types.MaxExtendedRound {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exceed maximum extended round")
 	}
@@ -133,7 +173,7 @@ func (k Keeper) CreateBatchAuction(ctx sdk.Context, msg *types.MsgCreateBatchAuc
 		return nil, sdkerrors.Wrap(err, "failed to pay auction creation fee")
 	}
 
-	if err := k.ReserveSellingCoin(ctx, nextId, msg.GetAuctioneer(), msg.SellingCoin); err != nil {
+	if err := k.ReserveSellingCoin(ctx, nextId, msg.GetAuctioneer(), sdk.NewCoins(msg.SellingCoin)); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to reserve selling coin")
 	}
 
@@ -153,8 +193,17 @@ func (k Keeper) CreateBatchAuction(ctx sdk.Context, msg *types.MsgCreateBatchAuc
 		msg.StartTime,
 		endTimes,
 		types.AuctionStatusStandBy,
+		msg.AuctioneerManagedAllowlist,
+		msg.OpenBidding,
+		msg.DefaultMaxBidAmount,
 	)
 
+	_ = ba.SetPayingCoinRates(msg.PayingCoinRates)
+	_ = ba.SetMinRaiseAmount(msg.MinRaiseAmount)
+	_ = ba.SetBidderVestingSchedules(msg.BidderVestingSchedules)
+	_ = ba.SetLinearVestingSchedule(msg.LinearVestingSchedule)
+	_ = ba.SetClaimMode(msg.ClaimMode)
+
 	// Update status if the start time is already passed the current time
 	if ba.ShouldAuctionStarted(ctx.BlockTime()) {
 		_ = ba.SetStatus(types.AuctionStatusStarted)
@@ -167,6 +216,9 @@ func (k Keeper) CreateBatchAuction(ctx sdk.Context, msg *types.MsgCreateBatchAuc
 		msg.MaxExtendedRound,
 		msg.ExtendedRoundRate,
 	)
+	auction.SealedBidConfig = msg.SealedBidConfig
+	auction.PartialFillMode = msg.PartialFillMode
+	auction.PricingRule = msg.PricingRule
 
 	// Call hook before storing an auction
 	k.BeforeBatchAuctionCreated(
@@ -218,9 +270,167 @@ func (k Keeper) CreateBatchAuction(ctx sdk.Context, msg *types.MsgCreateBatchAuc
 			sdk.NewAttribute(types.AttributeKeyMinBidPrice, auction.MinBidPrice.String()),
 			sdk.NewAttribute(types.AttributeKeyMaxExtendedRound, fmt.Sprint(auction.MaxExtendedRound)),
 			sdk.NewAttribute(types.AttributeKeyExtendedRoundRate, auction.ExtendedRoundRate.String()),
+			sdk.NewAttribute(types.AttributeKeyPricingRule, auction.PricingRule.String()),
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateAuction{
+		AuctionId:             nextId,
+		AuctionType:           types.AuctionTypeBatch,
+		Auctioneer:            auction.GetAuctioneer().String(),
+		SellingReserveAddress: auction.GetSellingReserveAddress().String(),
+		PayingReserveAddress:  auction.GetPayingReserveAddress().String(),
+		VestingReserveAddress: auction.GetVestingReserveAddress().String(),
+		StartPrice:            auction.GetStartPrice(),
+		SellingCoin:           auction.GetSellingCoin(),
+		PayingCoinDenom:       auction.GetPayingCoinDenom(),
+		StartTime:             auction.GetStartTime(),
+		EndTime:               msg.EndTime,
+		AuctionStatus:         auction.GetStatus(),
+		PayingCoinRates:       auction.GetPayingCoinRates(),
+		MinRaiseAmount:        auction.GetMinRaiseAmount(),
+		PricingRule:           auction.PricingRule,
+	}); err != nil {
+		return nil, err
+	}
+
+	return auction, nil
+}
+
+// CreateDutchAuction handles types.MsgCreateDutchAuction and create a dutch auction.
+// Note that the module is designed to delegate authorization to an external module to add allowed bidders for the auction.
+func (k Keeper) CreateDutchAuction(ctx sdk.Context, msg *types.MsgCreateDutchAuction) (types.AuctionI, error) {
+	if ctx.BlockTime().After(msg.EndTime) { // EndTime < CurrentTime
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end time must be set after the current time")
+	}
+
+	if len(msg.VestingSchedules) > types.MaxNumVestingSchedules {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exceed maximum number of vesting schedules")
+	}
+
+	if err := types.ValidateClaimMode(msg.ClaimMode, msg.BidderVestingSchedules); err != nil {
+		return nil, err
+	}
+
+	nextId := k.GetNextAuctionIdWithUpdate(ctx)
+
+	if err := k.PayCreationFee(ctx, msg.GetAuctioneer()); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to pay auction creation fee")
+	}
+
+	if err := k.ReserveSellingCoin(ctx, nextId, msg.GetAuctioneer(), sdk.NewCoins(msg.SellingCoin)); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to reserve selling coin")
+	}
+
+	ba := types.NewBaseAuction(
+		nextId,
+		types.AuctionTypeDutch,
+		msg.Auctioneer,
+		types.SellingReserveAddress(nextId).String(),
+		types.PayingReserveAddress(nextId).String(),
+		msg.StartPrice,
+		msg.SellingCoin,
+		msg.PayingCoinDenom,
+		types.VestingReserveAddress(nextId).String(),
+		msg.VestingSchedules,
+		msg.StartTime,
+		[]time.Time{msg.EndTime}, // it is an array data type to handle BatchAuction
+		types.AuctionStatusStandBy,
+		msg.AuctioneerManagedAllowlist,
+		msg.OpenBidding,
+		msg.DefaultMaxBidAmount,
+	)
+
+	_ = ba.SetPayingCoinRates(msg.PayingCoinRates)
+	_ = ba.SetBidderVestingSchedules(msg.BidderVestingSchedules)
+	_ = ba.SetLinearVestingSchedule(msg.LinearVestingSchedule)
+	_ = ba.SetClaimMode(msg.ClaimMode)
+
+	// Update status if the start time is already passed over the current time
+	if ba.ShouldAuctionStarted(ctx.BlockTime()) {
+		_ = ba.SetStatus(types.AuctionStatusStarted)
+	}
+
+	auction := types.NewDutchAuction(
+		ba,
+		msg.FloorPrice,
+		msg.PriceDecayStep,
+		msg.PriceDecayPeriod,
+		msg.SellingCoin,
+	)
+
+	// Call hook before storing an auction
+	k.BeforeDutchAuctionCreated(
+		ctx,
+		auction.Auctioneer,
+		auction.StartPrice,
+		auction.FloorPrice,
+		auction.PriceDecayStep,
+		auction.PriceDecayPeriod,
+		auction.SellingCoin,
+		auction.PayingCoinDenom,
+		auction.VestingSchedules,
+		auction.StartTime,
+		auction.EndTimes[0],
+	)
+
+	k.SetAuction(ctx, auction)
+
+	// Call hook after storing an auction
+	k.AfterDutchAuctionCreated(
+		ctx,
+		auction.Id,
+		auction.Auctioneer,
+		auction.StartPrice,
+		auction.FloorPrice,
+		auction.PriceDecayStep,
+		auction.PriceDecayPeriod,
+		auction.SellingCoin,
+		auction.PayingCoinDenom,
+		auction.VestingSchedules,
+		auction.StartTime,
+		auction.EndTimes[0],
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDutchAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(nextId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctioneerAddress, auction.GetAuctioneer().String()),
+			sdk.NewAttribute(types.AttributeKeySellingReserveAddress, auction.GetSellingReserveAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyPayingReserveAddress, auction.GetPayingReserveAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyStartPrice, auction.GetStartPrice().String()),
+			sdk.NewAttribute(types.AttributeKeySellingCoin, auction.GetSellingCoin().String()),
+			sdk.NewAttribute(types.AttributeKeyPayingCoinDenom, auction.GetPayingCoinDenom()),
+			sdk.NewAttribute(types.AttributeKeyVestingReserveAddress, auction.GetVestingReserveAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingSellingCoin, auction.RemainingSellingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, auction.GetStartTime().String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyAuctionStatus, auction.GetStatus().String()),
+			sdk.NewAttribute(types.AttributeKeyFloorPrice, auction.FloorPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyPriceDecayStep, auction.PriceDecayStep.String()),
+			sdk.NewAttribute(types.AttributeKeyPriceDecayPeriod, auction.PriceDecayPeriod.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateAuction{
+		AuctionId:             nextId,
+		AuctionType:           types.AuctionTypeDutch,
+		Auctioneer:            auction.GetAuctioneer().String(),
+		SellingReserveAddress: auction.GetSellingReserveAddress().String(),
+		PayingReserveAddress:  auction.GetPayingReserveAddress().String(),
+		VestingReserveAddress: auction.GetVestingReserveAddress().String(),
+		StartPrice:            auction.GetStartPrice(),
+		SellingCoin:           auction.GetSellingCoin(),
+		PayingCoinDenom:       auction.GetPayingCoinDenom(),
+		StartTime:             auction.GetStartTime(),
+		EndTime:               msg.EndTime,
+		AuctionStatus:         auction.GetStatus(),
+		PayingCoinRates:       auction.GetPayingCoinRates(),
+	}); err != nil {
+		return nil, err
+	}
+
 	return auction, nil
 }
 
@@ -240,23 +450,25 @@ func (k Keeper) CancelAuction(ctx sdk.Context, msg *types.MsgCancelAuction) erro
 		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the stand by auction can be cancelled")
 	}
 
-	sellingReserveAddr := auction.GetSellingReserveAddress()
 	sellingCoinDenom := auction.GetSellingCoin().Denom
-	spendableCoins := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
-	releaseCoin := sdk.NewCoin(sellingCoinDenom, spendableCoins.AmountOf(sellingCoinDenom))
 
 	// Release the selling coin back to the auctioneer
-	if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetAuctioneer(), sdk.NewCoins(releaseCoin)); err != nil {
+	if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
 		return sdkerrors.Wrap(err, "failed to release the selling coin")
 	}
 
 	// Call hook before cancelling the auction
 	k.BeforeAuctionCanceled(ctx, msg.AuctionId, msg.Auctioneer)
 
-	if auction.GetType() == types.AuctionTypeFixedPrice {
+	switch auction.GetType() {
+	case types.AuctionTypeFixedPrice:
 		fa := auction.(*types.FixedPriceAuction)
 		fa.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, sdk.ZeroInt())
 		auction = fa
+	case types.AuctionTypeDutch:
+		da := auction.(*types.DutchAuction)
+		da.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, sdk.ZeroInt())
+		auction = da
 	}
 
 	_ = auction.SetStatus(types.AuctionStatusCancelled)
@@ -269,6 +481,10 @@ func (k Keeper) CancelAuction(ctx sdk.Context, msg *types.MsgCancelAuction) erro
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelAuction{AuctionId: auction.GetId()}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -335,21 +551,223 @@ func (k Keeper) UpdateAllowedBidder(ctx sdk.Context, auctionId uint64, bidder sd
 	return nil
 }
 
+// validateMaxBidAmountOfBids validates that the maximum bid amount of the allowed bidder is not lower than
+// the total selling amount of the bids that the bidder has already placed for the auction,
+// so that the auctioneer can't lower the maximum bid amount below the bids of the bidder.
+// It is only used by the auctioneer's messages; an external module is free to set any maximum bid amount.
+func (k Keeper) validateMaxBidAmountOfBids(ctx sdk.Context, auctionId uint64, allowedBidder types.AllowedBidder) error {
+	aggregate, found := k.GetBidderAggregate(ctx, auctionId, allowedBidder.GetBidder())
+	if found && allowedBidder.MaxBidAmount.LT(aggregate.SellingAmount) {
+		return sdkerrors.Wrapf(types.ErrInvalidMaxBidAmount,
+			"maximum bid amount %s is lower than the total bid amount %s of the bidder", allowedBidder.MaxBidAmount, aggregate.SellingAmount)
+	}
+	return nil
+}
+
+// RemoveAllowedBidder is a function that is implemented for an external module.
+// An external module uses this function to remove particular allowed bidder from the auction.
+// In a batch auction, the bidder can't be removed while the bidder has bids for the auction,
+// since the bids are matched up to the maximum bid amount of the bidder.
+// Otherwise, the bids that the bidder has already placed remain, but the bidder can't place a new bid.
+// It doesn't have any auctioneer's verification logic because the module is fundamentally designed
+// to delegate full authorization to an external module.
+// It is up to an external module to freely add necessary verification and operations depending on their use cases.
+func (k Keeper) RemoveAllowedBidder(ctx sdk.Context, auctionId uint64, bidder sdk.AccAddress) error {
+	auction, found := k.GetAuction(ctx, auctionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d is not found", auctionId)
+	}
+
+	_, found = k.GetAllowedBidder(ctx, auctionId, bidder)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "bidder %s is not found", bidder.String())
+	}
+
+	if auction.GetType() == types.AuctionTypeBatch {
+		if aggregate, found := k.GetBidderAggregate(ctx, auctionId, bidder); found {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"bidder %s has %d bids for the batch auction; cancel the bids before removing the bidder", bidder.String(), aggregate.BidsCount)
+		}
+	}
+
+	// Call hook before removing the allowed bidder from the auction
+	k.BeforeAllowedBidderRemoved(ctx, auctionId, bidder)
+
+	k.DeleteAllowedBidder(ctx, auctionId, bidder)
+
+	return nil
+}
+
+// AddAllowedBiddersByAuctioneer handles types.MsgAddAllowedBidders and adds the allowed bidders
+// for the auction whose allowed bidders are managed by the auctioneer.
+func (k Keeper) AddAllowedBiddersByAuctioneer(ctx sdk.Context, msg *types.MsgAddAllowedBidders) error {
+	if _, err := k.validateAllowlistManager(ctx, msg.AuctionId, msg.GetAuctioneer()); err != nil {
+		return err
+	}
+
+	for _, ab := range msg.AllowedBidders {
+		if err := ab.Validate(); err != nil {
+			return err
+		}
+		if err := k.validateMaxBidAmountOfBids(ctx, msg.AuctionId, ab); err != nil {
+			return err
+		}
+	}
+
+	if err := k.AddAllowedBidders(ctx, msg.AuctionId, msg.AllowedBidders); err != nil {
+		return err
+	}
+
+	events := sdk.Events{}
+	for _, ab := range msg.AllowedBidders {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeAddAllowedBidders,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(msg.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, ab.Bidder),
+			sdk.NewAttribute(types.AttributeKeyMaxBidAmount, ab.MaxBidAmount.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	for _, ab := range msg.AllowedBidders {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAddAllowedBidder{
+			AuctionId:    msg.AuctionId,
+			Bidder:       ab.Bidder,
+			MaxBidAmount: ab.MaxBidAmount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateAllowedBidderByAuctioneer handles types.MsgUpdateAllowedBidder and updates the maximum bid amount
+// of the allowed bidder for the auction whose allowed bidders are managed by the auctioneer.
+func (k Keeper) UpdateAllowedBidderByAuctioneer(ctx sdk.Context, msg *types.MsgUpdateAllowedBidder) error {
+	if _, err := k.validateAllowlistManager(ctx, msg.AuctionId, msg.GetAuctioneer()); err != nil {
+		return err
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateMaxBidAmountOfBids(ctx, msg.AuctionId, types.NewAllowedBidder(bidderAddr, msg.MaxBidAmount)); err != nil {
+		return err
+	}
+
+	if err := k.UpdateAllowedBidder(ctx, msg.AuctionId, bidderAddr, msg.MaxBidAmount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateAllowedBidder,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(msg.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.Bidder),
+			sdk.NewAttribute(types.AttributeKeyMaxBidAmount, msg.MaxBidAmount.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateAllowedBidder{
+		AuctionId:    msg.AuctionId,
+		Bidder:       msg.Bidder,
+		MaxBidAmount: msg.MaxBidAmount,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveAllowedBidderByAuctioneer handles types.MsgRemoveAllowedBidder and removes the allowed bidder
+// from the auction whose allowed bidders are managed by the auctioneer.
+func (k Keeper) RemoveAllowedBidderByAuctioneer(ctx sdk.Context, msg *types.MsgRemoveAllowedBidder) error {
+	if _, err := k.validateAllowlistManager(ctx, msg.AuctionId, msg.GetAuctioneer()); err != nil {
+		return err
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return err
+	}
+
+	if err := k.RemoveAllowedBidder(ctx, msg.AuctionId, bidderAddr); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveAllowedBidder,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(msg.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.Bidder),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRemoveAllowedBidder{
+		AuctionId: msg.AuctionId,
+		Bidder:    msg.Bidder,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAllowlistManager validates that the auctioneer is allowed to manage
+// the allowed bidders of the auction that is not closed yet.
+func (k Keeper) validateAllowlistManager(ctx sdk.Context, auctionId uint64, auctioneer sdk.AccAddress) (types.AuctionI, error) {
+	auction, found := k.GetAuction(ctx, auctionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d is not found", auctionId)
+	}
+
+	if !auction.GetAuctioneer().Equals(auctioneer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the auctioneer can manage the allowed bidders")
+	}
+
+	if !auction.GetAuctioneerManagedAllowlist() {
+		return nil, types.ErrNotAuctioneerManagedAllowlist
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStandBy && auction.GetStatus() != types.AuctionStatusStarted {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuctionStatus, "unable to manage allowed bidders for the auction with %s status", auction.GetStatus())
+	}
+
+	return auction, nil
+}
+
 // AllocateSellingCoin allocates allocated selling coin for all matched bids in MatchingInfo and
 // releases them from the selling reserve account.
+// If the auction has bidder vesting schedules, the allocated coins are locked in the bidder vesting reserve account
+// and the vesting queues of each bidder are stored instead.
+// Otherwise, if the auction is in claim mode, the allocated coins are moved to the claim reserve account
+// and the allocation claim of each bidder is stored instead.
 func (k Keeper) AllocateSellingCoin(ctx sdk.Context, auction types.AuctionI, mInfo MatchingInfo) error {
 	// Call hook before selling coin allocation
 	k.BeforeSellingCoinsAllocated(ctx, auction.GetId(), mInfo.AllocationMap, mInfo.RefundMap)
 
+	return k.allocateSellingCoin(ctx, auction, mInfo.AllocationMap)
+}
+
+// allocateSellingCoin allocates the selling coin to the bidders in the allocation map.
+func (k Keeper) allocateSellingCoin(ctx sdk.Context, auction types.AuctionI, allocationMap map[string]sdk.Int) error {
 	sellingReserveAddr := auction.GetSellingReserveAddress()
 	sellingCoinDenom := auction.GetSellingCoin().Denom
+	bidderVesting := len(auction.GetBidderVestingSchedules()) > 0
+	claimMode := auction.GetClaimMode()
 
 	inputs := []banktypes.Input{}
 	outputs := []banktypes.Output{}
+	events := sdk.Events{}
+	typedEvents := []proto.Message{}
+	claimCoins := sdk.Coins{}
 
 	// Sort bidders to reserve determinism
 	var bidders []string
-	for bidder := range mInfo.AllocationMap {
+	for bidder := range allocationMap {
 		bidders = append(bidders, bidder)
 	}
 	sort.Strings(bidders)
@@ -357,14 +775,44 @@ func (k Keeper) AllocateSellingCoin(ctx sdk.Context, auction types.AuctionI, mIn
 	// Allocate coins to all matched bidders in AllocationMap and
 	// set the amounts in transaction inputs and outputs from the selling reserve account
 	for _, bidder := range bidders {
-		if mInfo.AllocationMap[bidder].IsZero() {
+		if allocationMap[bidder].IsZero() {
 			continue
 		}
-		allocateCoins := sdk.NewCoins(sdk.NewCoin(sellingCoinDenom, mInfo.AllocationMap[bidder]))
+		basketCoins := auction.GetBasketCoins(allocationMap[bidder])
+		allocateCoins := sdk.NewCoins(sdk.NewCoin(sellingCoinDenom, allocationMap[bidder])).Add(basketCoins...)
 		bidderAddr, _ := sdk.AccAddressFromBech32(bidder)
 
-		inputs = append(inputs, banktypes.NewInput(sellingReserveAddr, allocateCoins))
-		outputs = append(outputs, banktypes.NewOutput(bidderAddr, allocateCoins))
+		switch {
+		case bidderVesting:
+			k.ApplyBidderVestingSchedules(ctx, auction, bidderAddr, allocateCoins)
+			inputs = append(inputs, banktypes.NewInput(sellingReserveAddr, allocateCoins))
+			outputs = append(outputs, banktypes.NewOutput(auction.GetBidderVestingReserveAddress(), allocateCoins))
+		case claimMode:
+			k.addAllocationClaim(ctx, auction.GetId(), bidderAddr, allocateCoins, sdk.Coins{})
+			claimCoins = claimCoins.Add(allocateCoins...)
+		default:
+			inputs = append(inputs, banktypes.NewInput(sellingReserveAddr, allocateCoins))
+			outputs = append(outputs, banktypes.NewOutput(bidderAddr, allocateCoins))
+		}
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeAllocateSellingCoin,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, bidder),
+			sdk.NewAttribute(types.AttributeKeyAllocatedCoin, allocateCoins.String()),
+		))
+		typedEvents = append(typedEvents, &types.EventAllocateSellingCoin{
+			AuctionId:       auction.GetId(),
+			Bidder:          bidder,
+			AllocatedCoin:   sdk.NewCoin(sellingCoinDenom, allocationMap[bidder]),
+			AllocatedBasket: basketCoins,
+		})
+	}
+
+	// In claim mode, the allocated coins of all the bidders are moved to the claim reserve account at once
+	if !claimCoins.Empty() {
+		inputs = append(inputs, banktypes.NewInput(sellingReserveAddr, claimCoins))
+		outputs = append(outputs, banktypes.NewOutput(auction.GetClaimReserveAddress(), claimCoins))
 	}
 
 	// Send all at once
@@ -372,6 +820,11 @@ func (k Keeper) AllocateSellingCoin(ctx sdk.Context, auction types.AuctionI, mIn
 		return err
 	}
 
+	ctx.EventManager().EmitEvents(events)
+	if err := ctx.EventManager().EmitTypedEvents(typedEvents...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -393,6 +846,25 @@ func (k Keeper) ReleaseVestingPayingCoin(ctx sdk.Context, auction types.AuctionI
 			vestingQueue.SetReleased(true)
 			k.SetVestingQueue(ctx, vestingQueue)
 
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeReleaseVesting,
+					sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+					sdk.NewAttribute(types.AttributeKeyAuctioneerAddress, auctioneerAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyReleaseCoin, payingCoins.String()),
+					sdk.NewAttribute(types.AttributeKeyReleaseTime, vestingQueue.ReleaseTime.String()),
+				),
+			})
+
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventVestingReleased{
+				AuctionId:   auction.GetId(),
+				Auctioneer:  auctioneerAddr.String(),
+				ReleaseCoin: vestingQueue.PayingCoin,
+				ReleaseTime: vestingQueue.ReleaseTime,
+			}); err != nil {
+				return err
+			}
+
 			// Update status when all the amounts are released
 			if i == vestingQueuesLen-1 {
 				_ = auction.SetStatus(types.AuctionStatusFinished)
@@ -404,12 +876,15 @@ func (k Keeper) ReleaseVestingPayingCoin(ctx sdk.Context, auction types.AuctionI
 	return nil
 }
 
-// RefundRemainingSellingCoin refunds the remaining selling coin to the auctioneer.
+// RefundRemainingSellingCoin refunds the remaining selling coin and basket coins to the auctioneer.
 func (k Keeper) RefundRemainingSellingCoin(ctx sdk.Context, auction types.AuctionI) error {
 	sellingReserveAddr := auction.GetSellingReserveAddress()
-	sellingCoinDenom := auction.GetSellingCoin().Denom
 	spendableCoins := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
-	releaseCoins := sdk.NewCoins(sdk.NewCoin(sellingCoinDenom, spendableCoins.AmountOf(sellingCoinDenom)))
+
+	releaseCoins := sdk.Coins{}
+	for _, coin := range auction.GetSellingCoins() {
+		releaseCoins = releaseCoins.Add(sdk.NewCoin(coin.Denom, spendableCoins.AmountOf(coin.Denom)))
+	}
 
 	if err := k.bankKeeper.SendCoins(ctx, sellingReserveAddr, auction.GetAuctioneer(), releaseCoins); err != nil {
 		return err
@@ -418,23 +893,34 @@ func (k Keeper) RefundRemainingSellingCoin(ctx sdk.Context, auction types.Auctio
 }
 
 // RefundPayingCoin refunds paying coin to the corresponding bidders.
+// The refund amount of the matching information is in the paying coin denom, so the refund coins of a bidder
+// are the reserved paying coins of the bidder aggregate except the coins that are worth the paid amount.
+// If the auction is in claim mode, the refunded coins are moved to the claim reserve account
+// and added to the allocation claim of each bidder instead.
 func (k Keeper) RefundPayingCoin(ctx sdk.Context, auction types.AuctionI, mInfo MatchingInfo) error {
+	return k.refundPayingCoin(ctx, auction, mInfo.RefundMap)
+}
+
+// refundPayingCoin refunds the paying coin to the bidders in the refund map.
+func (k Keeper) refundPayingCoin(ctx sdk.Context, auction types.AuctionI, refundMap map[string]sdk.Int) error {
 	payingReserveAddr := auction.GetPayingReserveAddress()
-	payingCoinDenom := auction.GetPayingCoinDenom()
 
 	inputs := []banktypes.Input{}
 	outputs := []banktypes.Output{}
+	events := sdk.Events{}
+	typedEvents := []proto.Message{}
+	claimCoins := sdk.Coins{}
 
 	// Sort bidders to reserve determinism
 	var bidders []string
-	for bidder := range mInfo.RefundMap {
+	for bidder := range refundMap {
 		bidders = append(bidders, bidder)
 	}
 	sort.Strings(bidders)
 
 	// Refund the unmatched bid amount back to the bidder
 	for _, bidder := range bidders {
-		if mInfo.RefundMap[bidder].IsZero() {
+		if refundMap[bidder].IsZero() {
 			continue
 		}
 
@@ -442,10 +928,44 @@ func (k Keeper) RefundPayingCoin(ctx sdk.Context, auction types.AuctionI, mInfo
 		if err != nil {
 			return err
 		}
-		refundCoins := sdk.NewCoins(sdk.NewCoin(payingCoinDenom, mInfo.RefundMap[bidder]))
+		aggregate, found := k.GetBidderAggregate(ctx, auction.GetId(), bidderAddr)
+		if !found {
+			return fmt.Errorf("bidder aggregate of %s is not found for auction %d", bidder, auction.GetId())
+		}
+		paidAmt := aggregate.ReservedAmount.Sub(refundMap[bidder])
+		paidCoins := auction.GetPaidCoins(paidAmt, aggregate.ReservedCoins)
+		refundCoins := aggregate.ReservedCoins.Sub(paidCoins...)
+		if refundCoins.Empty() {
+			continue
+		}
+
+		if auction.GetClaimMode() {
+			k.addAllocationClaim(ctx, auction.GetId(), bidderAddr, sdk.Coins{}, refundCoins)
+			claimCoins = claimCoins.Add(refundCoins...)
+		} else {
+			inputs = append(inputs, banktypes.NewInput(payingReserveAddr, refundCoins))
+			outputs = append(outputs, banktypes.NewOutput(bidderAddr, refundCoins))
+		}
 
-		inputs = append(inputs, banktypes.NewInput(payingReserveAddr, refundCoins))
-		outputs = append(outputs, banktypes.NewOutput(bidderAddr, refundCoins))
+		events = append(events, sdk.NewEvent(
+			types.EventTypeRefundPayingCoin,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, bidder),
+			sdk.NewAttribute(types.AttributeKeyRefundCoin, refundCoins.String()),
+		))
+		for _, refundCoin := range refundCoins {
+			typedEvents = append(typedEvents, &types.EventRefundPayingCoin{
+				AuctionId:  auction.GetId(),
+				Bidder:     bidder,
+				RefundCoin: refundCoin,
+			})
+		}
+	}
+
+	// In claim mode, the refunded coins of all the bidders are moved to the claim reserve account at once
+	if !claimCoins.Empty() {
+		inputs = append(inputs, banktypes.NewInput(payingReserveAddr, claimCoins))
+		outputs = append(outputs, banktypes.NewOutput(auction.GetClaimReserveAddress(), claimCoins))
 	}
 
 	// Send all at once
@@ -453,11 +973,16 @@ func (k Keeper) RefundPayingCoin(ctx sdk.Context, auction types.AuctionI, mInfo
 		return err
 	}
 
+	ctx.EventManager().EmitEvents(events)
+	if err := ctx.EventManager().EmitTypedEvents(typedEvents...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ExtendRound extends another round of ExtendedPeriod value for the auction.
-func (k Keeper) ExtendRound(ctx sdk.Context, ba *types.BatchAuction) {
+func (k Keeper) ExtendRound(ctx sdk.Context, ba *types.BatchAuction) error {
 	params := k.GetParams(ctx)
 	extendedPeriod := params.ExtendedPeriod
 	nextEndTime := ba.GetEndTimes()[len(ba.GetEndTimes())-1].AddDate(0, 0, int(extendedPeriod))
@@ -465,30 +990,70 @@ func (k Keeper) ExtendRound(ctx sdk.Context, ba *types.BatchAuction) {
 
 	_ = ba.SetEndTimes(endTimes)
 	k.SetAuction(ctx, ba)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRoundExtended,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(ba.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, nextEndTime.String()),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRoundExtended{
+		AuctionId: ba.GetId(),
+		EndTime:   nextEndTime,
+	})
 }
 
 // CloseFixedPriceAuction closes a fixed price auction.
-func (k Keeper) CloseFixedPriceAuction(ctx sdk.Context, auction types.AuctionI) {
+func (k Keeper) CloseFixedPriceAuction(ctx sdk.Context, auction types.AuctionI) error {
 	mInfo := k.CalculateFixedPriceAllocation(ctx, auction)
 
+	if raisedAmt := k.CalculateRaisedAmount(ctx, auction, mInfo); !auction.IsSoftCapMet(raisedAmt) {
+		return k.FailSoftCap(ctx, auction, raisedAmt)
+	}
+
+	return k.closeFilledAuction(ctx, auction, mInfo)
+}
+
+// CloseDutchAuction closes a dutch auction.
+// The bids are filled when they are placed, but the selling coin is kept in the selling reserve account
+// and allocated to the bidders here, in the same way as the fixed price auction.
+func (k Keeper) CloseDutchAuction(ctx sdk.Context, auction types.AuctionI) error {
+	return k.closeFilledAuction(ctx, auction, k.CalculateDutchAllocation(ctx, auction))
+}
+
+// closeFilledAuction closes the auction whose bids are all filled at the time they are placed
+// with the matching information. It allocates the selling coin to the bidders, refunds the remaining
+// selling coin to the auctioneer, applies the vesting schedules and records the settlement.
+func (k Keeper) closeFilledAuction(ctx sdk.Context, auction types.AuctionI, mInfo MatchingInfo) error {
 	if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
-		panic(err)
+		return err
 	}
 
 	if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
-		panic(err)
+		return err
 	}
 
 	if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
-		panic(err)
+		return err
 	}
+
+	return k.SetSettlement(ctx, auction, mInfo)
 }
 
 // CloseBatchAuction closes a batch auction.
-func (k Keeper) CloseBatchAuction(ctx sdk.Context, auction types.AuctionI) {
+func (k Keeper) CloseBatchAuction(ctx sdk.Context, auction types.AuctionI) error {
 	ba, ok := auction.(*types.BatchAuction)
 	if !ok {
-		panic(fmt.Errorf("unable to close auction that is not a batch auction: %T", auction))
+		return fmt.Errorf("unable to close auction that is not a batch auction: %T", auction)
+	}
+
+	// Only the revealed bids take part in the matching of the sealed bid auction
+	if ba.IsSealed() {
+		if err := k.ForfeitBidCommitments(ctx, ba); err != nil {
+			return err
+		}
 	}
 
 	// Extend round since there is no last matched length to compare with
@@ -498,28 +1063,11 @@ func (k Keeper) CloseBatchAuction(ctx sdk.Context, auction types.AuctionI) {
 	// Close the auction when maximum extended round + 1 is the same as the length of end times
 	// If the value of MaxExtendedRound is 0, it means that an auctioneer does not want have an extended round
 	if ba.MaxExtendedRound+1 == uint32(len(auction.GetEndTimes())) {
-		if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
-			panic(err)
-		}
-
-		if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
-			panic(err)
-		}
-
-		if err := k.RefundPayingCoin(ctx, auction, mInfo); err != nil {
-			panic(err)
-		}
-
-		if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
-			panic(err)
-		}
-
-		return
+		return k.finalizeBatchAuction(ctx, ba, mInfo)
 	}
 
 	if lastMatchedLen == 0 {
-		k.ExtendRound(ctx, ba)
-		return
+		return k.ExtendRound(ctx, ba)
 	}
 
 	currDec := sdk.NewDec(mInfo.MatchedLen)
@@ -530,23 +1078,211 @@ func (k Keeper) CloseBatchAuction(ctx sdk.Context, auction types.AuctionI) {
 	// the current and the last length of matched bids to determine
 	// if the auction needs another extended round
 	if diff.GTE(ba.ExtendedRoundRate) {
-		k.ExtendRound(ctx, ba)
-		return
+		return k.ExtendRound(ctx, ba)
 	}
 
-	if err := k.AllocateSellingCoin(ctx, auction, mInfo); err != nil {
-		panic(err)
+	return k.finalizeBatchAuction(ctx, ba, mInfo)
+}
+
+// finalizeBatchAuction pays out the allocations and refunds of the batch auction with the final matching information.
+// If the auction has more bidders than the maximum number of bidders that can be settled per block,
+// it starts the settlement over multiple blocks instead.
+func (k Keeper) finalizeBatchAuction(ctx sdk.Context, ba *types.BatchAuction, mInfo MatchingInfo) error {
+	if raisedAmt := k.CalculateRaisedAmount(ctx, ba, mInfo); !ba.IsSoftCapMet(raisedAmt) {
+		return k.FailSoftCap(ctx, ba, raisedAmt)
+	}
+
+	ba.MatchedPrice = mInfo.MatchedPrice
+	if mInfo.MatchedLen == 0 {
+		ba.MatchedPrice = sdk.ZeroDec()
+	}
+
+	maxBidders := k.GetParams(ctx).MaxSettlementBidders
+	if maxBidders > 0 && len(mInfo.RefundMap) > int(maxBidders) {
+		return k.StartSettlement(ctx, ba, mInfo)
+	}
+
+	if err := k.AllocateSellingCoin(ctx, ba, mInfo); err != nil {
+		return err
+	}
+
+	if err := k.RefundRemainingSellingCoin(ctx, ba); err != nil {
+		return err
+	}
+
+	if err := k.RefundPayingCoin(ctx, ba, mInfo); err != nil {
+		return err
+	}
+
+	if err := k.ApplyVestingSchedules(ctx, ba); err != nil {
+		return err
+	}
+
+	return k.SetSettlement(ctx, ba, mInfo)
+}
+
+// CalculateRaisedAmount returns the amount of the paying coin denom that the auction raises with the matching information.
+// It is the reserved paying amount of all the bids that is not refunded.
+func (k Keeper) CalculateRaisedAmount(ctx sdk.Context, auction types.AuctionI, mInfo MatchingInfo) sdk.Int {
+	raisedAmt := sdk.ZeroInt()
+	for bidder, reservedAmt := range k.getReservedPayingAmounts(ctx, auction) {
+		if refundAmt, ok := mInfo.RefundMap[bidder]; ok {
+			reservedAmt = reservedAmt.Sub(refundAmt)
+		}
+		raisedAmt = raisedAmt.Add(reservedAmt)
+	}
+	return raisedAmt
+}
+
+// FailSoftCap returns all the selling coin to the auctioneer and all the reserved paying coin to the bidders
+// since the auction didn't raise the minimum raise amount, and updates the status to AuctionStatusFailedSoftCap.
+func (k Keeper) FailSoftCap(ctx sdk.Context, auction types.AuctionI, raisedAmt sdk.Int) error {
+	refundMap, err := k.returnReservedCoins(ctx, auction)
+	if err != nil {
+		return err
+	}
+
+	_ = auction.SetStatus(types.AuctionStatusFailedSoftCap)
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuctionFailedSoftCap,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyMinRaiseAmount, auction.GetMinRaiseAmount().String()),
+			sdk.NewAttribute(types.AttributeKeyRaisedAmount, raisedAmt.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAuctionFailedSoftCap{
+		AuctionId:      auction.GetId(),
+		MinRaiseAmount: auction.GetMinRaiseAmount(),
+		RaisedAmount:   raisedAmt,
+	}); err != nil {
+		return err
 	}
 
+	return k.SetSettlement(ctx, auction, MatchingInfo{
+		MatchedPrice:       sdk.ZeroDec(),
+		TotalMatchedAmount: sdk.ZeroInt(),
+		AllocationMap:      map[string]sdk.Int{},
+		RefundMap:          refundMap,
+	})
+}
+
+// returnReservedCoins releases all the selling coin in the selling reserve account to the auctioneer and
+// refunds all the reserved paying coin to the bidders. It returns the refunded amounts by bidder.
+func (k Keeper) returnReservedCoins(ctx sdk.Context, auction types.AuctionI) (map[string]sdk.Int, error) {
 	if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
-		panic(err)
+		return nil, sdkerrors.Wrap(err, "failed to release the selling coin")
 	}
 
-	if err := k.RefundPayingCoin(ctx, auction, mInfo); err != nil {
-		panic(err)
+	refundMap := k.getReservedPayingAmounts(ctx, auction)
+	if err := k.RefundPayingCoin(ctx, auction, MatchingInfo{RefundMap: refundMap}); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to refund the paying coin")
 	}
 
-	if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
-		panic(err)
+	if err := k.refundBidCommitments(ctx, auction); err != nil {
+		return nil, err
+	}
+
+	sellingCoinDenom := auction.GetSellingCoin().Denom
+	switch auction := auction.(type) {
+	case *types.FixedPriceAuction:
+		auction.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, sdk.ZeroInt())
+	case *types.DutchAuction:
+		auction.RemainingSellingCoin = sdk.NewCoin(sellingCoinDenom, sdk.ZeroInt())
 	}
+
+	return refundMap, nil
+}
+
+// getReservedPayingAmounts returns the reserved paying amount of all the bids of the auction by bidder
+// from the bidder aggregates. The amounts are in the paying coin denom.
+func (k Keeper) getReservedPayingAmounts(ctx sdk.Context, auction types.AuctionI) map[string]sdk.Int {
+	reservedAmtByBidder := map[string]sdk.Int{}
+	k.IterateBidderAggregatesByAuctionId(ctx, auction.GetId(), func(aggregate types.BidderAggregate) (stop bool) {
+		reservedAmtByBidder[aggregate.Bidder] = aggregate.ReservedAmount
+		return false
+	})
+	return reservedAmtByBidder
+}
+
+// SetSettlement records the settlement of the closed auction and its bidders from the matching information
+// and emits the event that the auction is closed.
+// The paid amount of a bidder is the reserved paying amount of all the bids that is not refunded.
+func (k Keeper) SetSettlement(ctx sdk.Context, auction types.AuctionI, mInfo MatchingInfo) error {
+	reservedAmtByBidder := k.getReservedPayingAmounts(ctx, auction)
+
+	matchedPrice := mInfo.MatchedPrice
+	if mInfo.MatchedLen == 0 {
+		matchedPrice = sdk.ZeroDec()
+	}
+
+	settlement := types.AuctionSettlement{
+		AuctionId:           auction.GetId(),
+		MatchedPrice:        matchedPrice,
+		TotalSoldAmount:     mInfo.TotalMatchedAmount,
+		TotalRaisedAmount:   sdk.ZeroInt(),
+		TotalRefundedAmount: sdk.ZeroInt(),
+		CloseHeight:         ctx.BlockHeight(),
+		CloseTime:           ctx.BlockTime(),
+		ExtendedRounds:      uint32(len(auction.GetEndTimes()) - 1),
+	}
+
+	// Sort bidders to reserve determinism
+	var bidders []string
+	for bidder := range reservedAmtByBidder {
+		bidders = append(bidders, bidder)
+	}
+	sort.Strings(bidders)
+
+	for _, bidder := range bidders {
+		allocatedAmt, ok := mInfo.AllocationMap[bidder]
+		if !ok {
+			allocatedAmt = sdk.ZeroInt()
+		}
+		refundedAmt, ok := mInfo.RefundMap[bidder]
+		if !ok {
+			refundedAmt = sdk.ZeroInt()
+		}
+		paidAmt := reservedAmtByBidder[bidder].Sub(refundedAmt)
+
+		k.SetBidderSettlement(ctx, types.BidderSettlement{
+			AuctionId:       auction.GetId(),
+			Bidder:          bidder,
+			AllocatedAmount: allocatedAmt,
+			PaidAmount:      paidAmt,
+			RefundedAmount:  refundedAmt,
+		})
+
+		if allocatedAmt.IsPositive() {
+			settlement.WinnersCount++
+		}
+		settlement.TotalRaisedAmount = settlement.TotalRaisedAmount.Add(paidAmt)
+		settlement.TotalRefundedAmount = settlement.TotalRefundedAmount.Add(refundedAmt)
+	}
+
+	k.SetAuctionSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuctionClosed,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionStatus, auction.GetStatus().String()),
+			sdk.NewAttribute(types.AttributeKeyMatchedPrice, settlement.MatchedPrice.String()),
+			sdk.NewAttribute(types.AttributeKeySoldAmount, settlement.TotalSoldAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyWinnersCount, strconv.FormatUint(settlement.WinnersCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyRaisedAmount, settlement.TotalRaisedAmount.String()),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAuctionClosed{
+		AuctionId:     auction.GetId(),
+		AuctionStatus: auction.GetStatus(),
+		MatchedPrice:  settlement.MatchedPrice,
+		SoldAmount:    settlement.TotalSoldAmount,
+		WinnersCount:  settlement.WinnersCount,
+		RaisedAmount:  settlement.TotalRaisedAmount,
+	})
 }
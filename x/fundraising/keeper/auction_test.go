@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
 	"github.com/tendermint/fundraising/x/fundraising/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -364,6 +365,17 @@ func (s *KeeperTestSuite) TestBatchAuction_MaxNumVestingSchedules() {
 		sdk.MustNewDecFromStr("0.2"),
 		time.Now().AddDate(0, 6, 0),
 		time.Now().AddDate(0, 6, 0).AddDate(0, 1, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
 	)
 
 	params := s.keeper.GetParams(s.ctx)
@@ -408,6 +420,16 @@ func (s *KeeperTestSuite) TestFixedPriceAuction_MaxNumVestingSchedules() {
 		[]types.VestingSchedule{},
 		time.Now().AddDate(0, 0, -1),
 		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		nil,
+		nil,
+		false,
 	)
 
 	params := s.keeper.GetParams(s.ctx)
@@ -446,6 +468,16 @@ func (s *KeeperTestSuite) TestInvalidEndTime() {
 		[]types.VestingSchedule{},
 		types.MustParseRFC3339("2022-03-01T00:00:00Z"),
 		types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		nil,
+		nil,
+		false,
 	)
 	s.fundAddr(s.addr(0), params.AuctionCreationFee.Add(fixedPriceAuction.SellingCoin))
 
@@ -463,6 +495,17 @@ func (s *KeeperTestSuite) TestInvalidEndTime() {
 		sdk.MustNewDecFromStr("0.2"),
 		types.MustParseRFC3339("2022-03-01T00:00:00Z"),
 		types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
 	)
 	s.fundAddr(s.addr(1), params.AuctionCreationFee.Add(batchAuction.SellingCoin))
 
@@ -674,6 +717,202 @@ func (s *KeeperTestSuite) TestUpdateAllowedBidder() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUpdateAllowedBidderByAuctioneer_BelowBidAmount() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	s.fundAddr(auctioneer, s.keeper.GetParams(s.ctx).AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateBatchAuction(s.ctx, &types.MsgCreateBatchAuction{
+		Auctioneer:                 auctioneer.String(),
+		StartPrice:                 parseDec("1"),
+		MinBidPrice:                parseDec("0.1"),
+		SellingCoin:                sellingCoin,
+		PayingCoinDenom:            "denom2",
+		VestingSchedules:           []types.VestingSchedule{},
+		ExtendedRoundRate:          sdk.ZeroDec(),
+		StartTime:                  time.Now().AddDate(0, 0, -1),
+		EndTime:                    time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		AuctioneerManagedAllowlist: true,
+	})
+	s.Require().NoError(err)
+	auction := a.(*types.BatchAuction)
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("150_000_000denom1"), sdk.NewInt(500_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("25_000_000denom2"), sdk.ZeroInt(), true)
+
+	// The auctioneer can't lower the maximum bid amount below the total bid amount of 200_000_000
+	err = s.keeper.UpdateAllowedBidderByAuctioneer(s.ctx, types.NewMsgUpdateAllowedBidder(auction.Id, auctioneer.String(), s.addr(1).String(), sdk.NewInt(199_999_999)))
+	s.Require().ErrorIs(err, types.ErrInvalidMaxBidAmount)
+
+	err = s.keeper.AddAllowedBiddersByAuctioneer(s.ctx, types.NewMsgAddAllowedBidders(auction.Id, auctioneer.String(), []types.AllowedBidder{
+		{Bidder: s.addr(2).String(), MaxBidAmount: sdk.NewInt(100_000_000)},
+		{Bidder: s.addr(1).String(), MaxBidAmount: sdk.NewInt(100_000_000)},
+	}))
+	s.Require().ErrorIs(err, types.ErrInvalidMaxBidAmount)
+
+	_, found := s.keeper.GetAllowedBidder(s.ctx, auction.Id, s.addr(2))
+	s.Require().False(found)
+	allowedBidder, found := s.keeper.GetAllowedBidder(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(500_000_000), allowedBidder.MaxBidAmount)
+
+	// The maximum bid amount can be lowered down to the total bid amount
+	err = s.keeper.UpdateAllowedBidderByAuctioneer(s.ctx, types.NewMsgUpdateAllowedBidder(auction.Id, auctioneer.String(), s.addr(1).String(), sdk.NewInt(200_000_000)))
+	s.Require().NoError(err)
+
+	// The keeper methods for external modules don't check the bids of the bidder
+	err = s.keeper.UpdateAllowedBidder(s.ctx, auction.Id, s.addr(1), sdk.NewInt(100_000_000))
+	s.Require().NoError(err)
+
+	allowedBidder, found = s.keeper.GetAllowedBidder(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(100_000_000), allowedBidder.MaxBidAmount)
+}
+
+func (s *KeeperTestSuite) TestRemoveAllowedBidder() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseCoin("500_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.addAllowedBidder(auction.Id, s.addr(1), parseInt("100_000_000_000"))
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("1_000_000denom2"), true)
+
+	// Invalid auction id
+	err := s.keeper.RemoveAllowedBidder(s.ctx, 10, s.addr(1))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// Bidder not found
+	err = s.keeper.RemoveAllowedBidder(s.ctx, auction.Id, s.addr(2))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	s.Require().NoError(s.keeper.RemoveAllowedBidder(s.ctx, auction.Id, s.addr(1)))
+	_, found := s.keeper.GetAllowedBidder(s.ctx, auction.Id, s.addr(1))
+	s.Require().False(found)
+
+	// The bid that is already placed remains
+	s.Require().Len(s.keeper.GetBidsByBidder(s.ctx, s.addr(1)), 1)
+
+	// The removed bidder can't place a new bid
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeFixedPrice,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("1_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrNotAllowedBidder)
+}
+
+func (s *KeeperTestSuite) TestRemoveAllowedBidder_BatchAuction() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	bid := s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom1"), sdk.NewInt(500_000_000), true)
+
+	// The bidder can't be removed while the bidder has bids, since the bids would never be matched
+	err := s.keeper.RemoveAllowedBidder(s.ctx, auction.Id, s.addr(1))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, found := s.keeper.GetAllowedBidder(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     bid.Id,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.RemoveAllowedBidder(s.ctx, auction.Id, s.addr(1)))
+	_, found = s.keeper.GetAllowedBidder(s.ctx, auction.Id, s.addr(1))
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAuctioneerManagedAllowlist() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("500_000_000_000denom1")
+	creationFee := s.keeper.GetParams(s.ctx).AuctionCreationFee
+	s.fundAddr(auctioneer, creationFee.Add(creationFee...).Add(sellingCoin.Add(sellingCoin)))
+
+	createAuction := func(auctioneerManaged bool) types.AuctionI {
+		auction, err := s.keeper.CreateFixedPriceAuction(s.ctx, &types.MsgCreateFixedPriceAuction{
+			Auctioneer:                 auctioneer.String(),
+			StartPrice:                 parseDec("0.5"),
+			SellingCoin:                sellingCoin,
+			PayingCoinDenom:            "denom2",
+			VestingSchedules:           []types.VestingSchedule{},
+			StartTime:                  time.Now().AddDate(0, 0, -1),
+			EndTime:                    time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+			AuctioneerManagedAllowlist: auctioneerManaged,
+		})
+		s.Require().NoError(err)
+		return auction
+	}
+
+	auction := createAuction(true)
+	s.Require().True(auction.GetAuctioneerManagedAllowlist())
+	externalAuction := createAuction(false)
+	s.Require().False(externalAuction.GetAuctioneerManagedAllowlist())
+
+	allowedBidders := []types.AllowedBidder{
+		{Bidder: s.addr(1).String(), MaxBidAmount: sdk.NewInt(100_000_000)},
+		{Bidder: s.addr(2).String(), MaxBidAmount: sdk.NewInt(200_000_000)},
+	}
+
+	// Only the auctioneer can manage the allowed bidders
+	err := s.keeper.AddAllowedBiddersByAuctioneer(s.ctx, types.NewMsgAddAllowedBidders(auction.GetId(), s.addr(1).String(), allowedBidders))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// The allowed bidders of the auction are managed by an external module
+	err = s.keeper.AddAllowedBiddersByAuctioneer(s.ctx, types.NewMsgAddAllowedBidders(externalAuction.GetId(), auctioneer.String(), allowedBidders))
+	s.Require().ErrorIs(err, types.ErrNotAuctioneerManagedAllowlist)
+
+	// Auction not found
+	err = s.keeper.AddAllowedBiddersByAuctioneer(s.ctx, types.NewMsgAddAllowedBidders(10, auctioneer.String(), allowedBidders))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	s.Require().NoError(s.keeper.AddAllowedBiddersByAuctioneer(s.ctx, types.NewMsgAddAllowedBidders(auction.GetId(), auctioneer.String(), allowedBidders)))
+	s.Require().Len(s.keeper.GetAllowedBiddersByAuction(s.ctx, auction.GetId()), 2)
+
+	err = s.keeper.UpdateAllowedBidderByAuctioneer(s.ctx, types.NewMsgUpdateAllowedBidder(auction.GetId(), s.addr(1).String(), s.addr(1).String(), sdk.NewInt(300_000_000)))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	s.Require().NoError(s.keeper.UpdateAllowedBidderByAuctioneer(s.ctx, types.NewMsgUpdateAllowedBidder(auction.GetId(), auctioneer.String(), s.addr(1).String(), sdk.NewInt(300_000_000))))
+	allowedBidder, found := s.keeper.GetAllowedBidder(s.ctx, auction.GetId(), s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(300_000_000), allowedBidder.MaxBidAmount)
+
+	err = s.keeper.RemoveAllowedBidderByAuctioneer(s.ctx, types.NewMsgRemoveAllowedBidder(auction.GetId(), s.addr(1).String(), s.addr(2).String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	s.Require().NoError(s.keeper.RemoveAllowedBidderByAuctioneer(s.ctx, types.NewMsgRemoveAllowedBidder(auction.GetId(), auctioneer.String(), s.addr(2).String())))
+	s.Require().Len(s.keeper.GetAllowedBiddersByAuction(s.ctx, auction.GetId()), 1)
+
+	// The allowed bidders can't be managed after the auction is cancelled or closed
+	a, found := s.keeper.GetAuction(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().NoError(a.SetStatus(types.AuctionStatusVesting))
+	s.keeper.SetAuction(s.ctx, a)
+
+	err = s.keeper.AddAllowedBiddersByAuctioneer(s.ctx, types.NewMsgAddAllowedBidders(auction.GetId(), auctioneer.String(), allowedBidders))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
+
 func (s *KeeperTestSuite) TestRefundPayingCoin() {
 	auction := s.createBatchAuction(
 		s.addr(0),
@@ -701,7 +940,7 @@ func (s *KeeperTestSuite) TestRefundPayingCoin() {
 	err := s.keeper.RefundPayingCoin(s.ctx, a, mInfo)
 	s.Require().NoError(err)
 
-	expectedAmt := refundBid.ConvertToPayingAmount(auction.GetPayingCoinDenom())
+	expectedAmt := refundBid.ConvertToPayingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
 	bidderBalance := s.getBalance(s.addr(2), auction.GetPayingCoinDenom()).Amount
 	s.Require().Equal(expectedAmt, bidderBalance)
 }
@@ -729,7 +968,7 @@ func (s *KeeperTestSuite) TestCloseFixedPriceAuction() {
 	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
 	s.Require().True(found)
 
-	s.keeper.CloseFixedPriceAuction(s.ctx, a)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, a))
 
 	s.Require().Equal(parseCoin("999000000000denom1"), s.getBalance(s.addr(0), a.GetSellingCoin().Denom))
 	s.Require().Equal(parseCoin("0denom2"), s.getBalance(s.addr(0), a.GetPayingCoinDenom()))
@@ -738,6 +977,374 @@ func (s *KeeperTestSuite) TestCloseFixedPriceAuction() {
 	s.Require().Equal(parseCoin("250_000_000denom1"), s.getBalance(s.addr(3), a.GetSellingCoin().Denom))
 	s.Require().Equal(parseCoin("250_000_000denom1"), s.getBalance(s.addr(4), a.GetSellingCoin().Denom))
 	s.Require().Len(s.keeper.GetVestingQueues(s.ctx), len(a.GetVestingSchedules()))
+
+	settlement, found := s.keeper.GetAuctionSettlement(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(parseDec("1"), settlement.MatchedPrice)
+	s.Require().Equal(parseInt("1_000_000_000"), settlement.TotalSoldAmount)
+	s.Require().Equal(parseInt("1_000_000_000"), settlement.TotalRaisedAmount)
+	s.Require().True(settlement.TotalRefundedAmount.IsZero())
+	s.Require().Equal(uint64(4), settlement.WinnersCount)
+	s.Require().Len(s.keeper.GetBidderSettlementsByAuctionId(s.ctx, auction.Id), 4)
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_SellingBasket() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	sellingBasket := parseCoins("3_000_000denom3,2_000_000_000denom4")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin).Add(sellingBasket...))
+
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		sellingBasket,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		nil,
+		nil,
+		false,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(sellingBasket, a.GetSellingBasket())
+
+	// The selling coin and the basket coins must be reserved
+	sellingReserve := s.app.BankKeeper.GetAllBalances(s.ctx, a.GetSellingReserveAddress())
+	s.Require().True(sellingReserve.IsEqual(sdk.NewCoins(sellingCoin).Add(sellingBasket...)))
+
+	s.placeBidFixedPrice(a.GetId(), s.addr(1), parseDec("1"), parseCoin("500_000_000denom2"), true)
+	s.placeBidFixedPrice(a.GetId(), s.addr(2), parseDec("1"), parseCoin("100_000_001denom2"), true)
+
+	_, broken := keeper.SellingPoolReserveAmountInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, auction))
+
+	// Bidders receive the basket coins in the fixed ratio to the allocated selling coin
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("1_500_000denom3"), s.getBalance(s.addr(1), "denom3"))
+	s.Require().Equal(parseCoin("1_000_000_000denom4"), s.getBalance(s.addr(1), "denom4"))
+	s.Require().Equal(parseCoin("100_000_001denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("300_000denom3"), s.getBalance(s.addr(2), "denom3"))
+	s.Require().Equal(parseCoin("200_000_002denom4"), s.getBalance(s.addr(2), "denom4"))
+
+	// The remaining selling coin and basket coins including the truncated remainder are returned to the auctioneer
+	s.Require().Equal(parseCoin("399_999_999denom1"), s.getBalance(auctioneer, "denom1"))
+	s.Require().Equal(parseCoin("1_200_000denom3"), s.getBalance(auctioneer, "denom3"))
+	s.Require().Equal(parseCoin("799_999_998denom4"), s.getBalance(auctioneer, "denom4"))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, a.GetSellingReserveAddress()).IsZero())
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_PayingCoinRates() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	payingCoinRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", parseDec("0.5")))
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		payingCoinRates,
+		sdk.ZeroInt(),
+		false,
+		nil,
+		nil,
+		false,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(payingCoinRates, a.GetPayingCoinRates())
+
+	// The bid coin in the other paying coin denom is reserved as it is
+	s.placeBidFixedPrice(a.GetId(), s.addr(1), parseDec("1"), parseCoin("200_000_000denom3"), true)
+	s.placeBidFixedPrice(a.GetId(), s.addr(2), parseDec("1"), parseCoin("100_000_000denom2"), true)
+	s.placeBidFixedPrice(a.GetId(), s.addr(3), parseDec("1"), parseCoin("50_000_000denom1"), true)
+
+	payingReserve := s.app.BankKeeper.GetAllBalances(s.ctx, a.GetPayingReserveAddress())
+	s.Require().True(payingReserve.IsEqual(parseCoins("150_000_000denom2,200_000_000denom3")))
+
+	_, broken := keeper.PayingPoolReserveAmountInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	s.fundAddr(s.addr(4), parseCoins("100_000_000denom4"))
+	s.addAllowedBidder(a.GetId(), s.addr(4), sdk.NewInt(100_000_000))
+	_, err = s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(a.GetId(), s.addr(4).String(), types.BidTypeFixedPrice, parseDec("1"), parseCoin("100_000_000denom4")))
+	s.Require().ErrorIs(err, types.ErrIncorrectCoinDenom)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(parseCoin("750_000_000denom1"), auction.(*types.FixedPriceAuction).RemainingSellingCoin)
+	s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, auction))
+
+	s.Require().Equal(parseCoin("100_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("100_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("50_000_000denom1"), s.getBalance(s.addr(3), "denom1"))
+
+	// All the paying coins are sent to the auctioneer without vesting schedules
+	s.Require().Equal(parseCoin("150_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+	s.Require().Equal(parseCoin("200_000_000denom3"), s.getBalance(auctioneer, "denom3"))
+}
+
+func (s *KeeperTestSuite) TestBatchAuction_PayingCoinRates() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	endTime := time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0)
+	vestingSchedules := []types.VestingSchedule{
+		{ReleaseTime: endTime.AddDate(0, 1, 0), Weight: parseDec("0.5")},
+		{ReleaseTime: endTime.AddDate(0, 2, 0), Weight: parseDec("0.5")},
+	}
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		vestingSchedules,
+		0,
+		parseDec("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		endTime,
+		false,
+		false,
+		sdk.ZeroInt(),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", parseDec("0.5"))),
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+
+	// The bidder's second bid requests 100_000_000 at its bid price, but it is partially matched
+	// at the lower match price due to the maximum bid amount of 500_000_000
+	s.placeBidBatchWorth(a.GetId(), s.addr(1), parseDec("1"), parseCoin("400_000_000denom2"), sdk.NewInt(500_000_000), true)
+	s.placeBidBatchWorth(a.GetId(), s.addr(1), parseDec("3"), parseCoin("600_000_000denom3"), sdk.ZeroInt(), true)
+	s.placeBidBatchWorth(a.GetId(), s.addr(2), parseDec("1"), parseCoin("300_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(a.GetId(), s.addr(3), parseDec("0.5"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	payingReserve := s.app.BankKeeper.GetAllBalances(s.ctx, a.GetPayingReserveAddress())
+	s.Require().True(payingReserve.IsEqual(parseCoins("800_000_000denom2,600_000_000denom3")))
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, auction))
+
+	// The paid amount is taken from the paying coin denom first and
+	// the rest of the other paying coin denom is refunded
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
+	s.Require().Equal(parseCoin("400_000_000denom3"), s.getBalance(s.addr(1), "denom3"))
+	s.Require().Equal(parseCoin("300_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(3), "denom2"))
+
+	// Each paying coin denom has its own vesting queues
+	queues := s.keeper.GetVestingQueuesByAuctionId(s.ctx, a.GetId())
+	s.Require().Len(queues, 4)
+	for _, schedule := range vestingSchedules {
+		s.Require().Equal(parseCoin("350_000_000denom2"), s.keeper.GetVestingQueue(s.ctx, a.GetId(), schedule.ReleaseTime, "denom2").PayingCoin)
+		s.Require().Equal(parseCoin("100_000_000denom3"), s.keeper.GetVestingQueue(s.ctx, a.GetId(), schedule.ReleaseTime, "denom3").PayingCoin)
+	}
+
+	vestingReserve := s.app.BankKeeper.GetAllBalances(s.ctx, a.GetVestingReserveAddress())
+	s.Require().True(vestingReserve.IsEqual(parseCoins("700_000_000denom2,200_000_000denom3")))
+
+	_, broken := keeper.VestingPoolReserveAmountInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// The settlement amounts are in the paying coin denom
+	settlement, found := s.keeper.GetAuctionSettlement(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(800_000_000), settlement.TotalRaisedAmount)
+
+	// Release all the vesting queues
+	s.ctx = s.ctx.WithBlockTime(vestingSchedules[1].ReleaseTime.AddDate(0, 0, 1))
+	auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().NoError(s.keeper.ReleaseVestingPayingCoin(s.ctx, auction))
+	s.Require().Equal(parseCoin("700_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+	s.Require().Equal(parseCoin("200_000_000denom3"), s.getBalance(auctioneer, "denom3"))
+
+	auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_CancelSellingBasket() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	sellingBasket := parseCoins("3_000_000denom3")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin).Add(sellingBasket...))
+
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 1, 0),
+		time.Now().AddDate(0, 2, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		sellingBasket,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		nil,
+		nil,
+		false,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStandBy, a.GetStatus())
+
+	s.Require().NoError(s.keeper.CancelAuction(s.ctx, types.NewMsgCancelAuction(auctioneer.String(), a.GetId())))
+	s.Require().Equal(sellingCoin, s.getBalance(auctioneer, "denom1"))
+	s.Require().Equal(sellingBasket[0], s.getBalance(auctioneer, "denom3"))
+}
+
+func (s *KeeperTestSuite) TestDutchAuction_AuctionStatus() {
+	standByAuction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2"),
+		parseDec("1"),
+		parseDec("0.1"),
+		time.Hour,
+		parseCoin("1_000_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		types.MustParseRFC3339("2030-01-01T00:00:00Z"),
+		types.MustParseRFC3339("2030-01-10T00:00:00Z"),
+		true,
+	)
+
+	auction, found := s.keeper.GetAuction(s.ctx, standByAuction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionTypeDutch, auction.GetType())
+	s.Require().Equal(types.AuctionStatusStandBy, auction.GetStatus())
+	s.Require().Equal(parseCoin("1_000_000_000_000denom1"), auction.(*types.DutchAuction).RemainingSellingCoin)
+
+	startedAuction := s.createDutchAuction(
+		s.addr(1),
+		parseDec("2"),
+		parseDec("1"),
+		parseDec("0.1"),
+		time.Hour,
+		parseCoin("1_000_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	auction, found = s.keeper.GetAuction(s.ctx, startedAuction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+}
+
+func (s *KeeperTestSuite) TestDutchAuction_CancelAuction() {
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2"),
+		parseDec("1"),
+		parseDec("0.1"),
+		time.Hour,
+		parseCoin("1_000_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		types.MustParseRFC3339("2030-01-01T00:00:00Z"),
+		types.MustParseRFC3339("2030-01-10T00:00:00Z"),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStandBy, auction.GetStatus())
+
+	err := s.keeper.CancelAuction(s.ctx, types.NewMsgCancelAuction(s.addr(0).String(), auction.Id))
+	s.Require().NoError(err)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusCancelled, a.GetStatus())
+	s.Require().True(a.(*types.DutchAuction).RemainingSellingCoin.IsZero())
+	s.Require().Equal(parseCoin("1_000_000_000_000denom1"), s.getBalance(s.addr(0), "denom1"))
+}
+
+func (s *KeeperTestSuite) TestCloseDutchAuction() {
+	startTime := s.ctx.BlockTime().Add(-90 * time.Minute)
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2"),
+		parseDec("1"),
+		parseDec("0.5"),
+		time.Hour,
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		startTime,
+		startTime.AddDate(0, 0, 1),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	// The price decayed once; 2 - 0.5 = 1.5
+	bid := s.placeBidDutch(auction.Id, s.addr(1), parseDec("2"), parseCoin("300_000_000denom2"), true)
+	s.Require().Equal(parseDec("1.5"), bid.Price)
+	s.Require().True(bid.IsMatched)
+
+	// The price reaches the floor price
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+	bid = s.placeBidDutch(auction.Id, s.addr(2), parseDec("1"), parseCoin("500_000_000denom1"), true)
+	s.Require().Equal(parseDec("1"), bid.Price)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(parseCoin("300_000_000denom1"), a.(*types.DutchAuction).RemainingSellingCoin)
+
+	mInfo := s.keeper.CalculateDutchAllocation(s.ctx, a)
+	s.Require().Equal(parseDec("1"), mInfo.MatchedPrice)
+	s.Require().Equal(parseInt("700_000_000"), mInfo.TotalMatchedAmount)
+
+	s.Require().NoError(s.keeper.CloseDutchAuction(s.ctx, a))
+
+	s.Require().Equal(parseCoin("300_000_000denom1"), s.getBalance(s.addr(0), a.GetSellingCoin().Denom))
+	s.Require().Equal(parseCoin("800_000_000denom2"), s.getBalance(s.addr(0), a.GetPayingCoinDenom()))
+	s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(s.addr(1), a.GetSellingCoin().Denom))
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(2), a.GetSellingCoin().Denom))
+
+	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
 }
 
 func (s *KeeperTestSuite) TestCloseBatchAuction() {
@@ -766,7 +1373,7 @@ func (s *KeeperTestSuite) TestCloseBatchAuction() {
 	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
 	s.Require().True(found)
 
-	s.keeper.CloseBatchAuction(s.ctx, a)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, a))
 
 	s.Require().Equal(parseCoin("350000000denom2"), s.getBalance(s.addr(0), a.GetPayingCoinDenom()))
 	s.Require().Equal(parseCoin("9500000000denom1"), s.getBalance(s.addr(0), a.GetSellingCoin().Denom))
@@ -776,6 +1383,62 @@ func (s *KeeperTestSuite) TestCloseBatchAuction() {
 	s.Require().Len(s.keeper.GetVestingQueues(s.ctx), len(a.GetVestingSchedules()))
 }
 
+func (s *KeeperTestSuite) TestCloseBatchAuction_Settlement() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("10_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.9"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.7"), parseCoin("100_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	// The first close extends the round since there is no last matched length to compare with
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, auction))
+	_, found := s.keeper.GetAuctionSettlement(s.ctx, auction.Id)
+	s.Require().False(found)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Len(a.GetEndTimes(), 2)
+
+	s.ctx = s.ctx.WithBlockHeight(10)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, a))
+
+	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(parseDec("0.7"), a.(*types.BatchAuction).MatchedPrice)
+
+	settlement, found := s.keeper.GetAuctionSettlement(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(parseDec("0.7"), settlement.MatchedPrice)
+	s.Require().Equal(parseInt("500_000_000"), settlement.TotalSoldAmount)
+	s.Require().Equal(parseInt("350_000_000"), settlement.TotalRaisedAmount)
+	s.Require().Equal(parseInt("60_000_000"), settlement.TotalRefundedAmount)
+	s.Require().Equal(uint64(3), settlement.WinnersCount)
+	s.Require().Equal(int64(10), settlement.CloseHeight)
+	s.Require().Equal(s.ctx.BlockTime(), settlement.CloseTime)
+	s.Require().Equal(uint32(1), settlement.ExtendedRounds)
+
+	bidderSettlement, found := s.keeper.GetBidderSettlement(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(parseInt("200_000_000"), bidderSettlement.AllocatedAmount)
+	s.Require().Equal(parseInt("140_000_000"), bidderSettlement.PaidAmount)
+	s.Require().Equal(parseInt("40_000_000"), bidderSettlement.RefundedAmount)
+	s.Require().Len(s.keeper.GetBidderSettlementsByAuctionId(s.ctx, auction.Id), 3)
+}
+
 func (s *KeeperTestSuite) TestCloseBatchAuction_ExtendRound() {
 	// Extend round for a batch auction by setting MaxExtendedRound to non zero value
 	maxExtendedRound := uint32(5)
@@ -804,17 +1467,19 @@ func (s *KeeperTestSuite) TestCloseBatchAuction_ExtendRound() {
 	s.Require().True(found)
 	s.Require().Len(a.GetEndTimes(), 1)
 
-	s.keeper.CloseBatchAuction(s.ctx, auction)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, auction))
 
 	// Extended round must be triggered
 	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
 	s.Require().True(found)
 	s.Require().Len(a.GetEndTimes(), 2)
+	s.Require().Equal(1, s.countEvents(types.EventTypeRoundExtended))
+	s.Require().Zero(s.countEvents(types.EventTypeAuctionClosed))
 
 	// Auction sniping occurs
 	s.placeBidBatchMany(auction.Id, s.addr(4), parseDec("0.85"), parseCoin("9_800_000_000denom1"), sdk.NewInt(100_000_000_000), true)
 
-	s.keeper.CloseBatchAuction(s.ctx, a)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, a))
 
 	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
 	s.Require().True(found)
@@ -849,19 +1514,301 @@ func (s *KeeperTestSuite) TestCloseBatchAuction_Valid() {
 	s.Require().True(found)
 	s.Require().Len(a.GetEndTimes(), 1)
 
-	s.keeper.CloseBatchAuction(s.ctx, auction)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, auction))
 
 	// Extended round must be triggered
 	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
 	s.Require().True(found)
 	s.Require().Len(a.GetEndTimes(), 2)
+	s.Require().Equal(1, s.countEvents(types.EventTypeRoundExtended))
+	s.Require().Zero(s.countEvents(types.EventTypeAuctionClosed))
 
 	// Auction sniping occurs
 	s.placeBidBatchMany(auction.Id, s.addr(4), parseDec("0.85"), parseCoin("9_500_000_000denom1"), sdk.NewInt(100_000_000_000), true)
 
-	s.keeper.CloseBatchAuction(s.ctx, a)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, a))
 
 	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
 	s.Require().True(found)
 	s.Require().Len(a.GetEndTimes(), 2)
 }
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_SoftCap() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin).Add(params.AuctionCreationFee...).Add(sellingCoin))
+
+	for _, tc := range []struct {
+		name           string
+		minRaiseAmount sdk.Int
+		expectedStatus types.AuctionStatus
+	}{
+		{"soft cap not met", sdk.NewInt(500_000_000), types.AuctionStatusFailedSoftCap},
+		{"soft cap met", sdk.NewInt(300_000_000), types.AuctionStatusFinished},
+	} {
+		s.Run(tc.name, func() {
+			a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+				auctioneer.String(),
+				parseDec("1"),
+				sellingCoin,
+				"denom2",
+				[]types.VestingSchedule{},
+				time.Now().AddDate(0, 0, -1),
+				time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				tc.minRaiseAmount,
+				false,
+				nil,
+				nil,
+				false,
+			))
+			s.Require().NoError(err)
+			s.Require().Equal(tc.minRaiseAmount, a.GetMinRaiseAmount())
+
+			bidder1, bidder2 := s.addr(int(a.GetId()*2)), s.addr(int(a.GetId()*2+1))
+			s.placeBidFixedPrice(a.GetId(), bidder1, parseDec("1"), parseCoin("200_000_000denom2"), true)
+			s.placeBidFixedPrice(a.GetId(), bidder2, parseDec("1"), parseCoin("100_000_000denom1"), true)
+
+			auctioneerBalance := s.getBalance(auctioneer, "denom1")
+
+			auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+			s.Require().True(found)
+			s.Require().NoError(s.keeper.CloseFixedPriceAuction(s.ctx, auction))
+
+			auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+			s.Require().True(found)
+			s.Require().Equal(tc.expectedStatus, auction.GetStatus())
+
+			settlement, found := s.keeper.GetAuctionSettlement(s.ctx, a.GetId())
+			s.Require().True(found)
+
+			if tc.expectedStatus == types.AuctionStatusFailedSoftCap {
+				// All the coins are returned to their owners
+				s.Require().Equal(parseCoin("200_000_000denom2"), s.getBalance(bidder1, "denom2"))
+				s.Require().True(s.getBalance(bidder1, "denom1").IsZero())
+				s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(bidder2, "denom2"))
+				s.Require().True(s.getBalance(bidder2, "denom1").IsZero())
+				s.Require().Equal(auctioneerBalance.Add(sellingCoin), s.getBalance(auctioneer, "denom1"))
+				s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, a.GetSellingReserveAddress()).IsZero())
+				s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, a.GetPayingReserveAddress()).IsZero())
+
+				s.Require().True(settlement.TotalSoldAmount.IsZero())
+				s.Require().True(settlement.TotalRaisedAmount.IsZero())
+				s.Require().Equal(sdk.NewInt(300_000_000), settlement.TotalRefundedAmount)
+				s.Require().Zero(settlement.WinnersCount)
+			} else {
+				s.Require().Equal(parseCoin("200_000_000denom1"), s.getBalance(bidder1, "denom1"))
+				s.Require().Equal(parseCoin("100_000_000denom1"), s.getBalance(bidder2, "denom1"))
+				s.Require().Equal(sdk.NewInt(300_000_000), settlement.TotalRaisedAmount)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchAuction_SoftCap() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		parseDec("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		sdk.NewInt(500_000_000),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+
+	s.placeBidBatchWorth(a.GetId(), s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(a.GetId(), s.addr(2), parseDec("0.4"), parseCoin("300_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().NoError(s.keeper.CloseBatchAuction(s.ctx, auction))
+
+	auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFailedSoftCap, auction.GetStatus())
+	s.Require().True(auction.(*types.BatchAuction).MatchedPrice.IsZero())
+
+	// All the coins are returned to their owners
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("120_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "denom1").IsZero())
+	s.Require().Equal(sellingCoin, s.getBalance(auctioneer, "denom1"))
+	s.Require().Empty(s.keeper.GetVestingQueuesByAuctionId(s.ctx, a.GetId()))
+
+	settlement, found := s.keeper.GetAuctionSettlement(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().True(settlement.TotalRaisedAmount.IsZero())
+	s.Require().Equal(sdk.NewInt(220_000_000), settlement.TotalRefundedAmount)
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_CloseWhenSoldOut() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		endTime,
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		sdk.ZeroInt(),
+		true,
+		nil,
+		nil,
+		false,
+	))
+	s.Require().NoError(err)
+	s.Require().True(a.(*types.FixedPriceAuction).CloseWhenSoldOut)
+
+	// The end time is kept until the selling coin is sold out
+	s.placeBidFixedPrice(a.GetId(), s.addr(1), parseDec("1"), parseCoin("600_000_000denom2"), true)
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal([]time.Time{endTime}, auction.GetEndTimes())
+	s.Require().Empty(s.keeper.GetAuctionsToClose(s.ctx, s.ctx.BlockTime()))
+
+	s.placeBidFixedPrice(a.GetId(), s.addr(2), parseDec("1"), parseCoin("400_000_000denom1"), true)
+	auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().True(auction.(*types.FixedPriceAuction).RemainingSellingCoin.IsZero())
+	s.Require().Equal([]time.Time{s.ctx.BlockTime()}, auction.GetEndTimes())
+
+	// The auction is closed at the next block
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(5 * time.Second))
+	auctions := s.keeper.GetAuctionsToClose(s.ctx, s.ctx.BlockTime())
+	s.Require().Len(auctions, 1)
+	s.Require().NoError(s.keeper.ExecuteStartedStatus(s.ctx, auctions[0]))
+
+	auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+	s.Require().Equal(parseCoin("600_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("400_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().Equal(parseCoin("1_000_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+}
+
+func (s *KeeperTestSuite) TestFixedPriceAuction_BidderVesting() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	bidderVestingSchedules := []types.VestingSchedule{
+		{ReleaseTime: endTime.AddDate(0, 1, 0), Weight: parseDec("0.3")},
+		{ReleaseTime: endTime.AddDate(0, 2, 0), Weight: parseDec("0.7")},
+	}
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		endTime,
+		false,
+		true,
+		sellingCoin.Amount,
+		nil,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		bidderVestingSchedules,
+		nil,
+		false,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(bidderVestingSchedules, a.GetBidderVestingSchedules())
+
+	s.placeBidFixedPrice(a.GetId(), s.addr(1), parseDec("1"), parseCoin("600_000_000denom2"), true)
+	s.placeBidFixedPrice(a.GetId(), s.addr(2), parseDec("1"), parseCoin("400_000_000denom1"), true)
+
+	// The allocated selling coin is locked in the bidder vesting reserve account at close
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "denom1").IsZero())
+	s.Require().Equal(sellingCoin, s.getBalance(auction.GetBidderVestingReserveAddress(), "denom1"))
+	s.Require().Equal(parseCoin("1_000_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+
+	queues := s.keeper.GetBidderVestingQueuesByAuctionId(s.ctx, a.GetId())
+	s.Require().Len(queues, 4)
+
+	resp, err := s.querier.BidderVestings(sdk.WrapSDKContext(s.ctx), &types.QueryBidderVestingsRequest{Bidder: s.addr(1).String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Vestings, 2)
+	s.Require().Equal(parseCoins("180_000_000denom1"), resp.Vestings[0].ReleaseCoins)
+	s.Require().Equal(parseCoins("420_000_000denom1"), resp.Vestings[1].ReleaseCoins)
+
+	_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// The first release
+	s.ctx = s.ctx.WithBlockTime(bidderVestingSchedules[0].ReleaseTime).WithEventManager(sdk.NewEventManager())
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	s.Require().Equal(2, s.countEvents(types.EventTypeReleaseBidderVesting))
+	s.Require().Equal(parseCoin("180_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("120_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+
+	resp, err = s.querier.BidderVestings(sdk.WrapSDKContext(s.ctx), &types.QueryBidderVestingsRequest{Bidder: s.addr(1).String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Vestings, 1)
+
+	// The last release
+	s.ctx = s.ctx.WithBlockTime(bidderVestingSchedules[1].ReleaseTime)
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	s.Require().Equal(parseCoin("600_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("400_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().True(s.getBalance(auction.GetBidderVestingReserveAddress(), "denom1").IsZero())
+	s.Require().Empty(s.keeper.GetAuctionsToReleaseToBidders(s.ctx, s.ctx.BlockTime()))
+
+	resp, err = s.querier.BidderVestings(sdk.WrapSDKContext(s.ctx), &types.QueryBidderVestingsRequest{Bidder: s.addr(1).String()})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Vestings)
+}
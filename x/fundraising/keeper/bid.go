@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,6 +17,22 @@ func (k Keeper) GetNextBidIdWithUpdate(ctx sdk.Context, auctionId uint64) uint64
 	return id
 }
 
+// GetMaxBidAmount returns the maximum bid amount of the bidder for the auction.
+// The allowed bidder's maximum bid amount takes precedence and the default maximum bid amount
+// is applied to any other bidder if the auction is open for bidding.
+func (k Keeper) GetMaxBidAmount(ctx sdk.Context, auction types.AuctionI, bidderAddr sdk.AccAddress) (maxBidAmt sdk.Int, found bool) {
+	allowedBidder, found := k.GetAllowedBidder(ctx, auction.GetId(), bidderAddr)
+	if found {
+		return allowedBidder.MaxBidAmount, true
+	}
+
+	if auction.GetOpenBidding() {
+		return auction.GetDefaultMaxBidAmount(), true
+	}
+
+	return sdk.Int{}, false
+}
+
 // PlaceBid places a bid for the selling coin of the auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, msg *types.MsgPlaceBid) (types.Bid, error) {
 	auction, found := k.GetAuction(ctx, msg.AuctionId)
@@ -28,13 +45,16 @@ func (k Keeper) PlaceBid(ctx sdk.Context, msg *types.MsgPlaceBid) (types.Bid, er
 	}
 
 	if auction.GetType() == types.AuctionTypeBatch {
+		if auction.(*types.BatchAuction).IsSealed() {
+			return types.Bid{}, sdkerrors.Wrap(types.ErrIncorrectAuctionType, "bids must be committed and revealed for the sealed bid auction")
+		}
+
 		if msg.Price.LT(auction.(*types.BatchAuction).MinBidPrice) {
 			return types.Bid{}, types.ErrInsufficientMinBidPrice
 		}
 	}
 
-	_, found = k.GetAllowedBidder(ctx, auction.GetId(), msg.GetBidder())
-	if !found {
+	if _, found = k.GetMaxBidAmount(ctx, auction, msg.GetBidder()); !found {
 		return types.Bid{}, types.ErrNotAllowedBidder
 	}
 
@@ -53,6 +73,7 @@ func (k Keeper) PlaceBid(ctx sdk.Context, msg *types.MsgPlaceBid) (types.Bid, er
 	}
 
 	payingCoinDenom := auction.GetPayingCoinDenom()
+	payingCoinRates := auction.GetPayingCoinRates()
 
 	// Place a bid depending on the bid type
 	switch bid.Type {
@@ -64,20 +85,49 @@ func (k Keeper) PlaceBid(ctx sdk.Context, msg *types.MsgPlaceBid) (types.Bid, er
 		fa := auction.(*types.FixedPriceAuction)
 
 		// Reserve bid amount
-		bidPayingAmt := bid.ConvertToPayingAmount(payingCoinDenom)
-		bidPayingCoin := sdk.NewCoin(payingCoinDenom, bidPayingAmt)
+		bidPayingCoin := bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates)
 		if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), bidPayingCoin); err != nil {
 			return types.Bid{}, sdkerrors.Wrap(err, "failed to reserve paying coin")
 		}
 
 		// Subtract bid amount from the remaining
-		bidSellingAmt := bid.ConvertToSellingAmount(payingCoinDenom)
+		bidSellingAmt := bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates)
 		bidSellingCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidSellingAmt)
 		fa.RemainingSellingCoin = fa.RemainingSellingCoin.Sub(bidSellingCoin)
 
+		// Set the end time to the current block time, so that the auction is closed at the next block
+		if fa.CloseWhenSoldOut && fa.RemainingSellingCoin.IsZero() {
+			_ = fa.SetEndTimes([]time.Time{ctx.BlockTime()})
+		}
+
 		k.SetAuction(ctx, fa)
 		bid.SetMatched(true)
 
+	case types.BidTypeDutch:
+		if err := k.ValidateDutchBid(ctx, auction, bid); err != nil {
+			return types.Bid{}, err
+		}
+
+		da := auction.(*types.DutchAuction)
+
+		// The bid price is the highest price that the bidder is willing to pay and
+		// the bid is filled at the current price of the auction
+		bid.Price = da.CurrentPrice(ctx.BlockTime())
+
+		// Reserve bid amount
+		bidPayingCoin := bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates)
+		if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), bidPayingCoin); err != nil {
+			return types.Bid{}, sdkerrors.Wrap(err, "failed to reserve paying coin")
+		}
+
+		// Subtract bid amount from the remaining
+		bidSellingAmt := bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates)
+		bidSellingCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidSellingAmt)
+		da.RemainingSellingCoin = da.RemainingSellingCoin.Sub(bidSellingCoin)
+
+		k.SetAuction(ctx, da)
+		bid.SetMatched(true)
+
 	case types.BidTypeBatchWorth:
 		if err := k.ValidateBatchWorthBid(ctx, auction, bid); err != nil {
 			return types.Bid{}, err
@@ -92,8 +142,7 @@ func (k Keeper) PlaceBid(ctx sdk.Context, msg *types.MsgPlaceBid) (types.Bid, er
 			return types.Bid{}, err
 		}
 
-		reserveAmt := bid.ConvertToPayingAmount(payingCoinDenom)
-		reserveCoin := sdk.NewCoin(payingCoinDenom, reserveAmt)
+		reserveCoin := bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates)
 
 		if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), reserveCoin); err != nil {
 			return types.Bid{}, sdkerrors.Wrap(err, "failed to reserve paying coin")
@@ -110,11 +159,22 @@ func (k Keeper) PlaceBid(ctx sdk.Context, msg *types.MsgPlaceBid) (types.Bid, er
 			types.EventTypePlaceBid,
 			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
 			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.GetBidder().String()),
-			sdk.NewAttribute(types.AttributeKeyBidPrice, msg.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyBidPrice, bid.Price.String()),
 			sdk.NewAttribute(types.AttributeKeyBidCoin, msg.Coin.String()),
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPlaceBid{
+		AuctionId: auction.GetId(),
+		Bidder:    msg.Bidder,
+		BidId:     bid.Id,
+		BidType:   bid.Type,
+		Price:     bid.Price,
+		Coin:      msg.Coin,
+	}); err != nil {
+		return types.Bid{}, err
+	}
+
 	return bid, nil
 }
 
@@ -124,7 +184,7 @@ func (k Keeper) ValidateFixedPriceBid(ctx sdk.Context, auction types.AuctionI, b
 		return types.ErrIncorrectAuctionType
 	}
 
-	if bid.Coin.Denom != auction.GetPayingCoinDenom() &&
+	if !auction.IsPayingCoinDenom(bid.Coin.Denom) &&
 		bid.Coin.Denom != auction.GetSellingCoin().Denom {
 		return types.ErrIncorrectCoinDenom
 	}
@@ -134,7 +194,7 @@ func (k Keeper) ValidateFixedPriceBid(ctx sdk.Context, auction types.AuctionI, b
 	}
 
 	// For remaining coin validation, convert bid amount in selling coin denom
-	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
 	bidCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidAmt)
 	remainingCoin := auction.(*types.FixedPriceAuction).RemainingSellingCoin
 
@@ -142,24 +202,63 @@ func (k Keeper) ValidateFixedPriceBid(ctx sdk.Context, auction types.AuctionI, b
 		return sdkerrors.Wrapf(types.ErrInsufficientRemainingAmount, "remaining selling coin amount %s", remainingCoin)
 	}
 
-	// Get the total bid amount by the bidder
-	totalBidAmt := sdk.ZeroInt()
-	for _, bid := range k.GetBidsByBidder(ctx, bid.GetBidder()) {
-		if bid.AuctionId == auction.GetId() {
-			bidSellingAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
-			totalBidAmt = totalBidAmt.Add(bidSellingAmt)
-		}
+	return k.ValidateMaxBidAmount(ctx, auction, bid.GetBidder(), bidAmt, sdk.ZeroInt())
+}
+
+// ValidateDutchBid validates a dutch bid type.
+// The bid price is the highest price that the bidder is willing to pay and
+// it must not be lower than the current price of the auction.
+func (k Keeper) ValidateDutchBid(ctx sdk.Context, auction types.AuctionI, bid types.Bid) error {
+	if auction.GetType() != types.AuctionTypeDutch {
+		return types.ErrIncorrectAuctionType
+	}
+
+	if !auction.IsPayingCoinDenom(bid.Coin.Denom) &&
+		bid.Coin.Denom != auction.GetSellingCoin().Denom {
+		return types.ErrIncorrectCoinDenom
+	}
+
+	da := auction.(*types.DutchAuction)
+	currentPrice := da.CurrentPrice(ctx.BlockTime())
+
+	if bid.Price.LT(currentPrice) {
+		return sdkerrors.Wrapf(types.ErrInsufficientMinBidPrice, "bid price must not be lower than the current price %s", currentPrice)
+	}
+
+	// Convert bid amount in selling coin denom at the current price,
+	// which is recorded as the price of the bid by PlaceBid
+	filledBid := bid
+	filledBid.Price = currentPrice
+	bidAmt := filledBid.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+	bidCoin := sdk.NewCoin(auction.GetSellingCoin().Denom, bidAmt)
+
+	if !bidAmt.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid amount must be greater than zero at the current price")
 	}
 
-	allowedBidder, found := k.GetAllowedBidder(ctx, bid.AuctionId, bid.GetBidder())
+	if da.RemainingSellingCoin.IsLT(bidCoin) {
+		return sdkerrors.Wrapf(types.ErrInsufficientRemainingAmount, "remaining selling coin amount %s", da.RemainingSellingCoin)
+	}
+
+	return k.ValidateMaxBidAmount(ctx, auction, bid.GetBidder(), bidAmt, sdk.ZeroInt())
+}
+
+// ValidateMaxBidAmount validates that the total selling amount that the bidder requests for the auction
+// doesn't exceed their maximum bid amount when the bid amount is added to the running total in
+// the bidder aggregate. The previous bid amount is subtracted from the total for a modified bid.
+func (k Keeper) ValidateMaxBidAmount(ctx sdk.Context, auction types.AuctionI, bidderAddr sdk.AccAddress, bidAmt, prevBidAmt sdk.Int) error {
+	maxBidAmt, found := k.GetMaxBidAmount(ctx, auction, bidderAddr)
 	if !found {
 		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bidder is not found in allowed bidder list")
 	}
 
-	totalBidAmt = totalBidAmt.Add(bidAmt)
+	totalBidAmt := bidAmt
+	if aggregate, found := k.GetBidderAggregate(ctx, auction.GetId(), bidderAddr); found {
+		totalBidAmt = aggregate.SellingAmount.Sub(prevBidAmt).Add(bidAmt)
+	}
 
 	// The total bid amount can't be greater than the bidder's maximum bid amount
-	if totalBidAmt.GT(allowedBidder.MaxBidAmount) {
+	if totalBidAmt.GT(maxBidAmt) {
 		return types.ErrOverMaxBidAmountLimit
 	}
 
@@ -172,23 +271,13 @@ func (k Keeper) ValidateBatchWorthBid(ctx sdk.Context, auction types.AuctionI, b
 		return types.ErrIncorrectAuctionType
 	}
 
-	if bid.Coin.Denom != auction.GetPayingCoinDenom() {
+	if !auction.IsPayingCoinDenom(bid.Coin.Denom) {
 		return types.ErrIncorrectCoinDenom
 	}
 
-	allowedBidder, found := k.GetAllowedBidder(ctx, bid.AuctionId, bid.GetBidder())
-	if !found {
-		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bidder is not found in allowed bidder list")
-	}
-
-	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
 
-	// The total bid amount can't be greater than the bidder's maximum bid amount
-	if bidAmt.GT(allowedBidder.MaxBidAmount) {
-		return types.ErrOverMaxBidAmountLimit
-	}
-
-	return nil
+	return k.ValidateMaxBidAmount(ctx, auction, bid.GetBidder(), bidAmt, sdk.ZeroInt())
 }
 
 // ValidateBatchManyBid validates a batch many bid type.
@@ -201,24 +290,15 @@ func (k Keeper) ValidateBatchManyBid(ctx sdk.Context, auction types.AuctionI, bi
 		return types.ErrIncorrectCoinDenom
 	}
 
-	allowedBidder, found := k.GetAllowedBidder(ctx, bid.AuctionId, bid.GetBidder())
-	if !found {
-		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bidder is not found in allowed bidder list")
-	}
-
-	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+	bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
 
-	// The total bid amount can't be greater than the bidder's maximum bid amount
-	if bidAmt.GT(allowedBidder.MaxBidAmount) {
-		return types.ErrOverMaxBidAmountLimit
-	}
-
-	return nil
+	return k.ValidateMaxBidAmount(ctx, auction, bid.GetBidder(), bidAmt, sdk.ZeroInt())
 }
 
 // ModifyBid handles types.MsgModifyBid and stores the modified bid.
-// A bidder must provide either greater bid price or coin amount.
-// They are not permitted to modify with less bid price or coin amount.
+// A bidder must provide either different bid price or coin amount.
+// They are permitted to modify with less bid price or coin amount only when
+// the auction allows bid cancellation and the difference is refunded.
 func (k Keeper) ModifyBid(ctx sdk.Context, msg *types.MsgModifyBid) error {
 	auction, found := k.GetAuction(ctx, msg.AuctionId)
 	if !found {
@@ -233,6 +313,10 @@ func (k Keeper) ModifyBid(ctx sdk.Context, msg *types.MsgModifyBid) error {
 		return types.ErrIncorrectAuctionType
 	}
 
+	if auction.(*types.BatchAuction).IsSealed() {
+		return sdkerrors.Wrap(types.ErrBidLocked, "revealed bids of the sealed bid auction cannot be modified")
+	}
+
 	bid, found := k.GetBid(ctx, msg.AuctionId, msg.BidId)
 	if !found {
 		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bid not found")
@@ -250,32 +334,40 @@ func (k Keeper) ModifyBid(ctx sdk.Context, msg *types.MsgModifyBid) error {
 		return types.ErrIncorrectCoinDenom
 	}
 
+	if msg.Price.Equal(bid.Price) && msg.Coin.Amount.Equal(bid.Coin.Amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid price and coin amount must be changed")
+	}
+
 	if msg.Price.LT(bid.Price) || msg.Coin.Amount.LT(bid.Coin.Amount) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid price or coin amount cannot be lower")
+		if err := k.ValidateBidCancellation(ctx, auction); err != nil {
+			return err
+		}
 	}
 
-	if msg.Price.Equal(bid.Price) && msg.Coin.Amount.Equal(bid.Coin.Amount) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid price and coin amount must be changed")
+	payingCoinDenom := auction.GetPayingCoinDenom()
+	payingCoinRates := auction.GetPayingCoinRates()
+	modifiedBid := types.Bid{Type: bid.Type, Price: msg.Price, Coin: msg.Coin}
+
+	prevBidAmt := bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates)
+	currBidAmt := modifiedBid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates)
+	if err := k.ValidateMaxBidAmount(ctx, auction, msg.GetBidder(), currBidAmt, prevBidAmt); err != nil {
+		return err
 	}
 
-	// Reserve bid amount difference
-	switch bid.Type {
-	case types.BidTypeBatchWorth:
-		diffReserveCoin := msg.Coin.Sub(bid.Coin)
-		if diffReserveCoin.IsPositive() {
-			if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), diffReserveCoin); err != nil {
-				return sdkerrors.Wrap(err, "failed to reserve paying coin")
-			}
+	// Reserve or refund bid amount difference in the denom of the reserved paying coin
+	prevReserveCoin := bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates)
+	currReserveCoin := modifiedBid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates)
+
+	switch {
+	case prevReserveCoin.IsLT(currReserveCoin):
+		diffReserveCoin := currReserveCoin.Sub(prevReserveCoin)
+		if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), diffReserveCoin); err != nil {
+			return sdkerrors.Wrap(err, "failed to reserve paying coin")
 		}
-	case types.BidTypeBatchMany:
-		prevReserveAmt := sdk.NewDecFromInt(bid.Coin.Amount).Mul(bid.Price).Ceil()
-		currReserveAmt := sdk.NewDecFromInt(msg.Coin.Amount).Mul(msg.Price).Ceil()
-		diffReserveAmt := currReserveAmt.Sub(prevReserveAmt).TruncateInt()
-		diffReserveCoin := sdk.NewCoin(auction.GetPayingCoinDenom(), diffReserveAmt)
-		if diffReserveCoin.IsPositive() {
-			if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), diffReserveCoin); err != nil {
-				return sdkerrors.Wrap(err, "failed to reserve paying coin")
-			}
+	case currReserveCoin.IsLT(prevReserveCoin):
+		diffRefundCoin := prevReserveCoin.Sub(currReserveCoin)
+		if err := k.ReleasePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), diffRefundCoin); err != nil {
+			return sdkerrors.Wrap(err, "failed to release paying coin")
 		}
 	}
 
@@ -287,5 +379,116 @@ func (k Keeper) ModifyBid(ctx sdk.Context, msg *types.MsgModifyBid) error {
 
 	k.SetBid(ctx, bid)
 
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeModifyBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(bid.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyBidId, strconv.FormatUint(bid.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyBidCoin, bid.Coin.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventModifyBid{
+		AuctionId: bid.AuctionId,
+		Bidder:    msg.Bidder,
+		BidId:     bid.Id,
+		Price:     bid.Price,
+		Coin:      bid.Coin,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CancelBid handles types.MsgCancelBid and deletes the bid.
+// The paying coin reserved for the bid is refunded to the bidder.
+func (k Keeper) CancelBid(ctx sdk.Context, msg *types.MsgCancelBid) error {
+	auction, found := k.GetAuction(ctx, msg.AuctionId)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "auction not found")
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return types.ErrInvalidAuctionStatus
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch {
+		return types.ErrIncorrectAuctionType
+	}
+
+	if auction.(*types.BatchAuction).IsSealed() {
+		return sdkerrors.Wrap(types.ErrBidLocked, "revealed bids of the sealed bid auction cannot be cancelled")
+	}
+
+	bid, found := k.GetBid(ctx, msg.AuctionId, msg.BidId)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bid not found")
+	}
+
+	if !bid.GetBidder().Equals(msg.GetBidder()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the bid creator can cancel the bid")
+	}
+
+	if err := k.ValidateBidCancellation(ctx, auction); err != nil {
+		return err
+	}
+
+	refundCoin := bid.ConvertToPayingCoin(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+
+	if err := k.ReleasePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), refundCoin); err != nil {
+		return sdkerrors.Wrap(err, "failed to release paying coin")
+	}
+
+	// Call the before bid canceled hook
+	k.BeforeBidCanceled(ctx, bid.AuctionId, bid.Id, bid.Bidder)
+
+	k.DeleteBid(ctx, bid)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyBidId, strconv.FormatUint(bid.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundCoin, refundCoin.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelBid{
+		AuctionId:  auction.GetId(),
+		Bidder:     msg.Bidder,
+		BidId:      bid.Id,
+		RefundCoin: refundCoin,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateBidCancellation validates if bids of the batch auction can be cancelled or lowered.
+// To prevent from auction sniping technique, bids can't be cancelled or lowered
+// in the final extended round of the auction nor after the bid cancellation cutoff
+// before the end time of the auction.
+func (k Keeper) ValidateBidCancellation(ctx sdk.Context, auction types.AuctionI) error {
+	ba, ok := auction.(*types.BatchAuction)
+	if !ok {
+		return types.ErrIncorrectAuctionType
+	}
+
+	endTimes := ba.GetEndTimes()
+	if ba.MaxExtendedRound > 0 && ba.MaxExtendedRound+1 == uint32(len(endTimes)) {
+		return sdkerrors.Wrap(types.ErrBidLocked, "bids cannot be cancelled or lowered in the final extended round")
+	}
+
+	cutoff := k.GetParams(ctx).BidCancellationCutoff
+	lastEndTime := endTimes[len(endTimes)-1]
+	if !ctx.BlockTime().Before(lastEndTime.Add(-cutoff)) {
+		return sdkerrors.Wrapf(types.ErrBidLocked, "bids cannot be cancelled or lowered within %s before the end time", cutoff)
+	}
+
 	return nil
 }
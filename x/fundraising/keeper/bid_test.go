@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
 
@@ -189,6 +190,105 @@ func (s *KeeperTestSuite) TestFixedPrice_IncorrectAuctionType() {
 	s.Require().ErrorIs(err, types.ErrIncorrectAuctionType)
 }
 
+func (s *KeeperTestSuite) TestDutch_InsufficientBidPrice() {
+	startTime := s.ctx.BlockTime().Add(-30 * time.Minute)
+
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("2"),
+		parseDec("1"),
+		parseDec("0.1"),
+		time.Hour,
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		startTime,
+		startTime.AddDate(0, 0, 1),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.fundAddr(s.addr(1), parseCoins("200_000_000denom2"))
+	s.addAllowedBidder(auction.Id, s.addr(1), bidSellingAmount(parseDec("2"), parseCoin("200_000_000denom2")))
+
+	// The price hasn't decayed yet
+	_, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("1.9"),
+		Coin:      parseCoin("200_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrInsufficientMinBidPrice)
+
+	// The bid is filled at the current price, not the bid price
+	bid, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("5"),
+		Coin:      parseCoin("200_000_000denom2"),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("2"), bid.Price)
+}
+
+func (s *KeeperTestSuite) TestDutch_InsufficientRemainingAmount() {
+	auction := s.createDutchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		time.Hour,
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime(),
+		s.ctx.BlockTime().AddDate(0, 0, 1),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.placeBidDutch(auction.Id, s.addr(1), parseDec("1"), parseCoin("800_000_000denom1"), true)
+
+	s.fundAddr(s.addr(2), parseCoins("300_000_000denom2"))
+	s.addAllowedBidder(auction.Id, s.addr(2), parseInt("300_000_000"))
+
+	_, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(2).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("1"),
+		Coin:      parseCoin("300_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrInsufficientRemainingAmount)
+}
+
+func (s *KeeperTestSuite) TestDutch_IncorrectAuctionType() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	s.fundAddr(s.addr(1), parseCoins("200_000_000denom2"))
+	s.addAllowedBidder(auction.Id, s.addr(1), parseInt("200_000_000"))
+
+	_, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeDutch,
+		Price:     parseDec("1"),
+		Coin:      parseCoin("200_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrIncorrectAuctionType)
+}
+
 func (s *KeeperTestSuite) TestBatchAuction_IncorrectCoinDenom() {
 	auction := s.createBatchAuction(
 		s.addr(1),
@@ -294,6 +394,224 @@ func (s *KeeperTestSuite) TestBatchMany_OverMaxBidAmountLimit() {
 	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
 }
 
+func (s *KeeperTestSuite) TestBatchAuction_CumulativeMaxBidAmountLimit() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.5"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.fundAddr(s.addr(1), parseCoins("1_000_000_000denom2"))
+	s.keeper.SetAllowedBidder(s.ctx, auction.Id, types.NewAllowedBidder(s.addr(1), sdk.NewInt(500_000_000)))
+
+	// Each bid is within the limit, but the sum of them is not
+	bid, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeBatchWorth,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("100_000_000denom2"),
+	})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeBatchMany,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("400_000_000denom1"),
+	})
+	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeBatchMany,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("300_000_000denom1"),
+	})
+	s.Require().NoError(err)
+
+	// Modifying the bid only counts the difference from the previous bid
+	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     bid.Id,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("110_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+
+	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     bid.Id,
+		Price:     parseDec("0.625"),
+		Coin:      parseCoin("125_000_000denom2"),
+	})
+	s.Require().NoError(err)
+
+	// Cancelling the bid releases its amount from the limit
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     bid.Id,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeBatchMany,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("200_000_000denom1"),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestFixedPrice_OpenBidding() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	s.fundAddr(auctioneer, s.keeper.GetParams(s.ctx).AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateFixedPriceAuction(s.ctx, &types.MsgCreateFixedPriceAuction{
+		Auctioneer:          auctioneer.String(),
+		StartPrice:          parseDec("0.5"),
+		SellingCoin:         sellingCoin,
+		PayingCoinDenom:     "denom2",
+		VestingSchedules:    []types.VestingSchedule{},
+		StartTime:           time.Now().AddDate(0, 0, -1),
+		EndTime:             time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		OpenBidding:         true,
+		DefaultMaxBidAmount: parseInt("100_000_000"),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	// Any address can place a bid without being an allowed bidder
+	s.fundAddr(s.addr(1), parseCoins("100_000_000denom2"))
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeFixedPrice,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("30_000_000denom2"),
+	})
+	s.Require().NoError(err)
+
+	// The total bid amount can't be greater than the default maximum bid amount
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeFixedPrice,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("50_000_000denom1"),
+	})
+	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+
+	// The allowed bidder's maximum bid amount takes precedence
+	s.addAllowedBidder(auction.GetId(), s.addr(2), parseInt("300_000_000"))
+	s.fundAddr(s.addr(2), parseCoins("100_000_000denom2"))
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(2).String(),
+		BidType:   types.BidTypeFixedPrice,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("200_000_000denom1"),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestBatchAuction_OpenBidding() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	s.fundAddr(auctioneer, s.keeper.GetParams(s.ctx).AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateBatchAuction(s.ctx, &types.MsgCreateBatchAuction{
+		Auctioneer:          auctioneer.String(),
+		StartPrice:          parseDec("0.5"),
+		MinBidPrice:         parseDec("0.1"),
+		SellingCoin:         sellingCoin,
+		PayingCoinDenom:     "denom2",
+		VestingSchedules:    []types.VestingSchedule{},
+		MaxExtendedRound:    1,
+		ExtendedRoundRate:   parseDec("0.2"),
+		StartTime:           time.Now().AddDate(0, 0, -1),
+		EndTime:             time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		OpenBidding:         true,
+		DefaultMaxBidAmount: parseInt("300_000_000"),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	// Place a BidTypeBatchWorth bid with more than the default maximum bid amount
+	s.fundAddr(s.addr(1), parseCoins("1_000_000_000denom2"))
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeBatchWorth,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("200_000_000denom2"),
+	})
+	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+
+	// Place a BidTypeBatchMany bid with more than the default maximum bid amount
+	_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidType:   types.BidTypeBatchMany,
+		Price:     parseDec("0.5"),
+		Coin:      parseCoin("400_000_000denom1"),
+	})
+	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+
+	for _, bidder := range []sdk.AccAddress{s.addr(1), s.addr(2)} {
+		s.fundAddr(bidder, parseCoins("1_000_000_000denom2"))
+		_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+			AuctionId: auction.GetId(),
+			Bidder:    bidder.String(),
+			BidType:   types.BidTypeBatchMany,
+			Price:     parseDec("0.5"),
+			Coin:      parseCoin("200_000_000denom1"),
+		})
+		s.Require().NoError(err)
+
+		// The total bid amount of the bidder can't exceed the default maximum bid amount
+		_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+			AuctionId: auction.GetId(),
+			Bidder:    bidder.String(),
+			BidType:   types.BidTypeBatchMany,
+			Price:     parseDec("0.5"),
+			Coin:      parseCoin("200_000_000denom1"),
+		})
+		s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+		_, err = s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+			AuctionId: auction.GetId(),
+			Bidder:    bidder.String(),
+			BidType:   types.BidTypeBatchMany,
+			Price:     parseDec("0.5"),
+			Coin:      parseCoin("100_000_000denom1"),
+		})
+		s.Require().NoError(err)
+	}
+
+	// Each bidder gets up to the default maximum bid amount when matching
+	mInfo := s.keeper.CalculateBatchAllocation(s.ctx, auction)
+	s.Require().Equal(parseDec("0.5"), mInfo.MatchedPrice)
+	s.Require().Equal(parseInt("600_000_000"), mInfo.TotalMatchedAmount)
+	s.Require().Equal(parseInt("300_000_000"), mInfo.AllocationMap[s.addr(1).String()])
+	s.Require().Equal(parseInt("300_000_000"), mInfo.AllocationMap[s.addr(2).String()])
+}
+
 func (s *KeeperTestSuite) TestModifyBid_Validation() {
 	err := s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
 		AuctionId: 1,
@@ -388,35 +706,40 @@ func (s *KeeperTestSuite) TestModifyBid_BidTypeWorth() {
 	})
 	s.Require().ErrorIs(err, types.ErrIncorrectCoinDenom)
 
-	// Modify the bid with lower bid price
+	// No modification
 	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
 		AuctionId: a.Id,
 		Bidder:    s.addr(1).String(),
 		BidId:     b.Id,
-		Price:     parseDec("0.3"),
+		Price:     parseDec("0.6"),
 		Coin:      parseCoin("100_000_000denom2"),
 	})
 	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
 
-	// Modify the bid with lower coin amount
+	// Modify the bid with lower bid price
 	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
 		AuctionId: a.Id,
 		Bidder:    s.addr(1).String(),
 		BidId:     b.Id,
-		Price:     parseDec("0.8"),
-		Coin:      parseCoin("100denom2"),
+		Price:     parseDec("0.3"),
+		Coin:      parseCoin("100_000_000denom2"),
 	})
-	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().NoError(err)
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
 
-	// No modification
+	// Modify the bid with lower coin amount and the difference is refunded
 	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
 		AuctionId: a.Id,
 		Bidder:    s.addr(1).String(),
 		BidId:     b.Id,
-		Price:     parseDec("0.6"),
-		Coin:      parseCoin("100_000_000denom2"),
+		Price:     parseDec("0.3"),
+		Coin:      parseCoin("40_000_000denom2"),
 	})
-	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("60_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("40_000_000denom2"), s.getBalance(a.GetPayingReserveAddress(), "denom2"))
+	s.Require().Equal(2, s.countEvents(types.EventTypeModifyBid))
+	s.Require().Equal(2, s.countEvents(proto.MessageName(&types.EventModifyBid{})))
 }
 
 func (s *KeeperTestSuite) TestModifyBid_BidTypeMany() {
@@ -461,3 +784,182 @@ func (s *KeeperTestSuite) TestModifyBid_BidTypeMany() {
 	})
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestModifyBid_LowerAfterCutoff() {
+	a := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, a.GetStatus())
+
+	b := s.placeBidBatchMany(a.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	// Move the block time after the bid cancellation cutoff
+	cutoff := s.keeper.GetParams(s.ctx).BidCancellationCutoff
+	s.ctx = s.ctx.WithBlockTime(a.GetEndTimes()[0].Add(-cutoff))
+
+	err := s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+		Price:     parseDec("0.4"),
+		Coin:      parseCoin("100_000_000denom1"),
+	})
+	s.Require().ErrorIs(err, types.ErrBidLocked)
+
+	// Raising the bid is still allowed
+	s.fundAddr(s.addr(1), parseCoins("10_000_000denom2"))
+	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+		Price:     parseDec("0.6"),
+		Coin:      parseCoin("100_000_000denom1"),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCancelBid() {
+	a := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, a.GetStatus())
+
+	b1 := s.placeBidBatchWorth(a.Id, s.addr(1), parseDec("0.6"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	b2 := s.placeBidBatchMany(a.Id, s.addr(2), parseDec("0.5"), parseCoin("100_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	// Cancel the bid that doesn't exist
+	err := s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     5,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// Cancel the bid with an incorrect owner
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(2).String(),
+		BidId:     b1.Id,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Cancel the bids and the reserved paying coins are refunded
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b1.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("100_000_000denom2"), s.getBalance(s.addr(1), "denom2"))
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(2).String(),
+		BidId:     b2.Id,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("50_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(a.GetPayingReserveAddress(), "denom2").IsZero())
+
+	_, found := s.keeper.GetBid(s.ctx, a.Id, b1.Id)
+	s.Require().False(found)
+	s.Require().Len(s.keeper.GetBidsByAuctionId(s.ctx, a.Id), 0)
+	s.Require().Len(s.keeper.GetBidsByBidder(s.ctx, s.addr(1)), 0)
+}
+
+func (s *KeeperTestSuite) TestCancelBid_Validation() {
+	fa := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	fb := s.placeBidFixedPrice(fa.Id, s.addr(1), parseDec("1"), parseCoin("1_000_000denom2"), true)
+
+	err := s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: fa.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     fb.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrIncorrectAuctionType)
+
+	a := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	b := s.placeBidBatchWorth(a.Id, s.addr(1), parseDec("0.6"), parseCoin("100_000_000denom4"), sdk.NewInt(1_000_000_000), true)
+
+	// Bids can't be cancelled after the bid cancellation cutoff
+	cutoff := s.keeper.GetParams(s.ctx).BidCancellationCutoff
+	s.ctx = s.ctx.WithBlockTime(a.GetEndTimes()[0].Add(-cutoff).Add(time.Second))
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrBidLocked)
+
+	// Bids can't be cancelled in the final extended round even without the cutoff
+	params := s.keeper.GetParams(s.ctx)
+	params.BidCancellationCutoff = 0
+	s.keeper.SetParams(s.ctx, params)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.Id)
+	s.Require().True(found)
+	s.Require().NoError(s.keeper.ExtendRound(s.ctx, auction.(*types.BatchAuction)))
+	s.ctx = s.ctx.WithBlockTime(a.GetEndTimes()[0].Add(time.Second))
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrBidLocked)
+
+	// Bids can't be cancelled when the auction is not started
+	auction, found = s.keeper.GetAuction(s.ctx, a.Id)
+	s.Require().True(found)
+	s.Require().NoError(auction.SetStatus(types.AuctionStatusCancelled))
+	s.keeper.SetAuction(s.ctx, auction)
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: a.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     b.Id,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
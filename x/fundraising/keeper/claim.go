@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// addAllocationClaim adds the allocated coins and the refund coins to the allocation claim of the bidder.
+func (k Keeper) addAllocationClaim(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress, allocatedCoins, refundCoins sdk.Coins) {
+	claim, found := k.GetAllocationClaim(ctx, auctionId, bidderAddr)
+	if !found {
+		claim = types.NewAllocationClaim(auctionId, bidderAddr, sdk.Coins{}, sdk.Coins{})
+	}
+	claim.AllocatedCoins = claim.AllocatedCoins.Add(allocatedCoins...)
+	claim.RefundCoins = claim.RefundCoins.Add(refundCoins...)
+	k.SetAllocationClaim(ctx, claim)
+}
+
+// ClaimAllocation sends the allocated selling coin and the refunded paying coin of the bidder
+// from the claim reserve account to the bidder. Anyone can claim on behalf of the bidder.
+func (k Keeper) ClaimAllocation(ctx sdk.Context, msg *types.MsgClaimAllocation) error {
+	auction, found := k.GetAuction(ctx, msg.AuctionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d not found", msg.AuctionId)
+	}
+
+	if !auction.GetClaimMode() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction %d is not in claim mode", msg.AuctionId)
+	}
+
+	claim, found := k.GetAllocationClaim(ctx, auction.GetId(), msg.GetBidder())
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "allocation claim of bidder %s not found", msg.Bidder)
+	}
+
+	if claim.Claimed {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "allocation of bidder %s is already claimed", msg.Bidder)
+	}
+
+	if claimCoins := claim.ClaimCoins(); !claimCoins.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetClaimReserveAddress(), msg.GetBidder(), claimCoins); err != nil {
+			return sdkerrors.Wrap(err, "failed to claim the allocation")
+		}
+	}
+
+	claim.Claimed = true
+	k.SetAllocationClaim(ctx, claim)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimAllocation,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, claim.Bidder),
+			sdk.NewAttribute(types.AttributeKeyAllocatedCoin, claim.AllocatedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundCoin, claim.RefundCoins.String()),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAllocationClaimed{
+		AuctionId:      auction.GetId(),
+		Bidder:         claim.Bidder,
+		AllocatedCoins: claim.AllocatedCoins,
+		RefundCoins:    claim.RefundCoins,
+	})
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (s *KeeperTestSuite) TestClaimAllocation_BatchAuction() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		endTime,
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		true,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+	s.Require().True(a.GetClaimMode())
+
+	maxBidAmt := parseCoin("1_000_000_000denom1").Amount
+	s.placeBidBatchMany(a.GetId(), s.addr(1), parseDec("1"), parseCoin("600_000_000denom1"), maxBidAmt, true)
+	s.placeBidBatchMany(a.GetId(), s.addr(2), parseDec("0.9"), parseCoin("600_000_000denom1"), maxBidAmt, true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+
+	// Nothing is sent to the bidders when the auction closes
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "denom2").IsZero())
+	s.Require().Equal(parseCoin("600_000_000denom1"), s.getBalance(auction.GetClaimReserveAddress(), "denom1"))
+	s.Require().Equal(parseCoin("540_000_000denom2"), s.getBalance(auction.GetClaimReserveAddress(), "denom2"))
+
+	claim, found := s.keeper.GetAllocationClaim(s.ctx, a.GetId(), s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(parseCoins("600_000_000denom1"), claim.AllocatedCoins)
+	s.Require().True(claim.RefundCoins.IsZero())
+
+	claim, found = s.keeper.GetAllocationClaim(s.ctx, a.GetId(), s.addr(2))
+	s.Require().True(found)
+	s.Require().True(claim.AllocatedCoins.IsZero())
+	s.Require().Equal(parseCoins("540_000_000denom2"), claim.RefundCoins)
+
+	resp, err := s.querier.AllocationClaims(sdk.WrapSDKContext(s.ctx), &types.QueryAllocationClaimsRequest{AuctionId: a.GetId()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Claims, 2)
+
+	_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// The bidder claims its own allocation
+	_, err = s.msgServer.ClaimAllocation(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimAllocation(s.addr(1).String(), a.GetId(), s.addr(1).String()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("600_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+
+	// The allocation cannot be claimed twice
+	_, err = s.msgServer.ClaimAllocation(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimAllocation(s.addr(1).String(), a.GetId(), s.addr(1).String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Anyone can claim on behalf of the bidder, but the coins go to the bidder
+	_, err = s.msgServer.ClaimAllocation(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimAllocation(s.addr(3).String(), a.GetId(), s.addr(2).String()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("540_000_000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(s.addr(3), "denom2").IsZero())
+
+	// The bidder without any allocation has nothing to claim
+	_, err = s.msgServer.ClaimAllocation(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimAllocation(s.addr(4).String(), a.GetId(), s.addr(4).String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	resp, err = s.querier.AllocationClaims(sdk.WrapSDKContext(s.ctx), &types.QueryAllocationClaimsRequest{AuctionId: a.GetId()})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Claims)
+
+	claimResp, err := s.querier.AllocationClaim(sdk.WrapSDKContext(s.ctx), &types.QueryAllocationClaimRequest{AuctionId: a.GetId(), Bidder: s.addr(2).String()})
+	s.Require().NoError(err)
+	s.Require().True(claimResp.Claim.Claimed)
+
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, auction.GetClaimReserveAddress()).IsZero())
+
+	_, broken = keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestClaimAllocation_BidderVesting() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	// The claim mode can't be used with the bidder vesting schedules, since the allocated coins
+	// go to the bidder vesting queues while the refunded coins would go to the allocation claims
+	_, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		endTime,
+		false,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		sdk.ZeroInt(),
+		[]types.VestingSchedule{{ReleaseTime: endTime.AddDate(0, 1, 0), Weight: parseDec("1")}},
+		nil,
+		true,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().ErrorIs(err, types.ErrInvalidVestingSchedules)
+	s.Require().Len(s.keeper.GetAuctions(s.ctx), 0)
+	s.Require().Equal(params.AuctionCreationFee.Add(sellingCoin), s.app.BankKeeper.GetAllBalances(s.ctx, auctioneer))
+}
+
+func (s *KeeperTestSuite) TestClaimAllocation_NotClaimMode() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 1, 0),
+		true,
+	)
+	s.Require().False(auction.GetClaimMode())
+
+	_, err := s.msgServer.ClaimAllocation(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimAllocation(s.addr(1).String(), auction.GetId(), s.addr(1).String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.msgServer.ClaimAllocation(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimAllocation(s.addr(1).String(), 10, s.addr(1).String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
@@ -1,40 +1,257 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
 
 // ExecuteStandByStatus simply updates the auction status to AuctionStatusStarted
 // if the auction is ready to get started.
-func (k Keeper) ExecuteStandByStatus(ctx sdk.Context, auction types.AuctionI) {
+func (k Keeper) ExecuteStandByStatus(ctx sdk.Context, auction types.AuctionI) error {
 	if auction.ShouldAuctionStarted(ctx.BlockTime()) { // BlockTime >= StartTime
 		if err := auction.SetStatus(types.AuctionStatusStarted); err != nil {
-			panic(err)
+			return err
 		}
 		k.SetAuction(ctx, auction)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAuctionStarted,
+				sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+				sdk.NewAttribute(types.AttributeKeyStartTime, auction.GetStartTime().String()),
+			),
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAuctionStarted{
+			AuctionId: auction.GetId(),
+			StartTime: auction.GetStartTime(),
+		}); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 // ExecuteStartedStatus executes operations depending on the auction type.
-func (k Keeper) ExecuteStartedStatus(ctx sdk.Context, auction types.AuctionI) {
+func (k Keeper) ExecuteStartedStatus(ctx sdk.Context, auction types.AuctionI) error {
 	if auction.ShouldAuctionClosed(ctx.BlockTime()) { // BlockTime >= EndTime
 		switch auction.GetType() {
 		case types.AuctionTypeFixedPrice:
-			k.CloseFixedPriceAuction(ctx, auction)
+			return k.CloseFixedPriceAuction(ctx, auction)
 
 		case types.AuctionTypeBatch:
-			k.CloseBatchAuction(ctx, auction)
+			return k.CloseBatchAuction(ctx, auction)
+
+		case types.AuctionTypeDutch:
+			return k.CloseDutchAuction(ctx, auction)
 		}
 	}
+	return nil
 }
 
 // ExecuteVestingStatus first gets all vesting queues in the store and
 // look up the release time of each vesting queue to see if the module needs to
 // distribute the paying coin to the auctioneer.
-func (k Keeper) ExecuteVestingStatus(ctx sdk.Context, auction types.AuctionI) {
-	if err := k.ReleaseVestingPayingCoin(ctx, auction); err != nil {
-		panic(err)
+func (k Keeper) ExecuteVestingStatus(ctx sdk.Context, auction types.AuctionI) error {
+	return k.ReleaseVestingPayingCoin(ctx, auction)
+}
+
+// ExecuteIsolated runs the execution for the auction in a cached context and writes the state only when it succeeds.
+// If the execution returns an error or panics, the state is rolled back and the auction is marked as failed
+// with the reason, so that a single misbehaving auction doesn't halt the chain.
+// If the auction can't be marked as failed either, the error is logged and the auction is left as it was.
+func (k Keeper) ExecuteIsolated(ctx sdk.Context, auction types.AuctionI, execute func(sdk.Context, types.AuctionI) error) {
+	failedStatus := auction.GetStatus()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := executeWithRecover(cacheCtx, auction, execute); err != nil {
+		failCtx, writeFailure := ctx.CacheContext()
+		if failErr := k.FailAuction(failCtx, auction.GetId(), failedStatus, err.Error()); failErr != nil {
+			k.Logger(ctx).Error("failed to mark the auction as failed", "auction_id", auction.GetId(), "reason", err, "error", failErr)
+			return
+		}
+		writeFailure()
+		ctx.EventManager().EmitEvents(failCtx.EventManager().Events())
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// executeWithRecover runs the execution and converts a panic into an error.
+func executeWithRecover(ctx sdk.Context, auction types.AuctionI, execute func(sdk.Context, types.AuctionI) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return execute(ctx, auction)
+}
+
+// FailAuction updates the auction status to AuctionStatusFailed and stores the failure record with the reason.
+// The auction is read from the store again since the given auction may be modified by the failed execution.
+// The release time indexes of the auction are deleted until the auction is resolved.
+func (k Keeper) FailAuction(ctx sdk.Context, auctionId uint64, failedStatus types.AuctionStatus, reason string) error {
+	auction, found := k.GetAuction(ctx, auctionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d is not found", auctionId)
+	}
+
+	_ = auction.SetStatus(types.AuctionStatusFailed)
+	k.SetAuction(ctx, auction)
+	k.deleteReleaseTimeIndexes(ctx, auctionId)
+
+	k.SetAuctionFailure(ctx, types.AuctionFailure{
+		AuctionId:    auctionId,
+		FailedStatus: failedStatus,
+		Reason:       reason,
+		FailHeight:   ctx.BlockHeight(),
+		FailTime:     ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuctionFailed,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFailedStatus, failedStatus.String()),
+			sdk.NewAttribute(types.AttributeKeyFailureReason, reason),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAuctionFailed{
+		AuctionId:    auctionId,
+		FailedStatus: failedStatus,
+		Reason:       reason,
+	})
+}
+
+// ResolveFailedAuction handles types.MsgResolveFailedAuction and either retries the failed execution of the auction
+// or returns the reserved coins of the auction to their owners.
+// Only the authority of the keeper is allowed to resolve the failed auction.
+func (k Keeper) ResolveFailedAuction(ctx sdk.Context, msg *types.MsgResolveFailedAuction) error {
+	if k.authority != msg.Authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	auction, found := k.GetAuction(ctx, msg.AuctionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d is not found", msg.AuctionId)
+	}
+
+	if auction.GetStatus() != types.AuctionStatusFailed {
+		return sdkerrors.Wrapf(types.ErrInvalidAuctionStatus, "auction %d is not failed", msg.AuctionId)
+	}
+
+	failure, found := k.GetAuctionFailure(ctx, msg.AuctionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "failure of auction %d is not found", msg.AuctionId)
+	}
+
+	_ = auction.SetStatus(failure.FailedStatus)
+	k.SetAuction(ctx, auction)
+	k.DeleteAuctionFailure(ctx, msg.AuctionId)
+	k.setReleaseTimeIndexes(ctx, msg.AuctionId)
+
+	var err error
+	if msg.ForceRefund {
+		err = k.RefundFailedAuction(ctx, auction)
+	} else {
+		switch failure.FailedStatus {
+		case types.AuctionStatusStandBy:
+			err = k.ExecuteStandByStatus(ctx, auction)
+		case types.AuctionStatusStarted:
+			err = k.ExecuteStartedStatus(ctx, auction)
+		case types.AuctionStatusSettling:
+			err = k.ExecuteSettlingStatus(ctx, auction)
+		case types.AuctionStatusVesting:
+			if err = k.ExecuteVestingStatus(ctx, auction); err == nil {
+				err = k.ReleaseBidderVestingCoins(ctx, auction)
+			}
+		case types.AuctionStatusFinished:
+			err = k.ReleaseBidderVestingCoins(ctx, auction)
+		}
+	}
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to resolve the failed auction")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResolveFailedAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(msg.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyForceRefund, strconv.FormatBool(msg.ForceRefund)),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventResolveFailedAuction{
+		AuctionId:   msg.AuctionId,
+		ForceRefund: msg.ForceRefund,
+	})
+}
+
+// RefundFailedAuction returns the reserved coins of the auction to their owners.
+// If the auction failed while vesting or after it is finished, the selling coin is already allocated, so
+// all the paying coin in the vesting reserve account is released to the auctioneer, all the selling coin in
+// the bidder vesting reserve account is released to the bidders and the auction is finished.
+// If the auction failed while settling, the matching result is already final, so all the remaining bidders
+// are settled at once and the auction is handled as above.
+// Otherwise, the selling coin is released to the auctioneer, all the reserved paying coin is refunded to
+// the bidders and the auction is cancelled.
+func (k Keeper) RefundFailedAuction(ctx sdk.Context, auction types.AuctionI) error {
+	if auction.GetStatus() == types.AuctionStatusSettling {
+		if err := k.SettleBidders(ctx, auction, 0); err != nil {
+			return err
+		}
+	}
+
+	if auction.GetStatus() == types.AuctionStatusFinished {
+		return k.releaseBidderVestingQueues(ctx, auction, true)
+	}
+
+	if auction.GetStatus() == types.AuctionStatusVesting {
+		vestingReserveAddr := auction.GetVestingReserveAddress()
+		spendableCoins := k.bankKeeper.SpendableCoins(ctx, vestingReserveAddr)
+		releaseCoins := sdk.Coins{}
+		for _, denom := range auction.GetPayingCoinDenoms() {
+			releaseCoins = releaseCoins.Add(sdk.NewCoin(denom, spendableCoins.AmountOf(denom)))
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, vestingReserveAddr, auction.GetAuctioneer(), releaseCoins); err != nil {
+			return sdkerrors.Wrap(err, "failed to release paying coin to the auctioneer")
+		}
+
+		for _, queue := range k.GetVestingQueuesByAuctionId(ctx, auction.GetId()) {
+			queue.SetReleased(true)
+			k.SetVestingQueue(ctx, queue)
+		}
+
+		if vesting, found := k.GetLinearVesting(ctx, auction.GetId()); found {
+			vesting.ClaimedCoins = vesting.TotalCoins
+			k.SetLinearVesting(ctx, vesting)
+		}
+
+		if err := k.releaseBidderVestingQueues(ctx, auction, true); err != nil {
+			return err
+		}
+
+		_ = auction.SetStatus(types.AuctionStatusFinished)
+		k.SetAuction(ctx, auction)
+
+		return nil
 	}
+
+	if _, err := k.returnReservedCoins(ctx, auction); err != nil {
+		return err
+	}
+
+	_ = auction.SetStatus(types.AuctionStatusCancelled)
+	k.SetAuction(ctx, auction)
+
+	return nil
 }
@@ -4,6 +4,9 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/tendermint/fundraising/x/fundraising"
 	"github.com/tendermint/fundraising/x/fundraising/types"
@@ -129,6 +132,82 @@ func (s *KeeperTestSuite) TestEndBlockerVestingStatus() {
 	s.Require().True(coinEq(totalBidCoin, auctioneerBalance))
 }
 
+func (s *KeeperTestSuite) TestEndBlockerEvents() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("500000000000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{
+				ReleaseTime: time.Now().AddDate(0, 6, 0),
+				Weight:      sdk.MustNewDecFromStr("0.5"),
+			},
+			{
+				ReleaseTime: time.Now().AddDate(1, 0, 0),
+				Weight:      sdk.MustNewDecFromStr("0.5"),
+			},
+		},
+		time.Now().AddDate(0, 0, 1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStandBy, auction.GetStatus())
+
+	s.ctx = s.ctx.WithBlockTime(auction.StartTime.AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Equal(1, s.countEvents(types.EventTypeAuctionStarted))
+	s.Require().Equal(1, s.countEvents(proto.MessageName(&types.EventAuctionStarted{})))
+
+	s.placeBidFixedPrice(auction.GetId(), s.addr(1), sdk.OneDec(), parseCoin("20000000denom2"), true)
+	s.placeBidFixedPrice(auction.GetId(), s.addr(2), sdk.OneDec(), parseCoin("20000000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0].AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Equal(2, s.countEvents(types.EventTypeAllocateSellingCoin))
+	s.Require().Equal(1, s.countEvents(types.EventTypeAuctionClosed))
+
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeAuctionClosed {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range ev.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		s.Require().Equal(parseDec("1").String(), attrs[types.AttributeKeyMatchedPrice])
+		s.Require().Equal("40000000", attrs[types.AttributeKeySoldAmount])
+		s.Require().Equal("2", attrs[types.AttributeKeyWinnersCount])
+		s.Require().Equal("40000000", attrs[types.AttributeKeyRaisedAmount])
+	}
+
+	// The typed event must be emitted along with the legacy event
+	s.Require().Equal(1, s.countEvents(proto.MessageName(&types.EventAuctionClosed{})))
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type != proto.MessageName(&types.EventAuctionClosed{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		s.Require().NoError(err)
+		closed, ok := msg.(*types.EventAuctionClosed)
+		s.Require().True(ok)
+		s.Require().Equal(auction.Id, closed.AuctionId)
+		s.Require().Equal(types.AuctionStatusVesting, closed.AuctionStatus)
+		s.Require().Equal(sdk.NewInt(40000000), closed.SoldAmount)
+		s.Require().Equal(uint64(2), closed.WinnersCount)
+		s.Require().Equal(sdk.NewInt(40000000), closed.RaisedAmount)
+	}
+
+	s.ctx = s.ctx.WithBlockTime(auction.VestingSchedules[0].ReleaseTime.AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Equal(1, s.countEvents(types.EventTypeReleaseVesting))
+	s.Require().Equal(1, s.countEvents(proto.MessageName(&types.EventVestingReleased{})))
+
+	s.ctx = s.ctx.WithBlockTime(auction.VestingSchedules[1].ReleaseTime.AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Equal(2, s.countEvents(types.EventTypeReleaseVesting))
+}
+
 func (s *KeeperTestSuite) TestExecuteStartedAuction_BatchAuction() {
 	ba := s.createBatchAuction(
 		s.addr(1),
@@ -155,6 +234,260 @@ func (s *KeeperTestSuite) TestExecuteStartedAuction_BatchAuction() {
 	auction, found := s.keeper.GetAuction(s.ctx, ba.Id)
 	s.Require().True(found)
 
-	s.keeper.ExecuteStartedStatus(s.ctx, auction)
+	s.Require().NoError(s.keeper.ExecuteStartedStatus(s.ctx, auction))
+
+}
 
+func (s *KeeperTestSuite) TestEndBlockerFailedAuction() {
+	healthy := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1000000000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+	broken := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1000000000denom3"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+	s.placeBidFixedPrice(healthy.Id, s.addr(1), parseDec("1"), parseCoin("100000000denom2"), true)
+	s.placeBidFixedPrice(broken.Id, s.addr(2), parseDec("1"), parseCoin("200000000denom2"), true)
+
+	// Drain the selling reserve account so that the allocation of the auction fails
+	s.sendCoins(broken.GetSellingReserveAddress(), s.addr(9), sdk.NewCoins(parseCoin("1000000000denom3")), false)
+
+	s.ctx = s.ctx.WithBlockTime(healthy.GetEndTimes()[0].AddDate(0, 0, 1))
+	s.Require().NotPanics(func() {
+		fundraising.BeginBlocker(s.ctx, s.keeper)
+	})
+
+	auction, found := s.keeper.GetAuction(s.ctx, healthy.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+	s.Require().Equal(parseCoin("100000000denom1"), s.getBalance(s.addr(1), "denom1"))
+
+	auction, found = s.keeper.GetAuction(s.ctx, broken.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFailed, auction.GetStatus())
+
+	failure, found := s.keeper.GetAuctionFailure(s.ctx, broken.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusStarted, failure.FailedStatus)
+	s.Require().NotEmpty(failure.Reason)
+
+	resp, err := s.querier.AuctionFailure(sdk.WrapSDKContext(s.ctx), &types.QueryAuctionFailureRequest{AuctionId: broken.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(failure, resp.Failure)
+	_, err = s.querier.AuctionFailure(sdk.WrapSDKContext(s.ctx), &types.QueryAuctionFailureRequest{AuctionId: healthy.Id})
+	s.Require().Error(err)
+
+	// The state of the failed auction must be rolled back
+	s.Require().Equal(parseCoin("200000000denom2"), s.getBalance(broken.GetPayingReserveAddress(), "denom2"))
+	_, found = s.keeper.GetAuctionSettlement(s.ctx, broken.Id)
+	s.Require().False(found)
+
+	s.Require().Equal(1, s.countEvents(types.EventTypeAuctionFailed))
+
+	// The failed auction is not executed again in the next block
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	auction, found = s.keeper.GetAuction(s.ctx, broken.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFailed, auction.GetStatus())
+}
+
+func (s *KeeperTestSuite) TestExecuteIsolated_FailAuctionError() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1000000000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+
+	err := s.keeper.FailAuction(s.ctx, 10, types.AuctionStatusStarted, "reason")
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// The auction that can't be marked as failed is logged and left as it was instead of halting the chain
+	auction.Id = 10
+	s.Require().NotPanics(func() {
+		s.keeper.ExecuteIsolated(s.ctx, auction, func(sdk.Context, types.AuctionI) error {
+			return types.ErrInvalidAuctionStatus
+		})
+	})
+	_, found := s.keeper.GetAuctionFailure(s.ctx, 10)
+	s.Require().False(found)
+	s.Require().Equal(0, s.countEvents(types.EventTypeAuctionFailed))
+}
+
+func (s *KeeperTestSuite) TestResolveFailedAuction() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1000000000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100000000denom2"), true)
+
+	// Not failed yet
+	_, err := s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(s.ctx), types.NewMsgResolveFailedAuction(s.keeper.GetAuthority(), auction.Id, false))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+
+	s.sendCoins(auction.GetSellingReserveAddress(), s.addr(9), sdk.NewCoins(parseCoin("1000000000denom1")), false)
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0].AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFailed, a.GetStatus())
+
+	// Only the authority can resolve the failed auction
+	_, err = s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(s.ctx), types.NewMsgResolveFailedAuction(s.addr(0).String(), auction.Id, false))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Retry fails again while the selling reserve account is still drained
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(cacheCtx), types.NewMsgResolveFailedAuction(s.keeper.GetAuthority(), auction.Id, false))
+	s.Require().Error(err)
+
+	// Retry succeeds once the selling coin is put back
+	s.sendCoins(s.addr(9), auction.GetSellingReserveAddress(), sdk.NewCoins(parseCoin("1000000000denom1")), false)
+	_, err = s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(s.ctx), types.NewMsgResolveFailedAuction(s.keeper.GetAuthority(), auction.Id, false))
+	s.Require().NoError(err)
+
+	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(parseCoin("100000000denom1"), s.getBalance(s.addr(1), "denom1"))
+	_, found = s.keeper.GetAuctionFailure(s.ctx, auction.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestResolveFailedAuction_ForceRefund() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1000000000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100000000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(2), parseDec("1"), parseCoin("50000000denom1"), true)
+
+	// Drain a part of the selling reserve account so that the allocation fails
+	s.sendCoins(auction.GetSellingReserveAddress(), s.addr(9), sdk.NewCoins(parseCoin("900000000denom1")), false)
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0].AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFailed, a.GetStatus())
+
+	_, err := s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(s.ctx), types.NewMsgResolveFailedAuction(s.keeper.GetAuthority(), auction.Id, true))
+	s.Require().NoError(err)
+
+	a, found = s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusCancelled, a.GetStatus())
+	s.Require().Equal(parseCoin("100000000denom1"), s.getBalance(s.addr(0), "denom1"))
+	s.Require().Equal(parseCoin("100000000denom2"), s.getBalance(s.addr(1), "denom2"))
+	s.Require().Equal(parseCoin("50000000denom2"), s.getBalance(s.addr(2), "denom2"))
+	s.Require().True(s.getBalance(auction.GetPayingReserveAddress(), "denom2").IsZero())
+}
+
+func (s *KeeperTestSuite) TestResolveFailedAuction_BidderVesting() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1000000000denom1")
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	bidderVestingSchedules := []types.VestingSchedule{
+		{ReleaseTime: endTime.AddDate(0, 1, 0), Weight: parseDec("0.5")},
+		{ReleaseTime: endTime.AddDate(0, 2, 0), Weight: parseDec("0.5")},
+	}
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		endTime,
+		true,
+		false,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		bidderVestingSchedules,
+		nil,
+		false,
+	))
+	s.Require().NoError(err)
+	s.placeBidFixedPrice(auction.GetId(), s.addr(1), parseDec("1"), parseCoin("100000000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	// Drain the bidder vesting reserve account so that the release fails
+	reserveAddr := auction.GetBidderVestingReserveAddress()
+	s.sendCoins(reserveAddr, s.addr(9), sdk.NewCoins(parseCoin("100000000denom1")), false)
+	s.ctx = s.ctx.WithBlockTime(bidderVestingSchedules[0].ReleaseTime)
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFailed, a.GetStatus())
+	failure, found := s.keeper.GetAuctionFailure(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, failure.FailedStatus)
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsZero())
+
+	// The failed auction is removed from the release time index until it is resolved
+	lastReleaseTime := bidderVestingSchedules[1].ReleaseTime
+	s.Require().Empty(s.keeper.GetAuctionsToReleaseToBidders(s.ctx, lastReleaseTime))
+
+	// Retry releases the due queue and puts the auction back in the index for the remaining queue
+	s.sendCoins(s.addr(9), reserveAddr, sdk.NewCoins(parseCoin("100000000denom1")), false)
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(cacheCtx), types.NewMsgResolveFailedAuction(s.keeper.GetAuthority(), auction.GetId(), false))
+	s.Require().NoError(err)
+	s.Require().Empty(s.keeper.GetAuctionsToReleaseToBidders(cacheCtx, bidderVestingSchedules[0].ReleaseTime))
+	s.Require().Len(s.keeper.GetAuctionsToReleaseToBidders(cacheCtx, lastReleaseTime), 1)
+
+	// Force refund releases all the bidder vesting queues regardless of the release time
+	_, err = s.msgServer.ResolveFailedAuction(sdk.WrapSDKContext(s.ctx), types.NewMsgResolveFailedAuction(s.keeper.GetAuthority(), auction.GetId(), true))
+	s.Require().NoError(err)
+
+	a, found = s.keeper.GetAuction(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(parseCoin("100000000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().True(s.getBalance(reserveAddr, "denom1").IsZero())
+	for _, queue := range s.keeper.GetBidderVestingQueuesByAuctionId(s.ctx, auction.GetId()) {
+		s.Require().True(queue.Released)
+	}
+	s.Require().Empty(s.keeper.GetAuctionsToReleaseToBidders(s.ctx, lastReleaseTime))
 }
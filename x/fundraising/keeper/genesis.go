@@ -43,7 +43,6 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if !found {
 			panic(fmt.Sprintf("auction %d is not found", bid.AuctionId))
 		}
-		k.GetNextBidIdWithUpdate(ctx, bid.AuctionId)
 		k.SetBid(ctx, bid)
 	}
 
@@ -54,6 +53,80 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetVestingQueue(ctx, queue)
 	}
+
+	for _, settlement := range genState.AuctionSettlements {
+		_, found := k.GetAuction(ctx, settlement.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", settlement.AuctionId))
+		}
+		k.SetAuctionSettlement(ctx, settlement)
+	}
+
+	for _, settlement := range genState.BidderSettlements {
+		_, found := k.GetAuction(ctx, settlement.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", settlement.AuctionId))
+		}
+		k.SetBidderSettlement(ctx, settlement)
+	}
+
+	for _, queue := range genState.BidderVestingQueues {
+		_, found := k.GetAuction(ctx, queue.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", queue.AuctionId))
+		}
+		k.SetBidderVestingQueue(ctx, queue)
+	}
+
+	// The failures are set after the vesting queues, since the failed auctions have no release time indexes
+	for _, failure := range genState.AuctionFailures {
+		_, found := k.GetAuction(ctx, failure.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", failure.AuctionId))
+		}
+		k.SetAuctionFailure(ctx, failure)
+		k.deleteReleaseTimeIndexes(ctx, failure.AuctionId)
+	}
+
+	for _, vesting := range genState.LinearVestings {
+		_, found := k.GetAuction(ctx, vesting.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", vesting.AuctionId))
+		}
+		k.SetLinearVesting(ctx, vesting)
+	}
+
+	for _, claim := range genState.AllocationClaims {
+		_, found := k.GetAuction(ctx, claim.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", claim.AuctionId))
+		}
+		k.SetAllocationClaim(ctx, claim)
+	}
+
+	for _, cursor := range genState.SettlementCursors {
+		_, found := k.GetAuction(ctx, cursor.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", cursor.AuctionId))
+		}
+		k.SetSettlementCursor(ctx, cursor)
+	}
+
+	for _, commitment := range genState.BidCommitments {
+		_, found := k.GetAuction(ctx, commitment.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", commitment.AuctionId))
+		}
+		k.SetBidCommitment(ctx, commitment)
+	}
+
+	for _, record := range genState.LastBidIdRecords {
+		_, found := k.GetAuction(ctx, record.AuctionId)
+		if !found {
+			panic(fmt.Sprintf("auction %d is not found", record.AuctionId))
+		}
+		k.SetBidId(ctx, record.AuctionId, record.BidId)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis state.
@@ -61,6 +134,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 	bids := k.GetBids(ctx)
 	queues := k.GetVestingQueues(ctx)
+	auctionSettlements := k.GetAuctionSettlements(ctx)
+	bidderSettlements := k.GetBidderSettlements(ctx)
+	auctionFailures := k.GetAuctionFailures(ctx)
+	bidderVestingQueues := k.GetBidderVestingQueues(ctx)
+	linearVestings := k.GetLinearVestings(ctx)
+	allocationClaims := k.GetAllocationClaims(ctx)
+	settlementCursors := k.GetSettlementCursors(ctx)
+	bidCommitments := k.GetBidCommitments(ctx)
+	lastBidIdRecords := k.GetLastBidIdRecords(ctx)
 
 	// Prevents from nil slice
 	if len(params.AuctionCreationFee) == 0 {
@@ -96,5 +178,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		AllowedBidderRecords: allowedBidderRecords,
 		Bids:                 bids,
 		VestingQueues:        queues,
+		AuctionSettlements:   auctionSettlements,
+		BidderSettlements:    bidderSettlements,
+		AuctionFailures:      auctionFailures,
+		BidderVestingQueues:  bidderVestingQueues,
+		LinearVestings:       linearVestings,
+		AllocationClaims:     allocationClaims,
+		SettlementCursors:    settlementCursors,
+		BidCommitments:       bidCommitments,
+		LastBidIdRecords:     lastBidIdRecords,
 	}
 }
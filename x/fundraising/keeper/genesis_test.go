@@ -102,14 +102,136 @@ func (s *KeeperTestSuite) TestGenesisState() {
 		}
 	}
 
+	bidderQueue := types.NewBidderVestingQueue(fixedAuction.Id, s.addr(1), parseCoins("1000denom1"), time.Now().AddDate(4, 0, 0), false)
+	s.keeper.SetBidderVestingQueue(s.ctx, bidderQueue)
+	s.keeper.SetLinearVesting(s.ctx, types.NewLinearVesting(fixedAuction.Id, s.addr(0), parseCoins("1000denom2"), parseCoins("100denom2")))
+	s.keeper.SetAllocationClaim(s.ctx, types.NewAllocationClaim(fixedAuction.Id, s.addr(1), parseCoins("1000denom1"), parseCoins("10denom2")))
+	s.keeper.SetSettlementCursor(s.ctx, types.SettlementCursor{AuctionId: fixedAuction.Id, LastBidder: s.addr(1).String(), SettledBidders: 1})
+	s.keeper.SetBidCommitment(s.ctx, types.NewBidCommitment(batchAuction.Id, 10, s.addr(8), make([]byte, types.BidCommitmentLength), parseCoin("1000denom4")))
+	s.keeper.SetBidId(s.ctx, batchAuction.Id, 10)
+
 	var genState *types.GenesisState
 	s.Require().NotPanics(func() {
 		genState = s.keeper.ExportGenesis(s.ctx)
 	})
 	s.Require().NoError(genState.Validate())
+	s.Require().Len(genState.AuctionSettlements, 1)
+	s.Require().Len(genState.BidderSettlements, 2)
+	s.Require().Len(genState.BidderVestingQueues, 1)
+	s.Require().Len(genState.LinearVestings, 1)
+	s.Require().Len(genState.AllocationClaims, 1)
+	s.Require().Len(genState.SettlementCursors, 1)
+	s.Require().Len(genState.BidCommitments, 1)
+	s.Require().Len(genState.LastBidIdRecords, 2)
 
 	s.Require().NotPanics(func() {
 		s.keeper.InitGenesis(s.ctx, *genState)
 	})
 	s.Require().Equal(genState, s.keeper.ExportGenesis(s.ctx))
+	s.Require().Len(s.keeper.GetAuctionsToReleaseToBidders(s.ctx, bidderQueue.ReleaseTime), 1)
+}
+
+func (s *KeeperTestSuite) TestGenesisState_CanceledBid() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("0.1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 2, 0),
+		true,
+	)
+	s.Require().Equal(types.AuctionStatusStarted, auction.GetStatus())
+
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	b3 := s.placeBidBatchWorth(auction.Id, s.addr(3), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+
+	// Cancel the bid that has the highest bid id
+	err := s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(3).String(),
+		BidId:     b3.Id,
+	})
+	s.Require().NoError(err)
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.Bids, 2)
+	s.Require().Equal([]types.LastBidIdRecord{{AuctionId: auction.Id, BidId: b3.Id}}, genState.LastBidIdRecords)
+
+	// Import the genesis state to a new chain
+	s.SetupTest()
+	s.Require().NotPanics(func() {
+		s.keeper.InitGenesis(s.ctx, *genState)
+	})
+	s.Require().Equal(b3.Id, s.keeper.GetLastBidId(s.ctx, auction.Id))
+
+	// The id of the canceled bid must not be reused
+	b4 := s.placeBidBatchWorth(auction.Id, s.addr(4), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.Require().Equal(b3.Id+1, b4.Id)
+}
+
+func (s *KeeperTestSuite) TestGenesisState_ForfeitedBidCommitment() {
+	auction := s.createSealedBatchAuction(s.addr(0), parseCoin("1_000_000denom1"), parseDec("0.1"))
+
+	c1 := s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1", parseCoin("600_000denom2"))
+	c2 := s.commitBid(auction.Id, s.addr(2), types.BidTypeBatchMany, parseDec("1"), parseCoin("300_000denom1"), "salt2", parseCoin("300_000denom2"))
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetRevealStartTime())
+	_, err := s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(auction.Id, s.addr(1).String(), c1.Id, types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1"))
+	s.Require().NoError(err)
+
+	// The unrevealed commitment that has the highest bid id is forfeited
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.keeper.GetBidCommitments(s.ctx))
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.Bids, 1)
+	s.Require().Equal([]types.LastBidIdRecord{{AuctionId: auction.Id, BidId: c2.Id}}, genState.LastBidIdRecords)
+
+	// Import the genesis state to a new chain
+	s.SetupTest()
+	s.Require().NotPanics(func() {
+		s.keeper.InitGenesis(s.ctx, *genState)
+	})
+	s.Require().Equal(c2.Id, s.keeper.GetLastBidId(s.ctx, auction.Id))
+}
+
+func (s *KeeperTestSuite) TestGenesisState_FailedAuction() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{
+			{ReleaseTime: time.Now().AddDate(0, 2, 0), Weight: parseDec("1")},
+		},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 1, 0),
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("100_000_000denom2"), true)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+	releaseTime := auction.VestingSchedules[0].ReleaseTime
+	s.Require().Len(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime), 1)
+
+	s.Require().NoError(s.keeper.FailAuction(s.ctx, auction.Id, types.AuctionStatusVesting, "reason"))
+	s.Require().Empty(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime))
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.VestingQueues, 1)
+
+	// Import the genesis state to a new chain
+	s.SetupTest()
+	s.Require().NotPanics(func() {
+		s.keeper.InitGenesis(s.ctx, *genState)
+	})
+	s.Require().Empty(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime))
 }
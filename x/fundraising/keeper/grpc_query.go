@@ -25,8 +25,7 @@ var _ types.QueryServer = Querier{}
 // Params queries the parameters of the fundraising module.
 func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.Keeper.paramSpace.GetParamSet(ctx, &params)
+	params := k.Keeper.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
@@ -36,25 +35,45 @@ func (k Querier) Auctions(c context.Context, req *types.QueryAuctionsRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if req.Type != "" && !(req.Type == types.AuctionTypeFixedPrice.String() || req.Type == types.AuctionTypeBatch.String()) {
+	if req.Type != "" && !(req.Type == types.AuctionTypeFixedPrice.String() || req.Type == types.AuctionTypeBatch.String() ||
+		req.Type == types.AuctionTypeDutch.String()) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid auction type %s", req.Type)
 	}
 
 	if req.Status != "" && !(req.Status == types.AuctionStatusStandBy.String() || req.Status == types.AuctionStatusStarted.String() ||
 		req.Status == types.AuctionStatusVesting.String() || req.Status == types.AuctionStatusFinished.String() ||
-		req.Status == types.AuctionStatusCancelled.String()) {
+		req.Status == types.AuctionStatusCancelled.String() || req.Status == types.AuctionStatusFailed.String() ||
+		req.Status == types.AuctionStatusFailedSoftCap.String() || req.Status == types.AuctionStatusSettling.String()) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid auction status %s", req.Status)
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(k.storeKey)
-	auctionStore := prefix.NewStore(store, types.AuctionKeyPrefix)
+
+	// Use the status index to iterate only the auctions with the status when it is given
+	var auctionStore prefix.Store
+	if req.Status != "" {
+		auctionStatus := types.AuctionStatus(types.AuctionStatus_value[req.Status])
+		auctionStore = prefix.NewStore(store, types.GetAuctionStatusIndexPrefix(auctionStatus))
+	} else {
+		auctionStore = prefix.NewStore(store, types.AuctionKeyPrefix)
+	}
 
 	var auctions []*codectypes.Any
 	pageRes, err := query.FilteredPaginate(auctionStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
-		auction, err := types.UnmarshalAuction(k.cdc, value)
-		if err != nil {
-			return false, err
+		var auction types.AuctionI
+		if req.Status != "" {
+			var found bool
+			auction, found = k.Keeper.GetAuction(ctx, types.ParseAuctionIdFromIndexKey(key))
+			if !found {
+				return false, nil
+			}
+		} else {
+			var err error
+			auction, err = types.UnmarshalAuction(k.cdc, value)
+			if err != nil {
+				return false, err
+			}
 		}
 
 		auctionAny, err := types.PackAuction(auction)
@@ -227,7 +246,196 @@ func (k Querier) Bid(c context.Context, req *types.QueryBidRequest) (*types.Quer
 	return &types.QueryBidResponse{Bid: bid}, nil
 }
 
+// SimulateBatchMatch simulates the matching of the batch auction with the current bids.
+// The matching runs on a cached context, so nothing is persisted.
+func (k Querier) SimulateBatchMatch(c context.Context, req *types.QuerySimulateBatchMatchRequest) (*types.QuerySimulateBatchMatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Bidder != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Bidder); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address %s: %v", req.Bidder, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	auction, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "auction %d is not a batch auction", req.AuctionId)
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return nil, status.Errorf(codes.FailedPrecondition, "auction %d is not started", req.AuctionId)
+	}
+
+	// CalculateBatchAllocation marks the matched bids in the store,
+	// so use a cached context that is never written
+	cacheCtx, _ := ctx.CacheContext()
+	mInfo := k.Keeper.CalculateBatchAllocation(cacheCtx, auction)
+
+	resp := &types.QuerySimulateBatchMatchResponse{
+		Matched:          mInfo.MatchedLen > 0,
+		MatchedPrice:     sdk.ZeroDec(),
+		MatchedAmount:    mInfo.TotalMatchedAmount,
+		MatchedBidsCount: uint64(mInfo.MatchedLen),
+		AllocatedAmount:  sdk.ZeroInt(),
+		RefundAmount:     sdk.ZeroInt(),
+		PaidAmount:       sdk.ZeroInt(),
+	}
+	if resp.Matched {
+		resp.MatchedPrice = mInfo.MatchedPrice
+	}
+	if allocatedAmt, ok := mInfo.AllocationMap[req.Bidder]; ok {
+		resp.AllocatedAmount = allocatedAmt
+		resp.RefundAmount = mInfo.RefundMap[req.Bidder]
+		resp.PaidAmount = mInfo.ReservedMatchedMap[req.Bidder]
+	}
+
+	return resp, nil
+}
+
+// AuctionOrderBook queries the bids of the batch auction aggregated into price levels.
+func (k Querier) AuctionOrderBook(c context.Context, req *types.QueryAuctionOrderBookRequest) (*types.QueryAuctionOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tickSize := sdk.Dec{}
+	if req.TickSize != "" {
+		var err error
+		tickSize, err = sdk.NewDecFromStr(req.TickSize)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tick size %s: %v", req.TickSize, err)
+		}
+		if !tickSize.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "tick size must be positive: %s", req.TickSize)
+		}
+	}
+
+	offset, limit := uint64(0), uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+		}
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	auction, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	if auction.GetType() != types.AuctionTypeBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "auction %d is not a batch auction", req.AuctionId)
+	}
+
+	prices, bidsByPrice := types.BidsByPrice(k.Keeper.GetBidsByAuctionId(ctx, auction.GetId()))
+	levels := types.OrderBook(prices, bidsByPrice, tickSize, auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+
+	pageRes := &query.PageResponse{}
+	if req.Pagination != nil && req.Pagination.CountTotal {
+		pageRes.Total = uint64(len(levels))
+	}
+
+	if offset > uint64(len(levels)) {
+		offset = uint64(len(levels))
+	}
+	end := offset + limit
+	if end > uint64(len(levels)) {
+		end = uint64(len(levels))
+	}
+
+	return &types.QueryAuctionOrderBookResponse{PriceLevels: levels[offset:end], Pagination: pageRes}, nil
+}
+
+// AuctionSettlement queries the settlement record of the closed auction.
+func (k Querier) AuctionSettlement(c context.Context, req *types.QueryAuctionSettlementRequest) (*types.QueryAuctionSettlementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	settlement, found := k.Keeper.GetAuctionSettlement(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "settlement for auction %d not found", req.AuctionId)
+	}
+
+	res := &types.QueryAuctionSettlementResponse{Settlement: settlement}
+	if cursor, found := k.Keeper.GetSettlementCursor(ctx, req.AuctionId); found {
+		res.Cursor = &cursor
+	}
+
+	return res, nil
+}
+
+// BidderSettlements queries the settlement records of all bidders for the closed auction.
+func (k Querier) BidderSettlements(c context.Context, req *types.QueryBidderSettlementsRequest) (*types.QueryBidderSettlementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bsStore := prefix.NewStore(store, types.GetBidderSettlementsByAuctionPrefix(req.AuctionId))
+
+	var settlements []types.BidderSettlement
+	pageRes, err := query.Paginate(bsStore, req.Pagination, func(key, value []byte) error {
+		var settlement types.BidderSettlement
+		if err := k.cdc.Unmarshal(value, &settlement); err != nil {
+			return err
+		}
+		settlements = append(settlements, settlement)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBidderSettlementsResponse{Settlements: settlements, Pagination: pageRes}, nil
+}
+
+// AuctionFailure queries the failure record of the failed auction.
+func (k Querier) AuctionFailure(c context.Context, req *types.QueryAuctionFailureRequest) (*types.QueryAuctionFailureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	failure, found := k.Keeper.GetAuctionFailure(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failure for auction %d not found", req.AuctionId)
+	}
+
+	return &types.QueryAuctionFailureResponse{Failure: failure}, nil
+}
+
 // Vestings queries all vesting queues for the auction.
+// If the auction has the linear vesting schedule, it also returns the linear vesting and
+// the paying coin that the auctioneer can claim at the current block time.
 func (k Querier) Vestings(c context.Context, req *types.QueryVestingsRequest) (*types.QueryVestingsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -241,7 +449,155 @@ func (k Querier) Vestings(c context.Context, req *types.QueryVestingsRequest) (*
 
 	queues := k.Keeper.GetVestingQueuesByAuctionId(ctx, auction.GetId())
 
-	return &types.QueryVestingsResponse{Vestings: queues}, nil
+	res := &types.QueryVestingsResponse{Vestings: queues, ClaimableCoins: sdk.Coins{}}
+	if vesting, found := k.Keeper.GetLinearVesting(ctx, auction.GetId()); found {
+		res.LinearVesting = &vesting
+		if schedule := auction.GetLinearVestingSchedule(); schedule != nil && auction.GetStatus() == types.AuctionStatusVesting {
+			res.ClaimableCoins = vesting.ClaimableCoins(*schedule, ctx.BlockTime())
+		}
+	}
+
+	return res, nil
+}
+
+// BidderVestings queries the vesting queues of the bidder that are not released across all auctions.
+func (k Querier) BidderVestings(c context.Context, req *types.QueryBidderVestingsRequest) (*types.QueryBidderVestingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address %s: %v", req.Bidder, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetBidderVestingQueueIndexByBidderPrefix(bidderAddr))
+
+	var queues []types.BidderVestingQueue
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		auctionId, releaseTime := types.ParseBidderVestingQueueIndexKey(key)
+		queue, found := k.Keeper.GetBidderVestingQueue(ctx, auctionId, bidderAddr, releaseTime)
+		if !found || queue.Released {
+			return false, nil
+		}
+
+		if accumulate {
+			queues = append(queues, queue)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBidderVestingsResponse{Vestings: queues, Pagination: pageRes}, nil
+}
+
+// AllocationClaims queries the allocation claims of the auction that are not claimed.
+func (k Querier) AllocationClaims(c context.Context, req *types.QueryAllocationClaimsRequest) (*types.QueryAllocationClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	claimStore := prefix.NewStore(store, types.GetAllocationClaimsByAuctionPrefix(req.AuctionId))
+
+	var claims []types.AllocationClaim
+	pageRes, err := query.FilteredPaginate(claimStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var claim types.AllocationClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return false, err
+		}
+
+		if claim.Claimed {
+			return false, nil
+		}
+
+		if accumulate {
+			claims = append(claims, claim)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllocationClaimsResponse{Claims: claims, Pagination: pageRes}, nil
+}
+
+// AllocationClaim queries the allocation claim of the bidder for the auction.
+func (k Querier) AllocationClaim(c context.Context, req *types.QueryAllocationClaimRequest) (*types.QueryAllocationClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address %s: %v", req.Bidder, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	claim, found := k.Keeper.GetAllocationClaim(ctx, req.AuctionId, bidderAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "allocation claim of bidder %s for auction %d not found", req.Bidder, req.AuctionId)
+	}
+
+	return &types.QueryAllocationClaimResponse{Claim: claim}, nil
+}
+
+// BidCommitments queries the commitments of the sealed bids that are not revealed yet for the auction.
+func (k Querier) BidCommitments(c context.Context, req *types.QueryBidCommitmentsRequest) (*types.QueryBidCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Bidder != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Bidder); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address %s: %v", req.Bidder, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, found := k.Keeper.GetAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.AuctionId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	commitmentStore := prefix.NewStore(store, types.GetBidCommitmentsByAuctionPrefix(req.AuctionId))
+
+	var commitments []types.BidCommitment
+	pageRes, err := query.FilteredPaginate(commitmentStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var commitment types.BidCommitment
+		if err := k.cdc.Unmarshal(value, &commitment); err != nil {
+			return false, err
+		}
+
+		if req.Bidder != "" && commitment.Bidder != req.Bidder {
+			return false, nil
+		}
+
+		if accumulate {
+			commitments = append(commitments, commitment)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBidCommitmentsResponse{Commitments: commitments, Pagination: pageRes}, nil
 }
 
 func queryAllBids(ctx sdk.Context, k Querier, store sdk.KVStore, req *types.QueryBidsRequest) (bids []types.Bid, pageRes *query.PageResponse, err error) {
@@ -345,3 +701,23 @@ func queryBidsByIsMatched(ctx sdk.Context, k Querier, store sdk.KVStore, req *ty
 
 	return bids, pageRes, err
 }
+
+// BidderAggregate queries the running totals of the bids that the bidder placed for the auction.
+func (k Querier) BidderAggregate(c context.Context, req *types.QueryBidderAggregateRequest) (*types.QueryBidderAggregateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address %s: %v", req.Bidder, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	aggregate, found := k.Keeper.GetBidderAggregate(ctx, req.AuctionId, bidderAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "bidder aggregate of bidder %s for auction %d not found", req.Bidder, req.AuctionId)
+	}
+
+	return &types.QueryBidderAggregateResponse{Aggregate: aggregate}, nil
+}
@@ -522,6 +522,425 @@ func (s *KeeperTestSuite) TestGRPCBid() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGRPCSimulateBatchMatch() {
+	fixedPriceAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("500_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1.0"), parseCoin("600_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("400_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.5"), parseCoin("600_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QuerySimulateBatchMatchRequest
+		expectErr bool
+		postRun   func(*types.QuerySimulateBatchMatchResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"id not found",
+			&types.QuerySimulateBatchMatchRequest{
+				AuctionId: 5,
+			},
+			true,
+			nil,
+		},
+		{
+			"not a batch auction",
+			&types.QuerySimulateBatchMatchRequest{
+				AuctionId: fixedPriceAuction.Id,
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid bidder address",
+			&types.QuerySimulateBatchMatchRequest{
+				AuctionId: auction.Id,
+				Bidder:    "invalid",
+			},
+			true,
+			nil,
+		},
+		{
+			"query by id",
+			&types.QuerySimulateBatchMatchRequest{
+				AuctionId: auction.Id,
+			},
+			false,
+			func(resp *types.QuerySimulateBatchMatchResponse) {
+				s.Require().True(resp.Matched)
+				s.Require().Equal(parseDec("0.8"), resp.MatchedPrice)
+				s.Require().Equal(parseInt("1_000_000_000"), resp.MatchedAmount)
+				s.Require().Equal(uint64(2), resp.MatchedBidsCount)
+				s.Require().True(resp.AllocatedAmount.IsZero())
+				s.Require().True(resp.RefundAmount.IsZero())
+			},
+		},
+		{
+			"query by id and bidder",
+			&types.QuerySimulateBatchMatchRequest{
+				AuctionId: auction.Id,
+				Bidder:    s.addr(1).String(),
+			},
+			false,
+			func(resp *types.QuerySimulateBatchMatchResponse) {
+				s.Require().Equal(parseInt("600_000_000"), resp.AllocatedAmount)
+				s.Require().Equal(parseInt("120_000_000"), resp.RefundAmount)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.SimulateBatchMatch(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	// Nothing is persisted by the simulation
+	for _, bid := range s.keeper.GetBidsByAuctionId(s.ctx, auction.Id) {
+		s.Require().False(bid.IsMatched)
+	}
+	s.Require().Equal(int64(0), s.keeper.GetLastMatchedBidsLen(s.ctx, auction.Id))
+}
+
+func (s *KeeperTestSuite) TestGRPCAuctionOrderBook() {
+	fixedPriceAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("500_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		1,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		true,
+	)
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1.0"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("200_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.75"), parseCoin("100_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.5"), parseCoin("100_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryAuctionOrderBookRequest
+		expectErr bool
+		postRun   func(*types.QueryAuctionOrderBookResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"id not found",
+			&types.QueryAuctionOrderBookRequest{
+				AuctionId: 5,
+			},
+			true,
+			nil,
+		},
+		{
+			"not a batch auction",
+			&types.QueryAuctionOrderBookRequest{
+				AuctionId: fixedPriceAuction.Id,
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid tick size",
+			&types.QueryAuctionOrderBookRequest{
+				AuctionId: auction.Id,
+				TickSize:  "-0.1",
+			},
+			true,
+			nil,
+		},
+		{
+			"query by id",
+			&types.QueryAuctionOrderBookRequest{
+				AuctionId: auction.Id,
+			},
+			false,
+			func(resp *types.QueryAuctionOrderBookResponse) {
+				s.Require().Len(resp.PriceLevels, 4)
+				s.Require().Equal(parseDec("1.0"), resp.PriceLevels[0].Price)
+				s.Require().Equal(parseInt("200_000_000"), resp.PriceLevels[0].CumulativeDemandAmount)
+				s.Require().Equal(parseDec("0.8"), resp.PriceLevels[1].Price)
+				s.Require().Equal(parseInt("250_000_000"), resp.PriceLevels[1].DemandAmount)
+				s.Require().Equal(parseInt("450_000_000"), resp.PriceLevels[1].CumulativeDemandAmount)
+				s.Require().Equal(parseDec("0.5"), resp.PriceLevels[3].Price)
+				s.Require().Equal(parseInt("800_000_000"), resp.PriceLevels[3].CumulativeDemandAmount)
+			},
+		},
+		{
+			"query by id with tick size",
+			&types.QueryAuctionOrderBookRequest{
+				AuctionId: auction.Id,
+				TickSize:  "0.5",
+			},
+			false,
+			func(resp *types.QueryAuctionOrderBookResponse) {
+				s.Require().Len(resp.PriceLevels, 2)
+				s.Require().Equal(parseDec("1.0"), resp.PriceLevels[0].Price)
+				s.Require().Equal(uint64(1), resp.PriceLevels[0].BidsCount)
+				s.Require().Equal(parseDec("0.5"), resp.PriceLevels[1].Price)
+				s.Require().Equal(uint64(3), resp.PriceLevels[1].BidsCount)
+				s.Require().Equal(parseInt("800_000_000"), resp.PriceLevels[1].CumulativeDemandAmount)
+			},
+		},
+		{
+			"query by id with pagination",
+			&types.QueryAuctionOrderBookRequest{
+				AuctionId:  auction.Id,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryAuctionOrderBookResponse) {
+				s.Require().Len(resp.PriceLevels, 2)
+				s.Require().Equal(parseDec("0.8"), resp.PriceLevels[0].Price)
+				s.Require().Equal(parseDec("0.75"), resp.PriceLevels[1].Price)
+				s.Require().Equal(uint64(4), resp.Pagination.Total)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.AuctionOrderBook(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCAuctionOrderBook_PayingCoinRates() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 1, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", parseDec("0.5"))),
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+
+	// The worth bid in denom3 is worth 150_000_000denom2 by the paying coin rate
+	s.placeBidBatchWorth(auction.GetId(), s.addr(1), parseDec("0.8"), parseCoin("200_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchWorth(auction.GetId(), s.addr(2), parseDec("0.8"), parseCoin("300_000_000denom3"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.GetId(), s.addr(3), parseDec("0.5"), parseCoin("100_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	resp, err := s.querier.AuctionOrderBook(sdk.WrapSDKContext(s.ctx), &types.QueryAuctionOrderBookRequest{
+		AuctionId: auction.GetId(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.PriceLevels, 2)
+	s.Require().Equal(parseDec("0.8"), resp.PriceLevels[0].Price)
+	s.Require().Equal(uint64(2), resp.PriceLevels[0].BidsCount)
+	s.Require().Equal(parseInt("350_000_000"), resp.PriceLevels[0].WorthAmount)
+	s.Require().Equal(parseInt("437_500_000"), resp.PriceLevels[0].DemandAmount)
+	s.Require().Equal(parseInt("437_500_000"), resp.PriceLevels[0].CumulativeDemandAmount)
+	s.Require().Equal(parseDec("0.5"), resp.PriceLevels[1].Price)
+	s.Require().Equal(parseInt("100_000_000"), resp.PriceLevels[1].DemandAmount)
+	s.Require().Equal(parseInt("800_000_000"), resp.PriceLevels[1].CumulativeDemandAmount)
+}
+
+func (s *KeeperTestSuite) TestGRPCSettlements() {
+	auction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1000000000000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		time.Now().AddDate(0, -1, 0),
+		time.Now().AddDate(0, -1, 0).AddDate(0, 3, 0),
+		true,
+	)
+	s.placeBidFixedPrice(auction.Id, s.addr(1), parseDec("1"), parseCoin("20000000denom2"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(2), parseDec("1"), parseCoin("30000000denom1"), true)
+	s.placeBidFixedPrice(auction.Id, s.addr(3), parseDec("1"), parseCoin("10000000denom2"), true)
+
+	_, err := s.querier.AuctionSettlement(sdk.WrapSDKContext(s.ctx), &types.QueryAuctionSettlementRequest{AuctionId: auction.Id})
+	s.Require().Error(err) // not closed yet
+
+	// Set the current block time a day after so that it gets closed
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0].AddDate(0, 0, 1))
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryAuctionSettlementRequest
+		expectErr bool
+		postRun   func(*types.QueryAuctionSettlementResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"auction not found",
+			&types.QueryAuctionSettlementRequest{
+				AuctionId: 5,
+			},
+			true,
+			nil,
+		},
+		{
+			"query by id",
+			&types.QueryAuctionSettlementRequest{
+				AuctionId: auction.Id,
+			},
+			false,
+			func(resp *types.QueryAuctionSettlementResponse) {
+				s.Require().Equal(auction.Id, resp.Settlement.AuctionId)
+				s.Require().Equal(parseInt("60000000"), resp.Settlement.TotalSoldAmount)
+				s.Require().Equal(parseInt("60000000"), resp.Settlement.TotalRaisedAmount)
+				s.Require().Equal(uint64(3), resp.Settlement.WinnersCount)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.AuctionSettlement(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryBidderSettlementsRequest
+		expectErr bool
+		postRun   func(*types.QueryBidderSettlementsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"auction not found",
+			&types.QueryBidderSettlementsRequest{
+				AuctionId: 5,
+			},
+			true,
+			nil,
+		},
+		{
+			"query by id",
+			&types.QueryBidderSettlementsRequest{
+				AuctionId: auction.Id,
+			},
+			false,
+			func(resp *types.QueryBidderSettlementsResponse) {
+				s.Require().Len(resp.Settlements, 3)
+				for _, settlement := range resp.Settlements {
+					s.Require().Equal(auction.Id, settlement.AuctionId)
+					s.Require().True(settlement.RefundedAmount.IsZero())
+				}
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryBidderSettlementsRequest{
+				AuctionId:  auction.Id,
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			false,
+			func(resp *types.QueryBidderSettlementsResponse) {
+				s.Require().Len(resp.Settlements, 2)
+				s.Require().Equal(uint64(3), resp.Pagination.Total)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.BidderSettlements(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGRPCVestings() {
 	auction := s.createFixedPriceAuction(
 		s.addr(0),
@@ -581,3 +1000,78 @@ func (s *KeeperTestSuite) TestGRPCVestings() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCBidderAggregate() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.4"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryBidderAggregateRequest
+		expectErr bool
+		postRun   func(*types.QueryBidderAggregateResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid bidder",
+			&types.QueryBidderAggregateRequest{
+				AuctionId: auction.Id,
+				Bidder:    "invalid",
+			},
+			true,
+			nil,
+		},
+		{
+			"bidder without any bid",
+			&types.QueryBidderAggregateRequest{
+				AuctionId: auction.Id,
+				Bidder:    s.addr(2).String(),
+			},
+			true,
+			nil,
+		},
+		{
+			"valid request",
+			&types.QueryBidderAggregateRequest{
+				AuctionId: auction.Id,
+				Bidder:    s.addr(1).String(),
+			},
+			false,
+			func(resp *types.QueryBidderAggregateResponse) {
+				s.Require().Equal(auction.Id, resp.Aggregate.AuctionId)
+				s.Require().Equal(s.addr(1).String(), resp.Aggregate.Bidder)
+				s.Require().Equal(sdk.NewInt(180_000_000), resp.Aggregate.ReservedAmount)
+				s.Require().Equal(sdk.NewInt(400_000_000), resp.Aggregate.SellingAmount)
+				s.Require().Equal(uint64(2), resp.Aggregate.BidsCount)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.BidderAggregate(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
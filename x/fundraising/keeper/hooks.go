@@ -127,6 +127,70 @@ func (k Keeper) AfterBatchAuctionCreated(
 	}
 }
 
+// BeforeDutchAuctionCreated - call hook if registered
+func (k Keeper) BeforeDutchAuctionCreated(
+	ctx sdk.Context,
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+) {
+	if k.hooks != nil {
+		k.hooks.BeforeDutchAuctionCreated(
+			ctx,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			priceDecayStep,
+			priceDecayPeriod,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			startTime,
+			endTime,
+		)
+	}
+}
+
+// AfterDutchAuctionCreated - call hook if registered
+func (k Keeper) AfterDutchAuctionCreated(
+	ctx sdk.Context,
+	auctionId uint64,
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+) {
+	if k.hooks != nil {
+		k.hooks.AfterDutchAuctionCreated(
+			ctx,
+			auctionId,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			priceDecayStep,
+			priceDecayPeriod,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			startTime,
+			endTime,
+		)
+	}
+}
+
 // BeforeAuctionCanceled - call hook if registered
 func (k Keeper) BeforeAuctionCanceled(
 	ctx sdk.Context,
@@ -168,6 +232,18 @@ func (k Keeper) BeforeBidModified(
 	}
 }
 
+// BeforeBidCanceled - call hook if registered
+func (k Keeper) BeforeBidCanceled(
+	ctx sdk.Context,
+	auctionId uint64,
+	bidId uint64,
+	bidder string,
+) {
+	if k.hooks != nil {
+		k.hooks.BeforeBidCanceled(ctx, auctionId, bidId, bidder)
+	}
+}
+
 // BeforeAllowedBiddersAdded - call hook if registered
 func (k Keeper) BeforeAllowedBiddersAdded(
 	ctx sdk.Context,
@@ -190,6 +266,17 @@ func (k Keeper) BeforeAllowedBidderUpdated(
 	}
 }
 
+// BeforeAllowedBidderRemoved - call hook if registered
+func (k Keeper) BeforeAllowedBidderRemoved(
+	ctx sdk.Context,
+	auctionId uint64,
+	bidder sdk.AccAddress,
+) {
+	if k.hooks != nil {
+		k.hooks.BeforeAllowedBidderRemoved(ctx, auctionId, bidder)
+	}
+}
+
 // BeforeSellingCoinsAllocated - call hook if registered
 func (k Keeper) BeforeSellingCoinsAllocated(
 	ctx sdk.Context,
@@ -18,11 +18,15 @@ type MockFundraisingHooksReceiver struct {
 	AfterFixedPriceAuctionCreatedValid  bool
 	BeforeBatchAuctionCreatedValid      bool
 	AfterBatchAuctionCreatedValid       bool
+	BeforeDutchAuctionCreatedValid      bool
+	AfterDutchAuctionCreatedValid       bool
 	BeforeAuctionCanceledValid          bool
 	BeforeBidPlacedValid                bool
 	BeforeBidModifiedValid              bool
+	BeforeBidCanceledValid              bool
 	BeforeAllowedBiddersAddedValid      bool
 	BeforeAllowedBidderUpdatedValid     bool
+	BeforeAllowedBidderRemovedValid     bool
 	BeforeSellingCoinsAllocatedValid    bool
 }
 
@@ -86,6 +90,39 @@ func (h *MockFundraisingHooksReceiver) AfterBatchAuctionCreated(
 	h.AfterBatchAuctionCreatedValid = true
 }
 
+func (h *MockFundraisingHooksReceiver) BeforeDutchAuctionCreated(
+	ctx sdk.Context,
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+) {
+	h.BeforeDutchAuctionCreatedValid = true
+}
+
+func (h *MockFundraisingHooksReceiver) AfterDutchAuctionCreated(
+	ctx sdk.Context,
+	auctionId uint64,
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+) {
+	h.AfterDutchAuctionCreatedValid = true
+}
+
 func (h *MockFundraisingHooksReceiver) BeforeAuctionCanceled(
 	ctx sdk.Context,
 	auctionId uint64,
@@ -118,6 +155,15 @@ func (h *MockFundraisingHooksReceiver) BeforeBidModified(
 	h.BeforeBidModifiedValid = true
 }
 
+func (h *MockFundraisingHooksReceiver) BeforeBidCanceled(
+	ctx sdk.Context,
+	auctionId uint64,
+	bidId uint64,
+	bidder string,
+) {
+	h.BeforeBidCanceledValid = true
+}
+
 func (h *MockFundraisingHooksReceiver) BeforeAllowedBiddersAdded(
 	ctx sdk.Context,
 	allowedBidders []types.AllowedBidder,
@@ -134,6 +180,14 @@ func (h *MockFundraisingHooksReceiver) BeforeAllowedBidderUpdated(
 	h.BeforeAllowedBidderUpdatedValid = true
 }
 
+func (h *MockFundraisingHooksReceiver) BeforeAllowedBidderRemoved(
+	ctx sdk.Context,
+	auctionId uint64,
+	bidder sdk.AccAddress,
+) {
+	h.BeforeAllowedBidderRemovedValid = true
+}
+
 func (h *MockFundraisingHooksReceiver) BeforeSellingCoinsAllocated(
 	ctx sdk.Context,
 	auctionId uint64,
@@ -153,11 +207,15 @@ func (s *KeeperTestSuite) TestHooks() {
 	s.Require().False(fundraisingHooksReceiver.AfterFixedPriceAuctionCreatedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeBatchAuctionCreatedValid)
 	s.Require().False(fundraisingHooksReceiver.AfterBatchAuctionCreatedValid)
+	s.Require().False(fundraisingHooksReceiver.BeforeDutchAuctionCreatedValid)
+	s.Require().False(fundraisingHooksReceiver.AfterDutchAuctionCreatedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeAuctionCanceledValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeBidPlacedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeBidModifiedValid)
+	s.Require().False(fundraisingHooksReceiver.BeforeBidCanceledValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeAllowedBiddersAddedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeAllowedBidderUpdatedValid)
+	s.Require().False(fundraisingHooksReceiver.BeforeAllowedBidderRemovedValid)
 	s.Require().False(fundraisingHooksReceiver.BeforeSellingCoinsAllocatedValid)
 
 	// Create a fixed price auction
@@ -241,6 +299,20 @@ func (s *KeeperTestSuite) TestHooks() {
 	s.Require().NoError(err)
 	s.Require().True(fundraisingHooksReceiver.BeforeBidModifiedValid)
 
+	// Cancel the bid
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: bid.AuctionId,
+		BidId:     bid.Id,
+		Bidder:    bid.Bidder,
+	})
+	s.Require().NoError(err)
+	s.Require().True(fundraisingHooksReceiver.BeforeBidCanceledValid)
+
+	// Remove the allowed bidder
+	err = s.keeper.RemoveAllowedBidder(s.ctx, auction.GetId(), s.addr(3))
+	s.Require().NoError(err)
+	s.Require().True(fundraisingHooksReceiver.BeforeAllowedBidderRemovedValid)
+
 	// Calculate fixed price allocation
 	mInfo := s.keeper.CalculateFixedPriceAllocation(s.ctx, auction)
 
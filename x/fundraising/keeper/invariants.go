@@ -16,6 +16,10 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PayingPoolReserveAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "vesting-pool-reserve-amount",
 		VestingPoolReserveAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bidder-vesting-pool-reserve-amount",
+		BidderVestingPoolReserveAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claim-reserve-amount",
+		ClaimReserveAmountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the fundraising module.
@@ -25,6 +29,8 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			SellingPoolReserveAmountInvariant,
 			PayingPoolReserveAmountInvariant,
 			VestingPoolReserveAmountInvariant,
+			BidderVestingPoolReserveAmountInvariant,
+			ClaimReserveAmountInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -35,8 +41,8 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	}
 }
 
-// SellingPoolReserveAmountInvariant checks an invariant that the total amount of selling coin for an auction
-// must equal or greater than the selling reserve account balance.
+// SellingPoolReserveAmountInvariant checks an invariant that the total amount of selling coin and basket coins
+// for an auction must equal or greater than the selling reserve account balance.
 func SellingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		msg := ""
@@ -45,17 +51,16 @@ func SellingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 		for _, auction := range k.GetAuctions(ctx) {
 			if auction.GetStatus() == types.AuctionStatusStarted {
 				sellingReserveAddr := auction.GetSellingReserveAddress()
-				sellingCoinDenom := auction.GetSellingCoin().Denom
 				spendable := k.bankKeeper.SpendableCoins(ctx, sellingReserveAddr)
-				sellingReserve := sdk.NewCoin(sellingCoinDenom, spendable.AmountOf(sellingCoinDenom))
-				fmt.Println("sellingReserve: ", sellingReserve)
-				fmt.Println("auction.GetSellingCoin(): ", auction.GetSellingCoin())
-				if !sellingReserve.IsGTE(auction.GetSellingCoin()) {
-					msg += fmt.Sprintf("\tselling reserve balance %s\n"+
-						"\tselling pool reserve: %v\n"+
-						"\ttotal selling coin: %v\n",
-						sellingReserveAddr.String(), sellingReserve, auction.GetSellingCoin())
-					count++
+				for _, sellingCoin := range auction.GetSellingCoins() {
+					sellingReserve := sdk.NewCoin(sellingCoin.Denom, spendable.AmountOf(sellingCoin.Denom))
+					if !sellingReserve.IsGTE(sellingCoin) {
+						msg += fmt.Sprintf("\tselling reserve balance %s\n"+
+							"\tselling pool reserve: %v\n"+
+							"\ttotal selling coin: %v\n",
+							sellingReserveAddr.String(), sellingReserve, sellingCoin)
+						count++
+					}
 				}
 			}
 		}
@@ -73,24 +78,28 @@ func PayingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 		count := 0
 
 		for _, auction := range k.GetAuctions(ctx) {
-			totalBidCoin := sdk.NewCoin(auction.GetPayingCoinDenom(), sdk.ZeroInt())
+			totalBidCoins := sdk.Coins{}
 
 			if auction.GetStatus() == types.AuctionStatusStarted {
 				for _, bid := range k.GetBidsByAuctionId(ctx, auction.GetId()) {
-					bidAmt := bid.ConvertToPayingAmount(auction.GetPayingCoinDenom())
-					totalBidCoin = totalBidCoin.Add(sdk.NewCoin(auction.GetPayingCoinDenom(), bidAmt))
+					totalBidCoins = totalBidCoins.Add(bid.ConvertToPayingCoin(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates()))
+				}
+				for _, commitment := range k.GetBidCommitmentsByAuctionId(ctx, auction.GetId()) {
+					totalBidCoins = totalBidCoins.Add(commitment.Deposit)
 				}
 			}
 
 			payingReserveAddr := auction.GetPayingReserveAddress()
-			payingCoinDenom := auction.GetPayingCoinDenom()
 			spendable := k.bankKeeper.SpendableCoins(ctx, payingReserveAddr)
-			payingReserve := sdk.NewCoin(payingCoinDenom, spendable.AmountOf(payingCoinDenom))
-			if !payingReserve.IsGTE(totalBidCoin) {
+			payingReserve := sdk.Coins{}
+			for _, denom := range auction.GetPayingCoinDenoms() {
+				payingReserve = payingReserve.Add(sdk.NewCoin(denom, spendable.AmountOf(denom)))
+			}
+			if !payingReserve.IsAllGTE(totalBidCoins) {
 				msg += fmt.Sprintf("\tpaying reserve balance %s\n"+
 					"\tpaying pool reserve: %v\n"+
-					"\ttotal bid coin: %v\n",
-					payingReserveAddr.String(), payingReserve, totalBidCoin)
+					"\ttotal bid coins: %v\n",
+					payingReserveAddr.String(), payingReserve, totalBidCoins)
 				count++
 			}
 		}
@@ -102,31 +111,38 @@ func PayingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 
 // VestingPoolReserveAmountInvariant checks an invariant that the total vesting amount
 // must be equal or greater than the vesting reserve account balance.
+// The total vesting amount includes the paying coin of the linear vesting that is not claimed yet.
 func VestingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		msg := ""
 		count := 0
 
 		for _, auction := range k.GetAuctions(ctx) {
-			totalPayingCoin := sdk.NewCoin(auction.GetPayingCoinDenom(), sdk.ZeroInt())
+			totalPayingCoins := sdk.Coins{}
 
 			if auction.GetStatus() == types.AuctionStatusVesting {
 				for _, queue := range k.GetVestingQueuesByAuctionId(ctx, auction.GetId()) {
 					if !queue.Released {
-						totalPayingCoin = totalPayingCoin.Add(queue.PayingCoin)
+						totalPayingCoins = totalPayingCoins.Add(queue.PayingCoin)
 					}
 				}
+
+				if vesting, found := k.GetLinearVesting(ctx, auction.GetId()); found {
+					totalPayingCoins = totalPayingCoins.Add(vesting.UnclaimedCoins()...)
+				}
 			}
 
 			vestingReserveAddr := auction.GetVestingReserveAddress()
-			payingCoinDenom := auction.GetPayingCoinDenom()
 			spendable := k.bankKeeper.SpendableCoins(ctx, vestingReserveAddr)
-			vestingReserve := sdk.NewCoin(payingCoinDenom, spendable.AmountOf(payingCoinDenom))
-			if !vestingReserve.IsGTE(totalPayingCoin) {
+			vestingReserve := sdk.Coins{}
+			for _, denom := range auction.GetPayingCoinDenoms() {
+				vestingReserve = vestingReserve.Add(sdk.NewCoin(denom, spendable.AmountOf(denom)))
+			}
+			if !vestingReserve.IsAllGTE(totalPayingCoins) {
 				msg += fmt.Sprintf("\tvesting reserve balance %s\n"+
 					"\tvesting pool reserve: %v\n"+
-					"\ttotal paying coin: %v\n",
-					vestingReserveAddr.String(), vestingReserve, totalPayingCoin)
+					"\ttotal paying coins: %v\n",
+					vestingReserveAddr.String(), vestingReserve, totalPayingCoins)
 				count++
 			}
 		}
@@ -135,3 +151,77 @@ func VestingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "vesting pool reserve amount and total paying amount", msg), broken
 	}
 }
+
+// BidderVestingPoolReserveAmountInvariant checks an invariant that the total amount of the bidder vesting queues
+// that are not released must be equal or greater than the bidder vesting reserve account balance.
+func BidderVestingPoolReserveAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+
+		for _, auction := range k.GetAuctions(ctx) {
+			if len(auction.GetBidderVestingSchedules()) == 0 {
+				continue
+			}
+
+			totalReleaseCoins := sdk.Coins{}
+			for _, queue := range k.GetBidderVestingQueuesByAuctionId(ctx, auction.GetId()) {
+				if !queue.Released {
+					totalReleaseCoins = totalReleaseCoins.Add(queue.ReleaseCoins...)
+				}
+			}
+
+			bidderVestingReserveAddr := auction.GetBidderVestingReserveAddress()
+			spendable := k.bankKeeper.SpendableCoins(ctx, bidderVestingReserveAddr)
+			bidderVestingReserve := sdk.Coins{}
+			for _, coin := range auction.GetSellingCoins() {
+				bidderVestingReserve = bidderVestingReserve.Add(sdk.NewCoin(coin.Denom, spendable.AmountOf(coin.Denom)))
+			}
+			if !bidderVestingReserve.IsAllGTE(totalReleaseCoins) {
+				msg += fmt.Sprintf("\tbidder vesting reserve balance %s\n"+
+					"\tbidder vesting pool reserve: %v\n"+
+					"\ttotal release coins: %v\n",
+					bidderVestingReserveAddr.String(), bidderVestingReserve, totalReleaseCoins)
+				count++
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "bidder vesting pool reserve amount and total release amount", msg), broken
+	}
+}
+
+// ClaimReserveAmountInvariant checks an invariant that the total amount of the allocation claims
+// that are not claimed must be equal or less than the claim reserve account balance.
+func ClaimReserveAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+
+		for _, auction := range k.GetAuctions(ctx) {
+			if !auction.GetClaimMode() {
+				continue
+			}
+
+			totalClaimCoins := sdk.Coins{}
+			for _, claim := range k.GetAllocationClaimsByAuctionId(ctx, auction.GetId()) {
+				if !claim.Claimed {
+					totalClaimCoins = totalClaimCoins.Add(claim.ClaimCoins()...)
+				}
+			}
+
+			claimReserveAddr := auction.GetClaimReserveAddress()
+			claimReserve := k.bankKeeper.SpendableCoins(ctx, claimReserveAddr)
+			if !claimReserve.IsAllGTE(totalClaimCoins) {
+				msg += fmt.Sprintf("\tclaim reserve balance %s\n"+
+					"\tclaim reserve: %v\n"+
+					"\ttotal claim coins: %v\n",
+					claimReserveAddr.String(), claimReserve, totalClaimCoins)
+				count++
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "claim reserve amount and total claim amount", msg), broken
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -40,6 +41,10 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	distrKeeper   types.DistrKeeper
 	hooks         types.FundraisingHooks
+
+	// authority is the address capable of executing a MsgUpdateParams message.
+	// Typically, this should be the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
@@ -50,12 +55,18 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	authority string,
 ) Keeper {
 	// Ensure fundraising module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	// Ensure the authority is a valid address
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %v", authority, err))
+	}
+
 	// Set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -69,6 +80,7 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
+		authority:     authority,
 	}
 }
 
@@ -88,15 +100,43 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address that is capable of updating the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the parameters for the fundraising module.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the parameters for the fundraising module.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// UpdateParams handles types.MsgUpdateParams and sets the module parameters.
+// Only the authority of the keeper is allowed to update the parameters.
+func (k Keeper) UpdateParams(ctx sdk.Context, msg *types.MsgUpdateParams) error {
+	if k.authority != msg.Authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	return nil
 }
 
 // PayCreationFee sends the auction creation fee to the fee collector account.
@@ -119,9 +159,17 @@ func (k Keeper) PayPlaceBidFee(ctx sdk.Context, bidderAddr sdk.AccAddress) error
 	return nil
 }
 
-// ReserveSellingCoin reserves the selling coin to the selling reserve account.
-func (k Keeper) ReserveSellingCoin(ctx sdk.Context, auctionId uint64, auctioneerAddr sdk.AccAddress, sellingCoin sdk.Coin) error {
-	if err := k.bankKeeper.SendCoins(ctx, auctioneerAddr, types.SellingReserveAddress(auctionId), sdk.NewCoins(sellingCoin)); err != nil {
+// ReleasePayingCoin releases the reserved paying coin from the paying reserve account to the bidder.
+func (k Keeper) ReleasePayingCoin(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress, payingCoin sdk.Coin) error {
+	if err := k.bankKeeper.SendCoins(ctx, types.PayingReserveAddress(auctionId), bidderAddr, sdk.NewCoins(payingCoin)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReserveSellingCoin reserves the selling coin and the basket coins to the selling reserve account.
+func (k Keeper) ReserveSellingCoin(ctx sdk.Context, auctionId uint64, auctioneerAddr sdk.AccAddress, sellingCoins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoins(ctx, auctioneerAddr, types.SellingReserveAddress(auctionId), sellingCoins); err != nil {
 		return err
 	}
 	return nil
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/tendermint/fundraising/app"
@@ -41,6 +43,25 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
 }
 
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := s.keeper.GetParams(s.ctx)
+	params.AuctionCreationFee = parseCoins("1_000_000stake")
+	params.ExtendedPeriod = 3
+
+	// Only the authority can update the params
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdateParams(s.addr(0).String(), params))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	invalidParams := params
+	invalidParams.BidCancellationCutoff = -time.Hour
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdateParams(s.keeper.GetAuthority(), invalidParams))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), types.NewMsgUpdateParams(s.keeper.GetAuthority(), params))
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
+
 //
 // Below are just shortcuts to frequently-used functions.
 //
@@ -109,6 +130,41 @@ func (s *KeeperTestSuite) createBatchAuction(
 	return auction.(*types.BatchAuction)
 }
 
+func (s *KeeperTestSuite) createDutchAuction(
+	auctioneer sdk.AccAddress,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []types.VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+	fund bool,
+) *types.DutchAuction {
+	params := s.keeper.GetParams(s.ctx)
+	if fund {
+		s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+	}
+
+	auction, err := s.keeper.CreateDutchAuction(s.ctx, &types.MsgCreateDutchAuction{
+		Auctioneer:       auctioneer.String(),
+		StartPrice:       startPrice,
+		FloorPrice:       floorPrice,
+		PriceDecayStep:   priceDecayStep,
+		PriceDecayPeriod: priceDecayPeriod,
+		SellingCoin:      sellingCoin,
+		PayingCoinDenom:  payingCoinDenom,
+		VestingSchedules: vestingSchedules,
+		StartTime:        startTime,
+		EndTime:          endTime,
+	})
+	s.Require().NoError(err)
+
+	return auction.(*types.DutchAuction)
+}
+
 func (s *KeeperTestSuite) addAllowedBidder(auctionId uint64, bidder sdk.AccAddress, maxBidAmt sdk.Int) {
 	allowedBidder, found := s.keeper.GetAllowedBidder(s.ctx, auctionId, bidder)
 	if found {
@@ -128,15 +184,50 @@ func (s *KeeperTestSuite) placeBidFixedPrice(
 	auction, found := s.keeper.GetAuction(s.ctx, auctionId)
 	s.Require().True(found)
 
+	bid := types.Bid{Price: price, Coin: coin}
+	fundCoin := bid.ConvertToPayingCoin(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+	maxBidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+
+	if fund {
+		s.fundAddr(bidder, sdk.NewCoins(fundCoin))
+	}
+
+	s.addAllowedBidder(auctionId, bidder, maxBidAmt)
+
+	b, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auctionId,
+		Bidder:    bidder.String(),
+		BidType:   types.BidTypeFixedPrice,
+		Price:     price,
+		Coin:      coin,
+	})
+	s.Require().NoError(err)
+
+	return b
+}
+
+func (s *KeeperTestSuite) placeBidDutch(
+	auctionId uint64,
+	bidder sdk.AccAddress,
+	price sdk.Dec,
+	coin sdk.Coin,
+	fund bool,
+) types.Bid {
+	auction, found := s.keeper.GetAuction(s.ctx, auctionId)
+	s.Require().True(found)
+
+	// The bid is filled at the current price of the auction
+	currentPrice := auction.(*types.DutchAuction).CurrentPrice(s.ctx.BlockTime())
+
 	var fundAmt sdk.Int
 	var fundCoin sdk.Coin
 	var maxBidAmt sdk.Int
 
 	if coin.Denom == auction.GetPayingCoinDenom() {
 		fundCoin = coin
-		maxBidAmt = sdk.NewDecFromInt(coin.Amount).QuoTruncate(price).TruncateInt()
+		maxBidAmt = sdk.NewDecFromInt(coin.Amount).QuoTruncate(currentPrice).TruncateInt()
 	} else {
-		fundAmt = sdk.NewDecFromInt(coin.Amount).Mul(price).Ceil().TruncateInt()
+		fundAmt = sdk.NewDecFromInt(coin.Amount).Mul(currentPrice).Ceil().TruncateInt()
 		fundCoin = sdk.NewCoin(auction.GetPayingCoinDenom(), fundAmt)
 		maxBidAmt = coin.Amount
 	}
@@ -150,7 +241,7 @@ func (s *KeeperTestSuite) placeBidFixedPrice(
 	b, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
 		AuctionId: auctionId,
 		Bidder:    bidder.String(),
-		BidType:   types.BidTypeFixedPrice,
+		BidType:   types.BidTypeDutch,
 		Price:     price,
 		Coin:      coin,
 	})
@@ -159,6 +250,21 @@ func (s *KeeperTestSuite) placeBidFixedPrice(
 	return b
 }
 
+// placeBidOverMaxBidAmount places the batch auction bid even if the bids of the bidder exceed
+// their maximum bid amount in total. It is the same state as an external module lowering the maximum bid
+// amount after the bids are placed, and the bidder is still limited by it when the auction is matched.
+func (s *KeeperTestSuite) placeBidOverMaxBidAmount(msg *types.MsgPlaceBid) types.Bid {
+	allowedBidder, found := s.keeper.GetAllowedBidder(s.ctx, msg.AuctionId, msg.GetBidder())
+	s.Require().True(found)
+
+	s.keeper.SetAllowedBidder(s.ctx, msg.AuctionId, types.NewAllowedBidder(msg.GetBidder(), sdk.NewInt(math.MaxInt64)))
+	b, err := s.keeper.PlaceBid(s.ctx, msg)
+	s.Require().NoError(err)
+	s.keeper.SetAllowedBidder(s.ctx, msg.AuctionId, allowedBidder)
+
+	return b
+}
+
 func (s *KeeperTestSuite) placeBidBatchWorth(
 	auctionId uint64,
 	bidder sdk.AccAddress,
@@ -173,16 +279,13 @@ func (s *KeeperTestSuite) placeBidBatchWorth(
 
 	s.addAllowedBidder(auctionId, bidder, maxBidAmt)
 
-	b, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+	return s.placeBidOverMaxBidAmount(&types.MsgPlaceBid{
 		AuctionId: auctionId,
 		Bidder:    bidder.String(),
 		BidType:   types.BidTypeBatchWorth,
 		Price:     price,
 		Coin:      coin,
 	})
-	s.Require().NoError(err)
-
-	return b
 }
 
 func (s *KeeperTestSuite) placeBidBatchMany(
@@ -205,16 +308,13 @@ func (s *KeeperTestSuite) placeBidBatchMany(
 
 	s.addAllowedBidder(auctionId, bidder, maxBidAmt)
 
-	b, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+	return s.placeBidOverMaxBidAmount(&types.MsgPlaceBid{
 		AuctionId: auctionId,
 		Bidder:    bidder.String(),
 		BidType:   types.BidTypeBatchMany,
 		Price:     price,
 		Coin:      coin,
 	})
-	s.Require().NoError(err)
-
-	return b
 }
 
 //
@@ -245,6 +345,17 @@ func (s *KeeperTestSuite) sendCoins(fromAddr, toAddr sdk.AccAddress, coins sdk.C
 	s.Require().NoError(err)
 }
 
+// countEvents returns the number of the events of the given type emitted so far.
+func (s *KeeperTestSuite) countEvents(eventType string) int {
+	count := 0
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
 // fullString is a helper function that returns a full output of the matching result.
 // it includes all bids sorted in descending order, allocation, refund, and matching info.
 // it is useful for debugging.
@@ -253,6 +364,7 @@ func (s *KeeperTestSuite) fullString(auctionId uint64, mInfo keeper.MatchingInfo
 	s.Require().True(found)
 
 	payingCoinDenom := auction.GetPayingCoinDenom()
+	payingCoinRates := auction.GetPayingCoinRates()
 	bids := s.keeper.GetBidsByAuctionId(s.ctx, auctionId)
 	bids = types.SortBids(bids)
 
@@ -262,8 +374,8 @@ func (s *KeeperTestSuite) fullString(auctionId uint64, mInfo keeper.MatchingInfo
 	b.WriteString("[Bids]\n")
 	b.WriteString("+--------------------bidder---------------------+-id-+---------price---------+---------type---------+-----reserve-amount-----+-------bid-amount-------+\n")
 	for _, bid := range bids {
-		reserveAmt := bid.ConvertToPayingAmount(payingCoinDenom)
-		bidAmt := bid.ConvertToSellingAmount(payingCoinDenom)
+		reserveAmt := bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates)
+		bidAmt := bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates)
 
 		_, _ = fmt.Fprintf(&b, "| %28s | %2d | %21s | %20s | %22s | %22s |\n", bid.Bidder, bid.Id, bid.Price.String(), bid.Type, reserveAmt, bidAmt)
 	}
@@ -32,7 +32,7 @@ func (k Keeper) CalculateFixedPriceAllocation(ctx sdk.Context, auction types.Auc
 	// Loop through all bids and calculate allocated amount
 	// Accumulate the allocated amount if a bidder placed multiple bids
 	for _, bid := range bids {
-		bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom())
+		bidAmt := bid.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
 
 		allocatedAmt, ok := mInfo.AllocationMap[bid.Bidder]
 		if !ok {
@@ -46,6 +46,25 @@ func (k Keeper) CalculateFixedPriceAllocation(ctx sdk.Context, auction types.Auc
 	return mInfo
 }
 
+// CalculateDutchAllocation calculates matching information of the dutch auction in the same way as
+// the fixed price auction. Every bid is already filled at the price of the time it was placed, so the
+// matched price is the lowest price that any bid was filled at.
+func (k Keeper) CalculateDutchAllocation(ctx sdk.Context, auction types.AuctionI) MatchingInfo {
+	mInfo := k.CalculateFixedPriceAllocation(ctx, auction)
+
+	k.IterateBidsByAuctionId(ctx, auction.GetId(), func(bid types.Bid) (stop bool) {
+		mInfo.MatchedPrice = sdk.MinDec(mInfo.MatchedPrice, bid.Price)
+		return false
+	})
+
+	return mInfo
+}
+
+// CalculateBatchAllocation finds the matching price of the batch auction and calculates matching information.
+// The price levels of the auction are searched for the lowest price that the bids can fit in the selling amount,
+// and only the bids at or above the matching price are loaded from the price index to match them.
+// If the bids don't match at the price found from the price levels, all bids are searched for the matching price
+// instead, so that the auction is never left unmatched by the price levels.
 func (k Keeper) CalculateBatchAllocation(ctx sdk.Context, auction types.AuctionI) MatchingInfo {
 	mInfo := MatchingInfo{
 		AllocationMap:      map[string]sdk.Int{},
@@ -53,47 +72,70 @@ func (k Keeper) CalculateBatchAllocation(ctx sdk.Context, auction types.AuctionI
 		RefundMap:          map[string]sdk.Int{},
 	}
 
-	bids := k.GetBidsByAuctionId(ctx, auction.GetId())
-	prices, bidsByPrice := types.BidsByPrice(bids)
 	sellingAmt := auction.GetSellingCoin().Amount
 
 	allowedBidders := k.GetAllowedBiddersByAuction(ctx, auction.GetId())
 
+	defaultMaxBidAmt := sdk.ZeroInt()
+	if auction.GetOpenBidding() {
+		defaultMaxBidAmt = auction.GetDefaultMaxBidAmount()
+	}
+
+	partialFillMode, pricingRule := types.PartialFillModeNil, types.PricingRuleUniform
+	if ba, ok := auction.(*types.BatchAuction); ok {
+		partialFillMode, pricingRule = ba.PartialFillMode, ba.PricingRule
+	}
+
 	matchRes := &types.MatchResult{
 		MatchPrice:          sdk.Dec{},
 		MatchedAmount:       sdk.ZeroInt(),
 		MatchResultByBidder: map[string]*types.BidderMatchResult{},
 	}
 
+	levels := k.GetPriceLevelsByAuctionId(ctx, auction.GetId())
+	fits := k.batchBidsFitter(ctx, auction, levels, allowedBidders, defaultMaxBidAmt, partialFillMode, pricingRule)
+
 	// We use binary search to find the best(the lowest possible) matching price.
-	// Note that the returned index from sort.Search is not used, since
-	// we're already storing the match result inside the closure.
-	// In this way, we can reduce redundant calculation for the matching price
-	// after finding it.
-	sort.Search(len(prices), func(i int) bool {
-		// Reverse the index, since prices are sorted in descending order.
-		// Note that our goal is to find the first true(matched) condition, starting
-		// from the lowest price.
-		i = (len(prices) - 1) - i
-		res, matched := types.Match(prices[i], prices, bidsByPrice, sellingAmt, allowedBidders)
-		if matched { // If we found a valid matching price, store the result
+	// Reverse the index, since the price levels are sorted in descending order.
+	// Note that our goal is to find the first true(fit) condition, starting
+	// from the lowest price.
+	i := sort.Search(len(levels), func(i int) bool {
+		return fits((len(levels) - 1) - i)
+	})
+	if i < len(levels) {
+		matchPrice := levels[(len(levels)-1)-i].Price
+
+		var prices []sdk.Dec
+		bidsByPrice := map[string][]types.Bid{}
+		k.IterateBidsByPrice(ctx, auction.GetId(), func(bid types.Bid) (stop bool) {
+			if bid.Price.LT(matchPrice) {
+				return true
+			}
+			if len(prices) == 0 || !prices[len(prices)-1].Equal(bid.Price) {
+				prices = append(prices, bid.Price)
+			}
+			bidsByPrice[bid.Price.String()] = append(bidsByPrice[bid.Price.String()], bid)
+			return false
+		})
+
+		res, matched := types.Match(matchPrice, prices, bidsByPrice, sellingAmt, allowedBidders, defaultMaxBidAmt, auction.GetPayingCoinDenom(), auction.GetPayingCoinRates(), partialFillMode, pricingRule)
+		if matched {
 			matchRes = res
+		} else {
+			k.Logger(ctx).Error("bids don't match at the price found from the price levels; searching all bids",
+				"auction_id", auction.GetId(), "price", matchPrice)
+			if res, matched := k.matchAllBids(ctx, auction, allowedBidders, defaultMaxBidAmt, partialFillMode, pricingRule); matched {
+				matchRes = res
+			}
 		}
-		return matched
-	})
+	}
 
 	mInfo.MatchedLen = int64(len(matchRes.MatchedBids))
 	mInfo.MatchedPrice = matchRes.MatchPrice
 	mInfo.TotalMatchedAmount = matchRes.MatchedAmount
 
-	reservedAmtByBidder := map[string]sdk.Int{}
-	for _, bid := range bids {
-		bidderReservedAmt, ok := reservedAmtByBidder[bid.Bidder]
-		if !ok {
-			bidderReservedAmt = sdk.ZeroInt()
-		}
-		reservedAmtByBidder[bid.Bidder] = bidderReservedAmt.Add(bid.ConvertToPayingAmount(auction.GetPayingCoinDenom()))
-	}
+	// The reserved amounts are taken from the bidder aggregates instead of iterating all bids
+	reservedAmtByBidder := k.getReservedPayingAmounts(ctx, auction)
 
 	for bidder, reservedAmt := range reservedAmtByBidder {
 		mInfo.AllocationMap[bidder] = sdk.ZeroInt()
@@ -115,3 +157,109 @@ func (k Keeper) CalculateBatchAllocation(ctx sdk.Context, auction types.AuctionI
 
 	return mInfo
 }
+
+// matchAllBids loads all bids of the batch auction and searches their prices for the lowest matching price.
+func (k Keeper) matchAllBids(
+	ctx sdk.Context, auction types.AuctionI, allowedBidders []types.AllowedBidder,
+	defaultMaxBidAmt sdk.Int, partialFillMode types.PartialFillMode, pricingRule types.PricingRule,
+) (matchRes *types.MatchResult, matched bool) {
+	prices, bidsByPrice := types.BidsByPrice(k.GetBidsByAuctionId(ctx, auction.GetId()))
+
+	// Reverse the index, since prices are sorted in descending order.
+	sort.Search(len(prices), func(i int) bool {
+		i = (len(prices) - 1) - i
+		res, ok := types.Match(prices[i], prices, bidsByPrice, auction.GetSellingCoin().Amount, allowedBidders, defaultMaxBidAmt, auction.GetPayingCoinDenom(), auction.GetPayingCoinRates(), partialFillMode, pricingRule)
+		if ok {
+			matchRes, matched = res, true
+		}
+		return ok
+	})
+	return matchRes, matched
+}
+
+// batchBidsFitter returns the function that reports whether the bids of the batch auction fit in the selling amount
+// when the auction is matched at the price of the price level with the given index.
+// The upper bound of the demand is calculated from the cumulative demand of the price levels first,
+// and the bids are iterated from the highest price only if the upper bound exceeds the selling amount,
+// which stops as soon as the matched amount exceeds the selling amount.
+func (k Keeper) batchBidsFitter(
+	ctx sdk.Context, auction types.AuctionI, levels []types.PriceLevel, allowedBidders []types.AllowedBidder,
+	defaultMaxBidAmt sdk.Int, partialFillMode types.PartialFillMode, pricingRule types.PricingRule,
+) func(i int) bool {
+	sellingAmt := sdk.NewDecFromInt(auction.GetSellingCoin().Amount)
+
+	// manyAmts[n], worthAmts[n] and worthSellingAmts[n] hold the cumulative demand of the first n price levels.
+	// The worth paying amounts are converted to the selling amounts by the price of each level for pay-as-bid.
+	manyAmts := make([]sdk.Int, len(levels)+1)
+	worthAmts := make([]sdk.Dec, len(levels)+1)
+	worthSellingAmts := make([]sdk.Dec, len(levels)+1)
+	manyAmts[0], worthAmts[0], worthSellingAmts[0] = sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroDec()
+	for n, level := range levels {
+		worthAmt := level.WorthPayingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+		manyAmts[n+1] = manyAmts[n].Add(level.ManyAmount)
+		worthAmts[n+1] = worthAmts[n].Add(worthAmt)
+		worthSellingAmts[n+1] = worthSellingAmts[n].Add(worthAmt.Quo(level.Price))
+	}
+
+	maxBidAmtByBidder := map[string]sdk.Int{}
+	for _, allowedBidder := range allowedBidders {
+		maxBidAmtByBidder[allowedBidder.Bidder] = allowedBidder.MaxBidAmount
+	}
+
+	return func(i int) bool {
+		matchPrice := levels[i].Price
+
+		// The bids at the match price don't have to fit in the selling amount
+		// unless the partial fill mode is PartialFillModeNil.
+		n := i + 1
+		if partialFillMode != types.PartialFillModeNil {
+			n = i
+		}
+		if n == 0 {
+			return true
+		}
+
+		upperAmt := sdk.NewDecFromInt(manyAmts[n]).Add(worthAmts[n].Quo(matchPrice))
+		if pricingRule == types.PricingRulePayAsBid {
+			upperAmt = sdk.NewDecFromInt(manyAmts[n]).Add(worthSellingAmts[n])
+		}
+		if upperAmt.LTE(sellingAmt) {
+			return true
+		}
+
+		lowestPrice := levels[n-1].Price
+		bidAmtByBidder := map[string]sdk.Int{}
+		matchedAmt := sdk.ZeroInt()
+		fit := true
+		k.IterateBidsByPrice(ctx, auction.GetId(), func(bid types.Bid) (stop bool) {
+			if bid.Price.LT(lowestPrice) {
+				return true
+			}
+
+			fillPrice := matchPrice
+			if pricingRule == types.PricingRulePayAsBid {
+				fillPrice = bid.Price
+			}
+			bidAmt := bid.ConvertToMatchAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates(), fillPrice)
+
+			maxBidAmt, ok := maxBidAmtByBidder[bid.Bidder]
+			if !ok {
+				maxBidAmt = defaultMaxBidAmt
+			}
+			prevBidAmt, ok := bidAmtByBidder[bid.Bidder]
+			if !ok {
+				prevBidAmt = sdk.ZeroInt()
+			}
+			matchAmt := sdk.MinInt(bidAmt, maxBidAmt.Sub(prevBidAmt))
+			bidAmtByBidder[bid.Bidder] = prevBidAmt.Add(matchAmt)
+
+			matchedAmt = matchedAmt.Add(matchAmt)
+			if matchedAmt.GT(auction.GetSellingCoin().Amount) {
+				fit = false
+				return true
+			}
+			return false
+		})
+		return fit
+	}
+}
@@ -2,10 +2,19 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/tendermint/fundraising/app"
+	"github.com/tendermint/fundraising/testutil/simapp"
+	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
 	"github.com/tendermint/fundraising/x/fundraising/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -761,7 +770,7 @@ func (s *KeeperTestSuite) TestCalculateAllocation_Mixed2_LimitedDifferent() {
 	mInfo := s.keeper.CalculateBatchAllocation(s.ctx, a)
 
 	// Checking
-	s.Require().Equal(int64(12), mInfo.MatchedLen)
+	s.Require().Equal(int64(13), mInfo.MatchedLen)
 	matchingPrice := parseDec("0.5")
 	s.Require().Equal(mInfo.MatchedPrice, matchingPrice)
 
@@ -1273,3 +1282,366 @@ func (s *KeeperTestSuite) TestCalculateAllocation_Mixed3_LimitedDifferent() {
 	err = s.keeper.RefundPayingCoin(s.ctx, auction, mInfo)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestCalculateAllocation_CumulativeMaxBidAmount() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	// The bids of the bidder 1 reach their maximum bid amount of 300_000_000 at the bid prices
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.6"), parseCoin("100_000_000denom1"), sdk.NewInt(300_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.6"), parseCoin("120_000_000denom2"), sdk.ZeroInt(), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("500_000_000denom1"), sdk.NewInt(500_000_000), true)
+
+	s.fundAddr(s.addr(1), parseCoins("1_000_000denom2"))
+	_, err := s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(auction.Id, s.addr(1).String(), types.BidTypeBatchMany, parseDec("0.5"), parseCoin("1_000_000denom1")))
+	s.Require().ErrorIs(err, types.ErrOverMaxBidAmountLimit)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+
+	mInfo := s.keeper.CalculateBatchAllocation(s.ctx, a)
+
+	// The worth bid is converted to more selling coin at the lower matching price,
+	// but the bidder 1 is still limited by their maximum bid amount
+	s.Require().Equal(int64(3), mInfo.MatchedLen)
+	s.Require().Equal(parseDec("0.5"), mInfo.MatchedPrice)
+	s.Require().Equal(sdk.NewInt(800_000_000), mInfo.TotalMatchedAmount)
+	s.Require().Equal(sdk.NewInt(300_000_000), mInfo.AllocationMap[s.addr(1).String()])
+	s.Require().Equal(sdk.NewInt(500_000_000), mInfo.AllocationMap[s.addr(2).String()])
+	s.Require().Equal(sdk.NewInt(150_000_000), mInfo.ReservedMatchedMap[s.addr(1).String()])
+	s.Require().Equal(sdk.NewInt(30_000_000), mInfo.RefundMap[s.addr(1).String()])
+}
+
+func (s *KeeperTestSuite) TestCalculateAllocation_PartialFill() {
+	for _, tc := range []struct {
+		name            string
+		partialFillMode types.PartialFillMode
+		matchedPrice    sdk.Dec
+		allocations     []sdk.Int
+		refunds         []sdk.Int
+	}{
+		{
+			"no partial fill",
+			types.PartialFillModeNil,
+			parseDec("1"),
+			[]sdk.Int{sdk.NewInt(500_000_000), sdk.ZeroInt(), sdk.ZeroInt()},
+			[]sdk.Int{sdk.ZeroInt(), sdk.NewInt(360_000_000), sdk.NewInt(180_000_000)},
+		},
+		{
+			"pro rata",
+			types.PartialFillModeProRata,
+			parseDec("0.9"),
+			[]sdk.Int{sdk.NewInt(500_000_000), sdk.NewInt(333_333_333), sdk.NewInt(166_666_666)},
+			[]sdk.Int{sdk.NewInt(50_000_000), sdk.NewInt(60_000_000), sdk.NewInt(30_000_000)},
+		},
+		{
+			"time priority",
+			types.PartialFillModeTimePriority,
+			parseDec("0.9"),
+			[]sdk.Int{sdk.NewInt(500_000_000), sdk.NewInt(400_000_000), sdk.NewInt(100_000_000)},
+			[]sdk.Int{sdk.NewInt(50_000_000), sdk.ZeroInt(), sdk.NewInt(90_000_000)},
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			auction := s.createBatchAuction(
+				s.addr(0),
+				parseDec("1"),
+				parseDec("0.1"),
+				parseCoin("1_000_000_000denom1"),
+				"denom2",
+				[]types.VestingSchedule{},
+				0,
+				sdk.MustNewDecFromStr("0.2"),
+				time.Now().AddDate(0, 0, -1),
+				time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+				true,
+			)
+			auction.PartialFillMode = tc.partialFillMode
+			s.keeper.SetAuction(s.ctx, auction)
+
+			s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1"), parseCoin("500_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+			s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.9"), parseCoin("400_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+			s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.9"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+			a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+			s.Require().True(found)
+
+			mInfo := s.keeper.CalculateBatchAllocation(s.ctx, a)
+			s.Require().Equal(tc.matchedPrice, mInfo.MatchedPrice)
+			for i := range tc.allocations {
+				bidder := s.addr(i + 1).String()
+				s.Require().True(tc.allocations[i].Equal(mInfo.AllocationMap[bidder]), bidder)
+				s.Require().True(tc.refunds[i].Equal(mInfo.RefundMap[bidder]), bidder)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchAuction_PayAsBid() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.MustNewDecFromStr("0.2"),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	auction.PricingRule = types.PricingRulePayAsBid
+	s.keeper.SetAuction(s.ctx, auction)
+
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1"), parseCoin("500_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("500_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(3), parseDec("0.5"), parseCoin("500_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+
+	resp, err := s.querier.SimulateBatchMatch(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateBatchMatchRequest{AuctionId: auction.Id, Bidder: s.addr(1).String()})
+	s.Require().NoError(err)
+	s.Require().Equal(parseDec("0.8"), resp.MatchedPrice)
+	s.Require().Equal(sdk.NewInt(500_000_000), resp.PaidAmount)
+	s.Require().True(resp.RefundAmount.IsZero())
+
+	s.ctx = s.ctx.WithBlockTime(a.GetEndTimes()[0])
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	// Every winning bid pays its own bid price
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
+	s.Require().Equal(parseCoin("500_000_000denom1"), s.getBalance(s.addr(2), "denom1"))
+	s.Require().True(s.getBalance(s.addr(2), "denom2").IsZero())
+	s.Require().True(s.getBalance(s.addr(3), "denom1").IsZero())
+	s.Require().Equal(parseCoin("250_000_000denom2"), s.getBalance(s.addr(3), "denom2"))
+
+	settlement, found := s.keeper.GetAuctionSettlement(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().Equal(parseDec("0.8"), settlement.MatchedPrice)
+	s.Require().Equal(sdk.NewInt(900_000_000), settlement.TotalRaisedAmount)
+	s.Require().Equal(parseCoin("900_000_000denom2"), s.getBalance(s.addr(0), "denom2"))
+}
+
+func (s *KeeperTestSuite) TestCalculateBatchAllocation_PriceIndex() {
+	auction := newRandomBatchAuction(1, sdk.NewInt(5_000_000_000))
+	s.keeper.SetAuction(s.ctx, auction)
+	setRandomBatchBids(s.ctx, s.keeper, rand.New(rand.NewSource(1)), auction.Id, 300, 30, 20, 1_000_000_000)
+
+	for _, tc := range []struct {
+		partialFillMode types.PartialFillMode
+		pricingRule     types.PricingRule
+	}{
+		{types.PartialFillModeNil, types.PricingRuleUniform},
+		{types.PartialFillModeNil, types.PricingRulePayAsBid},
+		{types.PartialFillModeProRata, types.PricingRuleUniform},
+		{types.PartialFillModeTimePriority, types.PricingRulePayAsBid},
+	} {
+		s.Run(fmt.Sprintf("%s/%s", tc.partialFillMode, tc.pricingRule), func() {
+			auction.PartialFillMode, auction.PricingRule = tc.partialFillMode, tc.pricingRule
+
+			// The matching price found from the price levels must be same as the one found from all bids
+			cacheCtx, _ := s.ctx.CacheContext()
+			expected := calculateBatchAllocationInMemory(cacheCtx, s.keeper, auction)
+			cacheCtx, _ = s.ctx.CacheContext()
+			mInfo := s.keeper.CalculateBatchAllocation(cacheCtx, auction)
+			s.Require().True(expected.MatchedPrice.Equal(mInfo.MatchedPrice), "%s != %s", expected.MatchedPrice, mInfo.MatchedPrice)
+
+			// The bids at the matching price can be filled partially in a different order of the bids
+			if tc.partialFillMode == types.PartialFillModeNil {
+				s.Require().True(expected.TotalMatchedAmount.Equal(mInfo.TotalMatchedAmount))
+				s.Require().Len(mInfo.AllocationMap, len(expected.AllocationMap))
+				for bidder, allocatedAmt := range expected.AllocationMap {
+					s.Require().True(allocatedAmt.Equal(mInfo.AllocationMap[bidder]))
+					s.Require().True(expected.ReservedMatchedMap[bidder].Equal(mInfo.ReservedMatchedMap[bidder]))
+					s.Require().True(expected.RefundMap[bidder].Equal(mInfo.RefundMap[bidder]))
+				}
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCalculateBatchAllocation_PriceLevelMismatch() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("1"), parseCoin("600_000_000denom1"), sdk.NewInt(600_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("600_000_000denom1"), sdk.NewInt(600_000_000), true)
+
+	// The price level at 0.5 understates its demand, so the bids don't match at the price found from the price levels
+	level, found := s.keeper.GetPriceLevel(s.ctx, auction.Id, parseDec("0.5"))
+	s.Require().True(found)
+	level.ManyAmount = sdk.ZeroInt()
+	s.keeper.SetPriceLevel(s.ctx, level)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+
+	mInfo := s.keeper.CalculateBatchAllocation(s.ctx, a)
+	s.Require().Equal(int64(1), mInfo.MatchedLen)
+	s.Require().Equal(parseDec("1"), mInfo.MatchedPrice)
+	s.Require().Equal(sdk.NewInt(600_000_000), mInfo.AllocationMap[s.addr(1).String()])
+	s.Require().True(mInfo.AllocationMap[s.addr(2).String()].IsZero())
+}
+
+// BenchmarkCalculateBatchAllocation compares finding the matching price of the batch auction with 100k bids
+// from the bid price index and the price levels against loading and sorting all bids in memory.
+func BenchmarkCalculateBatchAllocation(b *testing.B) {
+	a := simapp.New(app.DefaultNodeHome)
+	ctx := a.BaseApp.NewContext(false, tmproto.Header{})
+	k := a.FundraisingKeeper
+
+	auction := newRandomBatchAuction(1, sdk.NewInt(1_000_000_000_000))
+	k.SetAuction(ctx, auction)
+	setRandomBatchBids(ctx, k, rand.New(rand.NewSource(0)), auction.Id, 100_000, 1_000, 1_000, 1_000_000_000_000)
+
+	b.Run("in-memory", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			calculateBatchAllocationInMemory(cacheCtx, k, auction)
+		}
+	})
+	b.Run("price-index", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			k.CalculateBatchAllocation(cacheCtx, auction)
+		}
+	})
+}
+
+// newRandomBatchAuction returns a started batch auction that sells denom1 for denom2 to the allowed bidders.
+func newRandomBatchAuction(id uint64, sellingAmt sdk.Int) *types.BatchAuction {
+	return types.NewBatchAuction(
+		types.NewBaseAuction(
+			id,
+			types.AuctionTypeBatch,
+			sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+			types.SellingReserveAddress(id).String(),
+			types.PayingReserveAddress(id).String(),
+			sdk.OneDec(),
+			sdk.NewCoin("denom1", sellingAmt),
+			"denom2",
+			types.VestingReserveAddress(id).String(),
+			[]types.VestingSchedule{},
+			types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+			[]time.Time{types.MustParseRFC3339("2023-01-01T00:00:00Z")},
+			types.AuctionStatusStarted,
+			false,
+			false,
+			sdk.ZeroInt(),
+		),
+		sdk.MustNewDecFromStr("0.1"),
+		sdk.ZeroDec(),
+		0,
+		sdk.ZeroDec(),
+	)
+}
+
+// setRandomBatchBids sets the allowed bidders with the maximum bid amounts up to maxBidAmt and their random
+// batch auction bids directly in the store without reserving the paying coins, since only the matching is of interest.
+func setRandomBatchBids(ctx sdk.Context, k keeper.Keeper, r *rand.Rand, auctionId uint64, numBids, numPrices, numBidders int, maxBidAmt int64) {
+	bidders := make([]sdk.AccAddress, numBidders)
+	for i := range bidders {
+		bidders[i] = sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("bidder%d", i))))
+		k.SetAllowedBidder(ctx, auctionId, types.NewAllowedBidder(bidders[i], sdk.NewInt(r.Int63n(maxBidAmt)+10_000_000)))
+	}
+
+	for i := 0; i < numBids; i++ {
+		price := sdk.NewDecWithPrec(int64(100+r.Intn(numPrices)), 3)
+		bidType, coin := types.BidTypeBatchWorth, sdk.NewInt64Coin("denom2", r.Int63n(100_000_000)+1_000_000)
+		if r.Intn(2) == 0 {
+			bidType, coin = types.BidTypeBatchMany, sdk.NewInt64Coin("denom1", r.Int63n(100_000_000)+1_000_000)
+		}
+		bidId := k.GetNextBidIdWithUpdate(ctx, auctionId)
+		k.SetBid(ctx, types.NewBid(auctionId, bidders[r.Intn(numBidders)], bidId, bidType, price, coin, false))
+	}
+}
+
+// calculateBatchAllocationInMemory calculates matching information of the batch auction by loading and sorting
+// all bids in memory and searching all the bid prices, which is how the batch auction was matched
+// before the bids were indexed by price.
+func calculateBatchAllocationInMemory(ctx sdk.Context, k keeper.Keeper, auction *types.BatchAuction) keeper.MatchingInfo {
+	mInfo := keeper.MatchingInfo{
+		AllocationMap:      map[string]sdk.Int{},
+		ReservedMatchedMap: map[string]sdk.Int{},
+		RefundMap:          map[string]sdk.Int{},
+	}
+
+	bids := k.GetBidsByAuctionId(ctx, auction.Id)
+	prices, bidsByPrice := types.BidsByPrice(bids)
+	allowedBidders := k.GetAllowedBiddersByAuction(ctx, auction.Id)
+
+	defaultMaxBidAmt := sdk.ZeroInt()
+	if auction.GetOpenBidding() {
+		defaultMaxBidAmt = auction.GetDefaultMaxBidAmount()
+	}
+
+	matchRes := &types.MatchResult{
+		MatchPrice:          sdk.Dec{},
+		MatchedAmount:       sdk.ZeroInt(),
+		MatchResultByBidder: map[string]*types.BidderMatchResult{},
+	}
+	sort.Search(len(prices), func(i int) bool {
+		i = (len(prices) - 1) - i
+		res, matched := types.Match(prices[i], prices, bidsByPrice, auction.SellingCoin.Amount, allowedBidders, defaultMaxBidAmt,
+			auction.PayingCoinDenom, auction.GetPayingCoinRates(), auction.PartialFillMode, auction.PricingRule)
+		if matched {
+			matchRes = res
+		}
+		return matched
+	})
+
+	mInfo.MatchedLen = int64(len(matchRes.MatchedBids))
+	mInfo.MatchedPrice = matchRes.MatchPrice
+	mInfo.TotalMatchedAmount = matchRes.MatchedAmount
+
+	for _, bid := range bids {
+		reservedAmt, ok := mInfo.RefundMap[bid.Bidder]
+		if !ok {
+			reservedAmt = sdk.ZeroInt()
+			mInfo.AllocationMap[bid.Bidder] = sdk.ZeroInt()
+			mInfo.ReservedMatchedMap[bid.Bidder] = sdk.ZeroInt()
+		}
+		mInfo.RefundMap[bid.Bidder] = reservedAmt.Add(bid.ConvertToPayingAmount(auction.PayingCoinDenom, auction.GetPayingCoinRates()))
+	}
+	for bidder, bidderRes := range matchRes.MatchResultByBidder {
+		mInfo.AllocationMap[bidder] = bidderRes.MatchedAmount
+		mInfo.ReservedMatchedMap[bidder] = bidderRes.PayingAmount
+		mInfo.RefundMap[bidder] = mInfo.RefundMap[bidder].Sub(bidderRes.PayingAmount)
+	}
+
+	for _, bid := range matchRes.MatchedBids {
+		bid.SetMatched(true)
+		k.SetBid(ctx, bid)
+	}
+	k.SetMatchedBidsLen(ctx, auction.Id, mInfo.MatchedLen)
+
+	return mInfo
+}
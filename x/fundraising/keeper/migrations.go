@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It builds the auction status index, the auction start and end time queues and
+// the release time index of the vesting queues from the existing auctions and vesting queues.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, auction := range m.keeper.GetAuctions(ctx) {
+		m.keeper.setAuctionIndexes(ctx, auction)
+	}
+
+	for _, queue := range m.keeper.GetVestingQueues(ctx) {
+		m.keeper.SetVestingQueue(ctx, queue)
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It moves the module parameters from the legacy x/params subspace to the module store.
+// The bid cancellation cutoff parameter doesn't exist in the subspace, so it is set to the default value.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.KeyBidCancellationCutoff) {
+		m.keeper.paramSpace.Set(ctx, types.KeyBidCancellationCutoff, types.DefaultBidCancellationCutoff)
+	}
+
+	var params types.Params
+	m.keeper.paramSpace.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// It moves the vesting queues and their release time indexes to the keys that include the paying coin denom,
+// since an auction has a vesting queue for each paying coin denom per release time.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	for _, queue := range m.keeper.GetVestingQueues(ctx) {
+		legacyKey := append(types.GetVestingQueueByAuctionIdPrefix(queue.AuctionId), sdk.FormatTimeBytes(queue.ReleaseTime)...)
+		store.Delete(legacyKey)
+		legacyIndexKey := append(append(types.VestingQueueReleaseTimeIndexKeyPrefix, sdk.FormatTimeBytes(queue.ReleaseTime)...), sdk.Uint64ToBigEndian(queue.AuctionId)...)
+		store.Delete(legacyIndexKey)
+		m.keeper.SetVestingQueue(ctx, queue)
+	}
+
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+// It sets the default maximum number of bidders that a closed batch auction pays out per block.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxSettlementBidders = types.DefaultMaxSettlementBidders
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+// It builds the price index and the price levels of the existing batch auction bids.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	for _, bid := range m.keeper.GetBids(ctx) {
+		m.keeper.setBidPriceIndex(ctx, bid)
+	}
+
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+// It builds the bidder aggregates from the existing bids.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	for _, bid := range m.keeper.GetBids(ctx) {
+		m.keeper.addBidToBidderAggregate(ctx, bid)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	standByAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, 1),
+		s.ctx.BlockTime().AddDate(0, 0, 2),
+		true,
+	)
+	startedAuction := s.createFixedPriceAuction(
+		s.addr(1),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 0, 1),
+		true,
+	)
+	releaseTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	s.keeper.SetVestingQueue(s.ctx, types.VestingQueue{
+		AuctionId:   startedAuction.Id,
+		Auctioneer:  startedAuction.Auctioneer,
+		PayingCoin:  parseCoin("100_000_000denom4"),
+		ReleaseTime: releaseTime,
+		Released:    false,
+	})
+
+	// Delete all the indexes to make the store same as the previous version
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{
+		types.AuctionStatusIndexKeyPrefix,
+		types.AuctionStartTimeQueueKeyPrefix,
+		types.AuctionEndTimeQueueKeyPrefix,
+		types.VestingQueueReleaseTimeIndexKeyPrefix,
+	} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusStandBy), 0)
+	s.Require().Len(s.keeper.GetAuctionsToStart(s.ctx, standByAuction.StartTime), 0)
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate2to3(s.ctx))
+
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusStandBy), 1)
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusStarted), 1)
+	s.Require().Len(s.keeper.GetAuctionsToStart(s.ctx, standByAuction.StartTime), 1)
+	s.Require().Len(s.keeper.GetAuctionsToClose(s.ctx, startedAuction.EndTimes[0]), 1)
+	s.Require().Len(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime), 1)
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	params := types.DefaultParams()
+	params.AuctionCreationFee = parseCoins("1_000_000stake")
+	params.PlaceBidFee = parseCoins("1_000stake")
+	params.ExtendedPeriod = 3
+	// The legacy subspace has no max settlement bidders, which is set later by Migrate5to6
+	params.MaxSettlementBidders = 0
+
+	// Set the params that exist in the previous version in the legacy subspace and
+	// delete the params in the module store to make the store same as the previous version
+	subspace := s.app.GetSubspace(types.ModuleName)
+	subspace.Set(s.ctx, types.KeyAuctionCreationFee, params.AuctionCreationFee)
+	subspace.Set(s.ctx, types.KeyPlaceBidFee, params.PlaceBidFee)
+	subspace.Set(s.ctx, types.KeyExtendedPeriod, params.ExtendedPeriod)
+	s.Require().False(subspace.Has(s.ctx, types.KeyBidCancellationCutoff))
+	s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+	s.Require().Equal(types.Params{}, s.keeper.GetParams(s.ctx))
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate3to4(s.ctx))
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+	s.Require().Equal(types.DefaultBidCancellationCutoff, s.keeper.GetParams(s.ctx).BidCancellationCutoff)
+}
+
+func (s *KeeperTestSuite) TestMigrate4to5() {
+	releaseTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	queue := types.VestingQueue{
+		AuctionId:   1,
+		Auctioneer:  s.addr(0).String(),
+		PayingCoin:  parseCoin("100_000_000denom2"),
+		ReleaseTime: releaseTime,
+		Released:    false,
+	}
+
+	// Set the vesting queue with the store key of the previous version
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	legacyKey := append(types.GetVestingQueueByAuctionIdPrefix(1), sdk.FormatTimeBytes(releaseTime)...)
+	store.Set(legacyKey, s.app.AppCodec().MustMarshal(&queue))
+	legacyIndexKey := append(append(types.VestingQueueReleaseTimeIndexKeyPrefix, sdk.FormatTimeBytes(releaseTime)...), sdk.Uint64ToBigEndian(1)...)
+	store.Set(legacyIndexKey, []byte{})
+	s.Require().Equal(types.VestingQueue{}, s.keeper.GetVestingQueue(s.ctx, 1, releaseTime, "denom2"))
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate4to5(s.ctx))
+
+	s.Require().False(store.Has(legacyKey))
+	s.Require().False(store.Has(legacyIndexKey))
+	s.Require().True(store.Has(types.GetVestingQueueReleaseTimeIndexKey(releaseTime, "denom2", 1)))
+	s.Require().Equal(queue, s.keeper.GetVestingQueue(s.ctx, 1, releaseTime, "denom2"))
+	s.Require().Len(s.keeper.GetVestingQueues(s.ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestMigrate5to6() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxSettlementBidders = 0
+	s.keeper.SetParams(s.ctx, params)
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate5to6(s.ctx))
+
+	s.Require().Equal(types.DefaultMaxSettlementBidders, s.keeper.GetParams(s.ctx).MaxSettlementBidders)
+}
+
+func (s *KeeperTestSuite) TestMigrate6to7() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 2, 0),
+		true,
+	)
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(3), parseDec("0.8"), parseCoin("50_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+
+	levels := s.keeper.GetPriceLevelsByAuctionId(s.ctx, auction.Id)
+	s.Require().Len(levels, 2)
+
+	// Delete the price index and the price levels to make the store same as the previous version
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{types.BidPriceIndexKeyPrefix, types.PriceLevelKeyPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	s.Require().Empty(s.keeper.GetPriceLevelsByAuctionId(s.ctx, auction.Id))
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate6to7(s.ctx))
+
+	s.Require().Equal(levels, s.keeper.GetPriceLevelsByAuctionId(s.ctx, auction.Id))
+	var bidIds []uint64
+	s.keeper.IterateBidsByPrice(s.ctx, auction.Id, func(bid types.Bid) (stop bool) {
+		bidIds = append(bidIds, bid.Id)
+		return false
+	})
+	s.Require().Equal([]uint64{3, 1, 2}, bidIds)
+}
+
+func (s *KeeperTestSuite) TestMigrate7to8() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 2, 0),
+		true,
+	)
+	s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.4"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchWorth(auction.Id, s.addr(2), parseDec("0.8"), parseCoin("50_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+
+	aggregates := s.keeper.GetBidderAggregatesByAuctionId(s.ctx, auction.Id)
+	s.Require().Len(aggregates, 2)
+
+	// Delete the bidder aggregates to make the store same as the previous version
+	for _, aggregate := range aggregates {
+		s.keeper.DeleteBidderAggregate(s.ctx, aggregate)
+	}
+	s.Require().Empty(s.keeper.GetBidderAggregatesByAuctionId(s.ctx, auction.Id))
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate7to8(s.ctx))
+
+	s.Require().Equal(aggregates, s.keeper.GetBidderAggregatesByAuctionId(s.ctx, auction.Id))
+}
@@ -48,6 +48,17 @@ func (m msgServer) CreateBatchAuction(goCtx context.Context, msg *types.MsgCreat
 	return &types.MsgCreateBatchAuctionResponse{}, nil
 }
 
+// CreateDutchAuction defines a method to create dutch auction.
+func (m msgServer) CreateDutchAuction(goCtx context.Context, msg *types.MsgCreateDutchAuction) (*types.MsgCreateDutchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateDutchAuction(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDutchAuctionResponse{}, nil
+}
+
 // CancelAuction defines a method to cancel auction.
 func (m msgServer) CancelAuction(goCtx context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -81,6 +92,116 @@ func (m msgServer) ModifyBid(goCtx context.Context, msg *types.MsgModifyBid) (*t
 	return &types.MsgModifyBidResponse{}, nil
 }
 
+// CancelBid defines a method to cancel the bidder's bid
+func (m msgServer) CancelBid(goCtx context.Context, msg *types.MsgCancelBid) (*types.MsgCancelBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelBid(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelBidResponse{}, nil
+}
+
+// UpdateParams defines a method to update the module parameters
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateParams(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ResolveFailedAuction defines a method to retry or force-refund the failed auction
+func (m msgServer) ResolveFailedAuction(goCtx context.Context, msg *types.MsgResolveFailedAuction) (*types.MsgResolveFailedAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ResolveFailedAuction(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveFailedAuctionResponse{}, nil
+}
+
+// AddAllowedBidders defines a method for the auctioneer to add allowed bidders
+func (m msgServer) AddAllowedBidders(goCtx context.Context, msg *types.MsgAddAllowedBidders) (*types.MsgAddAllowedBiddersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.AddAllowedBiddersByAuctioneer(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAllowedBiddersResponse{}, nil
+}
+
+// ClaimVested defines a method for the auctioneer to claim the vested paying coin
+func (m msgServer) ClaimVested(goCtx context.Context, msg *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ClaimVested(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimVestedResponse{}, nil
+}
+
+// ClaimAllocation defines a method for claiming the allocated selling coin and the refunded paying coin
+func (m msgServer) ClaimAllocation(goCtx context.Context, msg *types.MsgClaimAllocation) (*types.MsgClaimAllocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ClaimAllocation(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAllocationResponse{}, nil
+}
+
+// CommitBid defines a method to commit a sealed bid for the auction.
+func (m msgServer) CommitBid(goCtx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CommitBid(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitBidResponse{}, nil
+}
+
+// RevealBid defines a method to reveal the committed sealed bid for the auction.
+func (m msgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.RevealBid(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealBidResponse{}, nil
+}
+
+// UpdateAllowedBidder defines a method for the auctioneer to update the allowed bidder
+func (m msgServer) UpdateAllowedBidder(goCtx context.Context, msg *types.MsgUpdateAllowedBidder) (*types.MsgUpdateAllowedBidderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateAllowedBidderByAuctioneer(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAllowedBidderResponse{}, nil
+}
+
+// RemoveAllowedBidder defines a method for the auctioneer to remove the allowed bidder
+func (m msgServer) RemoveAllowedBidder(goCtx context.Context, msg *types.MsgRemoveAllowedBidder) (*types.MsgRemoveAllowedBidderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RemoveAllowedBidderByAuctioneer(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAllowedBidderResponse{}, nil
+}
+
 // AddAllowedBidder defines a method to add an allowed bidder.
 // This message is created for testing purpose and it must not be used in mainnet.
 func (m msgServer) AddAllowedBidder(goCtx context.Context, msg *types.MsgAddAllowedBidder) (*types.MsgAddAllowedBidderResponse, error) {
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// getSealedBatchAuction returns the sealed bid batch auction for the given auction id.
+func (k Keeper) getSealedBatchAuction(ctx sdk.Context, auctionId uint64) (*types.BatchAuction, error) {
+	auction, found := k.GetAuction(ctx, auctionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d not found", auctionId)
+	}
+
+	if auction.GetStatus() != types.AuctionStatusStarted {
+		return nil, types.ErrInvalidAuctionStatus
+	}
+
+	ba, ok := auction.(*types.BatchAuction)
+	if !ok || !ba.IsSealed() {
+		return nil, sdkerrors.Wrap(types.ErrIncorrectAuctionType, "auction is not a sealed bid auction")
+	}
+
+	return ba, nil
+}
+
+// CommitBid handles types.MsgCommitBid and stores the commitment of the sealed bid.
+// The deposit is reserved in the paying reserve account as collateral for the bid,
+// and the bid itself stays hidden until it is revealed in the reveal window.
+func (k Keeper) CommitBid(ctx sdk.Context, msg *types.MsgCommitBid) (types.BidCommitment, error) {
+	ba, err := k.getSealedBatchAuction(ctx, msg.AuctionId)
+	if err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	if !ctx.BlockTime().Before(ba.GetRevealStartTime()) {
+		return types.BidCommitment{}, sdkerrors.Wrap(types.ErrInvalidBidCommitment, "bids cannot be committed in the reveal window")
+	}
+
+	if _, found := k.GetMaxBidAmount(ctx, ba, msg.GetBidder()); !found {
+		return types.BidCommitment{}, types.ErrNotAllowedBidder
+	}
+
+	if !ba.IsPayingCoinDenom(msg.Deposit.Denom) {
+		return types.BidCommitment{}, types.ErrIncorrectCoinDenom
+	}
+
+	if err := k.PayPlaceBidFee(ctx, msg.GetBidder()); err != nil {
+		return types.BidCommitment{}, sdkerrors.Wrap(err, "failed to pay place bid fee")
+	}
+
+	if err := k.ReservePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), msg.Deposit); err != nil {
+		return types.BidCommitment{}, sdkerrors.Wrap(err, "failed to reserve deposit")
+	}
+
+	// The commitment id is taken from the bid ids, so that the revealed bid keeps the id
+	commitment := types.NewBidCommitment(msg.AuctionId, k.GetNextBidIdWithUpdate(ctx, msg.AuctionId), msg.GetBidder(), msg.Commitment, msg.Deposit)
+	k.SetBidCommitment(ctx, commitment)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(msg.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.Bidder),
+			sdk.NewAttribute(types.AttributeKeyCommitmentId, strconv.FormatUint(commitment.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCommitBid{
+		AuctionId:    msg.AuctionId,
+		Bidder:       msg.Bidder,
+		CommitmentId: commitment.Id,
+		Deposit:      msg.Deposit,
+	}); err != nil {
+		return types.BidCommitment{}, err
+	}
+
+	return commitment, nil
+}
+
+// RevealBid handles types.MsgRevealBid and places the bid of the commitment.
+// The revealed bid must match the commitment and its paying coin must be covered by the deposit.
+// The excess of the deposit is refunded to the bidder.
+func (k Keeper) RevealBid(ctx sdk.Context, msg *types.MsgRevealBid) (types.Bid, error) {
+	ba, err := k.getSealedBatchAuction(ctx, msg.AuctionId)
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	if ctx.BlockTime().Before(ba.GetRevealStartTime()) {
+		return types.Bid{}, sdkerrors.Wrapf(types.ErrInvalidBidCommitment, "bids can be revealed from %s", ba.GetRevealStartTime())
+	}
+
+	commitment, found := k.GetBidCommitment(ctx, msg.AuctionId, msg.CommitmentId)
+	if !found {
+		return types.Bid{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "bid commitment not found")
+	}
+
+	if !commitment.GetBidder().Equals(msg.GetBidder()) {
+		return types.Bid{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the bid creator can reveal the bid")
+	}
+
+	hash := types.BidCommitmentHash(msg.AuctionId, msg.GetBidder(), msg.BidType, msg.Price, msg.Coin, msg.Salt)
+	if !bytes.Equal(hash, commitment.Commitment) {
+		return types.Bid{}, sdkerrors.Wrap(types.ErrInvalidBidCommitment, "revealed bid does not match the commitment")
+	}
+
+	if msg.Price.LT(ba.MinBidPrice) {
+		return types.Bid{}, types.ErrInsufficientMinBidPrice
+	}
+
+	bid := types.NewBid(msg.AuctionId, msg.GetBidder(), commitment.Id, msg.BidType, msg.Price, msg.Coin, false)
+
+	switch bid.Type {
+	case types.BidTypeBatchWorth:
+		err = k.ValidateBatchWorthBid(ctx, ba, bid)
+	case types.BidTypeBatchMany:
+		err = k.ValidateBatchManyBid(ctx, ba, bid)
+	default:
+		err = types.ErrIncorrectAuctionType
+	}
+	if err != nil {
+		return types.Bid{}, err
+	}
+
+	reserveCoin := bid.ConvertToPayingCoin(ba.GetPayingCoinDenom(), ba.GetPayingCoinRates())
+	if reserveCoin.Denom != commitment.Deposit.Denom || commitment.Deposit.IsLT(reserveCoin) {
+		return types.Bid{}, sdkerrors.Wrapf(types.ErrInvalidBidCommitment, "paying coin %s of the bid is not covered by the deposit %s", reserveCoin, commitment.Deposit)
+	}
+
+	refundCoin := commitment.Deposit.Sub(reserveCoin)
+	if refundCoin.IsPositive() {
+		if err := k.ReleasePayingCoin(ctx, msg.AuctionId, msg.GetBidder(), refundCoin); err != nil {
+			return types.Bid{}, sdkerrors.Wrap(err, "failed to release paying coin")
+		}
+	}
+
+	// Call before bid placed hook
+	k.BeforeBidPlaced(ctx, bid.AuctionId, bid.Id, bid.Bidder, bid.Type, bid.Price, bid.Coin)
+
+	k.DeleteBidCommitment(ctx, commitment)
+	k.SetBid(ctx, bid)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(msg.AuctionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, msg.Bidder),
+			sdk.NewAttribute(types.AttributeKeyBidId, strconv.FormatUint(bid.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyBidCoin, bid.Coin.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundCoin, refundCoin.String()),
+		),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRevealBid{
+		AuctionId:  msg.AuctionId,
+		Bidder:     msg.Bidder,
+		BidId:      bid.Id,
+		BidType:    bid.Type,
+		Price:      bid.Price,
+		Coin:       bid.Coin,
+		RefundCoin: refundCoin,
+	}); err != nil {
+		return types.Bid{}, err
+	}
+
+	return bid, nil
+}
+
+// ForfeitBidCommitments forfeits the commitments of the sealed bid auction that are not revealed
+// by the end time. The penalty taken from the deposit at the unrevealed penalty rate is sent to
+// the community pool and the rest of the deposit is refunded to the bidder.
+func (k Keeper) ForfeitBidCommitments(ctx sdk.Context, ba *types.BatchAuction) error {
+	payingReserveAddr := ba.GetPayingReserveAddress()
+	penaltyRate := ba.SealedBidConfig.UnrevealedPenaltyRate
+
+	for _, commitment := range k.GetBidCommitmentsByAuctionId(ctx, ba.GetId()) {
+		penaltyAmt := sdk.NewDecFromInt(commitment.Deposit.Amount).MulTruncate(penaltyRate).TruncateInt()
+		penaltyCoin := sdk.NewCoin(commitment.Deposit.Denom, penaltyAmt)
+		refundCoin := commitment.Deposit.Sub(penaltyCoin)
+
+		if penaltyCoin.IsPositive() {
+			if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(penaltyCoin), payingReserveAddr); err != nil {
+				return sdkerrors.Wrap(err, "failed to send penalty to the community pool")
+			}
+		}
+
+		if refundCoin.IsPositive() {
+			if err := k.ReleasePayingCoin(ctx, ba.GetId(), commitment.GetBidder(), refundCoin); err != nil {
+				return sdkerrors.Wrap(err, "failed to release paying coin")
+			}
+		}
+
+		k.DeleteBidCommitment(ctx, commitment)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCommitmentForfeited,
+				sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(ba.GetId(), 10)),
+				sdk.NewAttribute(types.AttributeKeyBidderAddress, commitment.Bidder),
+				sdk.NewAttribute(types.AttributeKeyCommitmentId, strconv.FormatUint(commitment.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPenaltyCoin, penaltyCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundCoin, refundCoin.String()),
+			),
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventCommitmentForfeited{
+			AuctionId:    ba.GetId(),
+			Bidder:       commitment.Bidder,
+			CommitmentId: commitment.Id,
+			PenaltyCoin:  penaltyCoin,
+			RefundCoin:   refundCoin,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refundBidCommitments refunds the whole deposits of the remaining commitments of the auction
+// without any penalty and deletes the commitments.
+func (k Keeper) refundBidCommitments(ctx sdk.Context, auction types.AuctionI) error {
+	for _, commitment := range k.GetBidCommitmentsByAuctionId(ctx, auction.GetId()) {
+		if err := k.ReleasePayingCoin(ctx, auction.GetId(), commitment.GetBidder(), commitment.Deposit); err != nil {
+			return sdkerrors.Wrap(err, "failed to release deposit")
+		}
+		k.DeleteBidCommitment(ctx, commitment)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+// createSealedBatchAuction creates the sealed bid batch auction that everyone can bid for.
+// The reveal window starts a day after the current block time.
+func (s *KeeperTestSuite) createSealedBatchAuction(auctioneer sdk.AccAddress, sellingCoin sdk.Coin, penaltyRate sdk.Dec) *types.BatchAuction {
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 0, 2),
+		false,
+		true,
+		sellingCoin.Amount,
+		nil,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		&types.SealedBidConfig{
+			RevealPeriod:          24 * time.Hour,
+			UnrevealedPenaltyRate: penaltyRate,
+		},
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+
+	return auction.(*types.BatchAuction)
+}
+
+// commitBid commits the sealed bid with the given salt and the deposit funded to the bidder.
+func (s *KeeperTestSuite) commitBid(auctionId uint64, bidder sdk.AccAddress, bidType types.BidType, price sdk.Dec, coin sdk.Coin, salt string, deposit sdk.Coin) types.BidCommitment {
+	s.fundAddr(bidder, sdk.NewCoins(deposit))
+
+	commitment, err := s.keeper.CommitBid(s.ctx, types.NewMsgCommitBid(
+		auctionId,
+		bidder.String(),
+		types.BidCommitmentHash(auctionId, bidder, bidType, price, coin, salt),
+		deposit,
+	))
+	s.Require().NoError(err)
+
+	return commitment
+}
+
+func (s *KeeperTestSuite) TestSealedBid() {
+	auction := s.createSealedBatchAuction(s.addr(0), parseCoin("1_000_000denom1"), parseDec("0.1"))
+	s.Require().True(auction.IsSealed())
+
+	// Bids can't be placed without a commitment
+	_, err := s.keeper.PlaceBid(s.ctx, types.NewMsgPlaceBid(auction.Id, s.addr(1).String(), types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1")))
+	s.Require().ErrorIs(err, types.ErrIncorrectAuctionType)
+
+	c1 := s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1", parseCoin("700_000denom2"))
+	c2 := s.commitBid(auction.Id, s.addr(2), types.BidTypeBatchMany, parseDec("0.9"), parseCoin("600_000denom1"), "salt2", parseCoin("540_000denom2"))
+	c3 := s.commitBid(auction.Id, s.addr(3), types.BidTypeBatchMany, parseDec("2"), parseCoin("1_000_000denom1"), "salt3", parseCoin("2_000_000denom2"))
+	s.Require().Equal([]uint64{1, 2, 3}, []uint64{c1.Id, c2.Id, c3.Id})
+	s.Require().Equal(3, s.countEvents(types.EventTypeCommitBid))
+	s.Require().Empty(s.keeper.GetBidsByAuctionId(s.ctx, auction.Id))
+
+	resp, err := s.querier.BidCommitments(sdk.WrapSDKContext(s.ctx), &types.QueryBidCommitmentsRequest{AuctionId: auction.Id, Bidder: s.addr(2).String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.BidCommitment{c2}, resp.Commitments)
+
+	// The bids can't be revealed before the reveal window
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(auction.Id, s.addr(1).String(), c1.Id, types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1"))
+	s.Require().ErrorIs(err, types.ErrInvalidBidCommitment)
+
+	_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetRevealStartTime())
+
+	// The bids can't be committed in the reveal window
+	s.fundAddr(s.addr(4), parseCoins("1_000_000denom2"))
+	_, err = s.keeper.CommitBid(s.ctx, types.NewMsgCommitBid(auction.Id, s.addr(4).String(), c1.Commitment, parseCoin("1_000_000denom2")))
+	s.Require().ErrorIs(err, types.ErrInvalidBidCommitment)
+
+	for _, tc := range []struct {
+		name   string
+		msg    *types.MsgRevealBid
+		expErr error
+	}{
+		{
+			"wrong salt",
+			types.NewMsgRevealBid(auction.Id, s.addr(1).String(), c1.Id, types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt2"),
+			types.ErrInvalidBidCommitment,
+		},
+		{
+			"wrong price",
+			types.NewMsgRevealBid(auction.Id, s.addr(1).String(), c1.Id, types.BidTypeBatchMany, parseDec("1.1"), parseCoin("600_000denom1"), "salt1"),
+			types.ErrInvalidBidCommitment,
+		},
+		{
+			"other bidder",
+			types.NewMsgRevealBid(auction.Id, s.addr(2).String(), c1.Id, types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1"),
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"commitment not found",
+			types.NewMsgRevealBid(auction.Id, s.addr(1).String(), 10, types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1"),
+			sdkerrors.ErrNotFound,
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, err := s.keeper.RevealBid(s.ctx, tc.msg)
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// The excess of the deposit is refunded when the bid is revealed
+	bid, err := s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(auction.Id, s.addr(1).String(), c1.Id, types.BidTypeBatchMany, parseDec("1"), parseCoin("600_000denom1"), "salt1"))
+	s.Require().NoError(err)
+	s.Require().Equal(c1.Id, bid.Id)
+	s.Require().Equal(parseCoin("100_000denom2"), s.getBalance(s.addr(1), "denom2"))
+	_, found := s.keeper.GetBidCommitment(s.ctx, auction.Id, c1.Id)
+	s.Require().False(found)
+
+	_, err = s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(auction.Id, s.addr(2).String(), c2.Id, types.BidTypeBatchMany, parseDec("0.9"), parseCoin("600_000denom1"), "salt2"))
+	s.Require().NoError(err)
+	s.Require().Equal(2, s.countEvents(types.EventTypeRevealBid))
+
+	// Revealed bids of the sealed bid auction are locked
+	err = s.keeper.CancelBid(s.ctx, types.NewMsgCancelBid(auction.Id, s.addr(1).String(), c1.Id))
+	s.Require().ErrorIs(err, types.ErrBidLocked)
+
+	_, broken = keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// The unrevealed commitment is forfeited and only the revealed bids are matched
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	s.Require().Empty(s.keeper.GetBidCommitments(s.ctx))
+	s.Require().Equal(1, s.countEvents(types.EventTypeCommitmentForfeited))
+	s.Require().Equal(parseCoin("1_800_000denom2"), s.getBalance(s.addr(3), "denom2"))
+	s.Require().True(s.getBalance(s.addr(3), "denom1").IsZero())
+	communityPool := s.keeper.GetParams(s.ctx).AuctionCreationFee.Add(parseCoin("200_000denom2"))
+	s.Require().True(s.app.DistrKeeper.GetFeePool(s.ctx).CommunityPool.IsEqual(sdk.NewDecCoinsFromCoins(communityPool...)))
+
+	s.Require().Equal(parseCoin("600_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("540_000denom2"), s.getBalance(s.addr(2), "denom2"))
+
+	_, broken = keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestSealedBid_InsufficientDeposit() {
+	auction := s.createSealedBatchAuction(s.addr(0), parseCoin("1_000_000denom1"), parseDec("0.5"))
+
+	c := s.commitBid(auction.Id, s.addr(1), types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000denom2"), "salt", parseCoin("400_000denom2"))
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetRevealStartTime())
+	_, err := s.keeper.RevealBid(s.ctx, types.NewMsgRevealBid(auction.Id, s.addr(1).String(), c.Id, types.BidTypeBatchWorth, parseDec("1"), parseCoin("500_000denom2"), "salt"))
+	s.Require().ErrorIs(err, types.ErrInvalidBidCommitment)
+
+	// The deposit is refunded without any penalty when the auction fails
+	a, found := s.keeper.GetAuction(s.ctx, auction.Id)
+	s.Require().True(found)
+	s.Require().NoError(s.keeper.RefundFailedAuction(s.ctx, a))
+	s.Require().Empty(s.keeper.GetBidCommitments(s.ctx))
+	s.Require().Equal(parseCoin("400_000denom2"), s.getBalance(s.addr(1), "denom2"))
+}
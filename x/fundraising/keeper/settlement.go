@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+// StartSettlement records the final matching result of the closed auction and updates the status to
+// AuctionStatusSettling, so that the allocations and refunds are paid out to the bidders over multiple blocks.
+// The bidder settlements are the stored matching result that the settlement resumes from.
+func (k Keeper) StartSettlement(ctx sdk.Context, auction types.AuctionI, mInfo MatchingInfo) error {
+	// Call hook before selling coin allocation
+	k.BeforeSellingCoinsAllocated(ctx, auction.GetId(), mInfo.AllocationMap, mInfo.RefundMap)
+
+	_ = auction.SetStatus(types.AuctionStatusSettling)
+	k.SetAuction(ctx, auction)
+
+	k.SetSettlementCursor(ctx, types.SettlementCursor{AuctionId: auction.GetId()})
+
+	return k.SetSettlement(ctx, auction, mInfo)
+}
+
+// ExecuteSettlingStatus pays out the allocations and refunds of the settling auction to the next bidders
+// up to the maximum number of bidders per block.
+func (k Keeper) ExecuteSettlingStatus(ctx sdk.Context, auction types.AuctionI) error {
+	return k.SettleBidders(ctx, auction, k.GetParams(ctx).MaxSettlementBidders)
+}
+
+// SettleBidders pays out the allocations and refunds to at most limit bidders of the settling auction,
+// resuming from the settlement cursor. The limit of zero means that all the remaining bidders are settled.
+// Once all the bidders are settled, the remaining selling coin is refunded to the auctioneer and
+// the vesting schedules are applied.
+func (k Keeper) SettleBidders(ctx sdk.Context, auction types.AuctionI, limit uint32) error {
+	if auction.GetStatus() != types.AuctionStatusSettling {
+		return fmt.Errorf("auction %d is not settling", auction.GetId())
+	}
+
+	cursor, found := k.GetSettlementCursor(ctx, auction.GetId())
+	if !found {
+		return fmt.Errorf("settlement cursor of auction %d is not found", auction.GetId())
+	}
+
+	settlements, done := k.getNextBidderSettlements(ctx, cursor, limit)
+
+	allocationMap := map[string]sdk.Int{}
+	refundMap := map[string]sdk.Int{}
+	for _, settlement := range settlements {
+		allocationMap[settlement.Bidder] = settlement.AllocatedAmount
+		refundMap[settlement.Bidder] = settlement.RefundedAmount
+	}
+
+	if err := k.allocateSellingCoin(ctx, auction, allocationMap); err != nil {
+		return err
+	}
+
+	if err := k.refundPayingCoin(ctx, auction, refundMap); err != nil {
+		return err
+	}
+
+	if len(settlements) > 0 {
+		cursor.LastBidder = settlements[len(settlements)-1].Bidder
+		cursor.SettledBidders += uint64(len(settlements))
+	}
+
+	if !done {
+		k.SetSettlementCursor(ctx, cursor)
+		return nil
+	}
+
+	k.DeleteSettlementCursor(ctx, auction.GetId())
+
+	if err := k.RefundRemainingSellingCoin(ctx, auction); err != nil {
+		return err
+	}
+
+	if err := k.ApplyVestingSchedules(ctx, auction); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuctionSettled,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionStatus, auction.GetStatus().String()),
+			sdk.NewAttribute(types.AttributeKeySettledBidders, strconv.FormatUint(cursor.SettledBidders, 10)),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAuctionSettled{
+		AuctionId:      auction.GetId(),
+		AuctionStatus:  auction.GetStatus(),
+		SettledBidders: cursor.SettledBidders,
+	})
+}
+
+// getNextBidderSettlements returns at most limit bidder settlements of the auction after the last bidder of
+// the cursor in the store key order, and whether there is no bidder settlement left after them.
+func (k Keeper) getNextBidderSettlements(ctx sdk.Context, cursor types.SettlementCursor, limit uint32) (settlements []types.BidderSettlement, done bool) {
+	prefix := types.GetBidderSettlementsByAuctionPrefix(cursor.AuctionId)
+	start := prefix
+	if cursor.LastBidder != "" {
+		lastBidderAddr, err := sdk.AccAddressFromBech32(cursor.LastBidder)
+		if err != nil {
+			panic(err)
+		}
+		// Start right after the key of the last bidder
+		start = append(types.GetBidderSettlementKey(cursor.AuctionId, lastBidderAddr), 0x00)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && len(settlements) == int(limit) {
+			return settlements, false
+		}
+		var settlement types.BidderSettlement
+		k.cdc.MustUnmarshal(iter.Value(), &settlement)
+		settlements = append(settlements, settlement)
+	}
+	return settlements, true
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
+	"github.com/tendermint/fundraising/x/fundraising/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+// createOpenBatchAuction creates the batch auction that everyone can bid for.
+func (s *KeeperTestSuite) createOpenBatchAuction(auctioneer sdk.AccAddress, sellingCoin sdk.Coin) types.AuctionI {
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 1, 0),
+		false,
+		true,
+		sellingCoin.Amount,
+		nil,
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+
+	return auction
+}
+
+// placeOpenBidBatchMany places the batch many bid for the open batch auction with the exact paying coin reserved.
+func (s *KeeperTestSuite) placeOpenBidBatchMany(auctionId uint64, bidder sdk.AccAddress, price sdk.Dec, coin sdk.Coin) {
+	fundAmt := sdk.NewDecFromInt(coin.Amount).Mul(price).Ceil().TruncateInt()
+	s.fundAddr(bidder, sdk.NewCoins(sdk.NewCoin("denom2", fundAmt)))
+
+	_, err := s.keeper.PlaceBid(s.ctx, &types.MsgPlaceBid{
+		AuctionId: auctionId,
+		Bidder:    bidder.String(),
+		BidType:   types.BidTypeBatchMany,
+		Price:     price,
+		Coin:      coin,
+	})
+	s.Require().NoError(err)
+}
+
+// nextBlock commits the current block and begins the next block at the given block time,
+// so that the store does not keep the whole state of many blocks uncommitted.
+func (s *KeeperTestSuite) nextBlock(blockTime time.Time) abci.ResponseBeginBlock {
+	s.app.Commit()
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1, Time: blockTime}
+	res := s.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	s.ctx = s.app.BaseApp.NewContext(false, header)
+
+	return res
+}
+
+// countBlockEvents returns the number of the events of the given type emitted in the begin block.
+func countBlockEvents(res abci.ResponseBeginBlock, eventType string) int {
+	count := 0
+	for _, event := range res.Events {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *KeeperTestSuite) TestSettlement_ManyBidders() {
+	if testing.Short() {
+		s.T().Skip("skipping settlement of many bidders in short mode")
+	}
+
+	const numBids = 50_000
+	const maxSettlementBidders = 5_000
+
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxSettlementBidders = maxSettlementBidders
+	s.keeper.SetParams(s.ctx, params)
+
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("20_000_000denom1")
+	auction := s.createOpenBatchAuction(auctioneer, sellingCoin)
+
+	for i := 0; i < numBids; i++ {
+		price := parseDec("0.5").Add(sdk.NewDecWithPrec(int64(i%50), 2))
+		s.placeOpenBidBatchMany(auction.GetId(), s.addr(i+1), price, parseCoin("1_000denom1"))
+
+		// Place the bids over multiple blocks
+		if (i+1)%1_000 == 0 {
+			s.nextBlock(s.ctx.BlockTime())
+		}
+	}
+
+	// The match result is stored when the auction is closed, but nothing is paid out yet
+	res := s.nextBlock(auction.GetEndTimes()[0])
+	s.Require().Equal(1, countBlockEvents(res, types.EventTypeAuctionClosed))
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusSettling, a.GetStatus())
+
+	settlements := s.keeper.GetBidderSettlementsByAuctionId(s.ctx, auction.GetId())
+	s.Require().Len(settlements, numBids)
+
+	cursor, found := s.keeper.GetSettlementCursor(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Zero(cursor.SettledBidders)
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsZero())
+
+	resp, err := s.querier.AuctionSettlement(sdk.WrapSDKContext(s.ctx), &types.QueryAuctionSettlementRequest{AuctionId: auction.GetId()})
+	s.Require().NoError(err)
+	s.Require().True(resp.Settlement.TotalSoldAmount.IsPositive())
+	s.Require().Equal(&cursor, resp.Cursor)
+
+	_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Each block pays out the next bidders up to the max settlement bidders
+	blocks := 0
+	for ; a.GetStatus() == types.AuctionStatusSettling; blocks++ {
+		res = s.nextBlock(s.ctx.BlockTime().Add(5 * time.Second))
+
+		a, found = s.keeper.GetAuction(s.ctx, auction.GetId())
+		s.Require().True(found)
+
+		if a.GetStatus() == types.AuctionStatusSettling {
+			cursor, found = s.keeper.GetSettlementCursor(s.ctx, auction.GetId())
+			s.Require().True(found)
+			s.Require().EqualValues((blocks+1)*maxSettlementBidders, cursor.SettledBidders)
+			s.Require().Zero(countBlockEvents(res, types.EventTypeAuctionSettled))
+		}
+	}
+	s.Require().Equal(numBids/maxSettlementBidders, blocks)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Equal(1, countBlockEvents(res, types.EventTypeAuctionSettled))
+
+	_, found = s.keeper.GetSettlementCursor(s.ctx, auction.GetId())
+	s.Require().False(found)
+
+	// Every bidder is paid out exactly once by the stored match result
+	allocatedAmt, paidAmt := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, settlement := range settlements {
+		bidderAddr, err := sdk.AccAddressFromBech32(settlement.Bidder)
+		s.Require().NoError(err)
+		s.Require().Equal(settlement.AllocatedAmount, s.getBalance(bidderAddr, "denom1").Amount)
+		s.Require().Equal(settlement.RefundedAmount, s.getBalance(bidderAddr, "denom2").Amount)
+
+		allocatedAmt = allocatedAmt.Add(settlement.AllocatedAmount)
+		paidAmt = paidAmt.Add(settlement.PaidAmount)
+	}
+	s.Require().True(allocatedAmt.IsPositive())
+	s.Require().Equal(sellingCoin.Amount, allocatedAmt.Add(s.getBalance(auctioneer, "denom1").Amount))
+	s.Require().Equal(paidAmt, s.getBalance(auctioneer, "denom2").Amount)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, a.GetSellingReserveAddress()).IsZero())
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, a.GetPayingReserveAddress()).IsZero())
+
+	_, broken = keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestSettlement_WithinMaxSettlementBidders() {
+	auction := s.createOpenBatchAuction(s.addr(0), parseCoin("1_000_000denom1"))
+	s.placeOpenBidBatchMany(auction.GetId(), s.addr(1), parseDec("1"), parseCoin("600_000denom1"))
+	s.placeOpenBidBatchMany(auction.GetId(), s.addr(2), parseDec("0.9"), parseCoin("600_000denom1"))
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	// The auction is settled at once without the settlement cursor
+	a, found := s.keeper.GetAuction(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, a.GetStatus())
+	s.Require().Empty(s.keeper.GetSettlementCursors(s.ctx))
+	s.Require().Zero(s.countEvents(types.EventTypeAuctionSettled))
+	s.Require().Equal(parseCoin("600_000denom1"), s.getBalance(s.addr(1), "denom1"))
+	s.Require().Equal(parseCoin("540_000denom2"), s.getBalance(s.addr(2), "denom2"))
+}
+
+func (s *KeeperTestSuite) TestSettlement_ForceRefund() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxSettlementBidders = 1
+	s.keeper.SetParams(s.ctx, params)
+
+	auction := s.createOpenBatchAuction(s.addr(0), parseCoin("1_000_000denom1"))
+	for i := 1; i <= 3; i++ {
+		s.placeOpenBidBatchMany(auction.GetId(), s.addr(i), parseDec("1"), parseCoin("300_000denom1"))
+	}
+
+	s.ctx = s.ctx.WithBlockTime(auction.GetEndTimes()[0])
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	a, found := s.keeper.GetAuction(s.ctx, auction.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusSettling, a.GetStatus())
+
+	// All the remaining bidders are settled before the auction is refunded
+	s.Require().NoError(s.keeper.RefundFailedAuction(s.ctx, a))
+
+	_, found = s.keeper.GetSettlementCursor(s.ctx, auction.GetId())
+	s.Require().False(found)
+	for i := 1; i <= 3; i++ {
+		s.Require().Equal(parseCoin("300_000denom1"), s.getBalance(s.addr(i), "denom1"))
+	}
+	s.Require().Equal(parseCoin("100_000denom1"), s.getBalance(s.addr(0), "denom1"))
+
+	_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
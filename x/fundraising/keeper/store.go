@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
@@ -46,10 +47,46 @@ func (k Keeper) GetAuction(ctx sdk.Context, id uint64) (auction types.AuctionI,
 }
 
 // SetAuction sets an auction with the given auction id.
+// It also keeps the status index and the start and end time queues of the auction up to date.
 func (k Keeper) SetAuction(ctx sdk.Context, auction types.AuctionI) {
+	if prevAuction, found := k.GetAuction(ctx, auction.GetId()); found {
+		k.deleteAuctionIndexes(ctx, prevAuction)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := types.MustMarshalAuction(k.cdc, auction)
 	store.Set(types.GetAuctionKey(auction.GetId()), bz)
+
+	k.setAuctionIndexes(ctx, auction)
+}
+
+// setAuctionIndexes sets the status index of the auction and
+// puts the auction in the start or end time queue depending on its status.
+func (k Keeper) setAuctionIndexes(ctx sdk.Context, auction types.AuctionI) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuctionStatusIndexKey(auction.GetStatus(), auction.GetId()), []byte{})
+
+	switch auction.GetStatus() {
+	case types.AuctionStatusStandBy:
+		store.Set(types.GetAuctionStartTimeQueueKey(auction.GetStartTime(), auction.GetId()), []byte{})
+	case types.AuctionStatusStarted:
+		endTimes := auction.GetEndTimes()
+		store.Set(types.GetAuctionEndTimeQueueKey(endTimes[len(endTimes)-1], auction.GetId()), []byte{})
+	}
+}
+
+// deleteAuctionIndexes deletes the status index and the time queue keys of the auction.
+func (k Keeper) deleteAuctionIndexes(ctx sdk.Context, auction types.AuctionI) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAuctionStatusIndexKey(auction.GetStatus(), auction.GetId()))
+
+	switch auction.GetStatus() {
+	case types.AuctionStatusStandBy:
+		store.Delete(types.GetAuctionStartTimeQueueKey(auction.GetStartTime(), auction.GetId()))
+	case types.AuctionStatusStarted:
+		endTimes := auction.GetEndTimes()
+		store.Delete(types.GetAuctionEndTimeQueueKey(endTimes[len(endTimes)-1], auction.GetId()))
+	}
 }
 
 // GetAuctions returns all auctions in the store.
@@ -77,6 +114,80 @@ func (k Keeper) IterateAuctions(ctx sdk.Context, cb func(auction types.AuctionI)
 	}
 }
 
+// GetAuctionsByStatus returns all auctions that have the given status.
+func (k Keeper) GetAuctionsByStatus(ctx sdk.Context, status types.AuctionStatus) (auctions []types.AuctionI) {
+	k.IterateAuctionsByStatus(ctx, status, func(auction types.AuctionI) (stop bool) {
+		auctions = append(auctions, auction)
+		return false
+	})
+	return auctions
+}
+
+// IterateAuctionsByStatus iterates over all the auctions that have the given status using the status index
+// and performs a callback function. Stops iteration when callback returns true.
+func (k Keeper) IterateAuctionsByStatus(ctx sdk.Context, status types.AuctionStatus, cb func(auction types.AuctionI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionStatusIndexPrefix(status))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		auction, found := k.GetAuction(ctx, types.ParseAuctionIdFromIndexKey(iterator.Key()))
+		if !found { // this should never happen
+			continue
+		}
+
+		if cb(auction) {
+			break
+		}
+	}
+}
+
+// GetAuctionsToStart returns the stand by auctions whose start time is equal or before the given time t.
+func (k Keeper) GetAuctionsToStart(ctx sdk.Context, t time.Time) []types.AuctionI {
+	return k.getAuctionsByTimeQueue(ctx, types.AuctionStartTimeQueueKeyPrefix, t)
+}
+
+// GetAuctionsToClose returns the started auctions whose last end time is equal or before the given time t.
+func (k Keeper) GetAuctionsToClose(ctx sdk.Context, t time.Time) []types.AuctionI {
+	return k.getAuctionsByTimeQueue(ctx, types.AuctionEndTimeQueueKeyPrefix, t)
+}
+
+// GetAuctionsToRelease returns the vesting auctions that have any vesting queue
+// whose release time is equal or before the given time t.
+func (k Keeper) GetAuctionsToRelease(ctx sdk.Context, t time.Time) []types.AuctionI {
+	return k.getAuctionsByTimeQueue(ctx, types.VestingQueueReleaseTimeIndexKeyPrefix, t)
+}
+
+// GetAuctionsToReleaseToBidders returns the auctions that have any bidder vesting queue
+// whose release time is equal or before the given time t.
+func (k Keeper) GetAuctionsToReleaseToBidders(ctx sdk.Context, t time.Time) []types.AuctionI {
+	return k.getAuctionsByTimeQueue(ctx, types.BidderVestingQueueReleaseTimeIndexKeyPrefix, t)
+}
+
+// getAuctionsByTimeQueue returns the auctions in the time queue with the given prefix
+// until the given time t. An auction that appears multiple times in the queue is returned once.
+func (k Keeper) getAuctionsByTimeQueue(ctx sdk.Context, prefix []byte, t time.Time) (auctions []types.AuctionI) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(prefix, types.GetTimeQueueEndKey(prefix, t))
+
+	defer iterator.Close()
+	seen := map[uint64]bool{}
+	for ; iterator.Valid(); iterator.Next() {
+		auctionId := types.ParseAuctionIdFromIndexKey(iterator.Key())
+		if seen[auctionId] {
+			continue
+		}
+		seen[auctionId] = true
+
+		auction, found := k.GetAuction(ctx, auctionId)
+		if !found { // this should never happen
+			continue
+		}
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
 // GetAllowedBidder returns an allowed bidder object for the given auction id and bidder address.
 func (k Keeper) GetAllowedBidder(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress) (allowedBidder types.AllowedBidder, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -96,6 +207,12 @@ func (k Keeper) SetAllowedBidder(ctx sdk.Context, auctionId uint64, allowedBidde
 	store.Set(types.GetAllowedBidderKey(auctionId, allowedBidder.GetBidder()), bz)
 }
 
+// DeleteAllowedBidder deletes the allowed bidder object for the auction.
+func (k Keeper) DeleteAllowedBidder(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAllowedBidderKey(auctionId, bidderAddr))
+}
+
 // GetAllowedBiddersByAuction returns allowed bidders list for the auction.
 func (k Keeper) GetAllowedBiddersByAuction(ctx sdk.Context, auctionId uint64) (allowedBidders []types.AllowedBidder) {
 	_ = k.IterateAllowedBiddersByAuction(ctx, auctionId, func(allowedBidder types.AllowedBidder) (stop bool, err error) {
@@ -147,6 +264,23 @@ func (k Keeper) SetBidId(ctx sdk.Context, auctionId uint64, bidId uint64) {
 	store.Set(types.GetLastBidIdKey(auctionId), bz)
 }
 
+// GetLastBidIdRecords returns the last bid ids of all auctions.
+func (k Keeper) GetLastBidIdRecords(ctx sdk.Context) []types.LastBidIdRecord {
+	records := []types.LastBidIdRecord{}
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LastBidIdKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &val)
+		records = append(records, types.LastBidIdRecord{
+			AuctionId: sdk.BigEndianToUint64(iter.Key()[1:]),
+			BidId:     val.GetValue(),
+		})
+	}
+	return records
+}
+
 // GetBid returns a bid for the given auction id and bid id.
 // A bidder can have as many bids as they want, so bid id is required to get the bid.
 func (k Keeper) GetBid(ctx sdk.Context, auctionId uint64, bidId uint64) (bid types.Bid, found bool) {
@@ -160,13 +294,237 @@ func (k Keeper) GetBid(ctx sdk.Context, auctionId uint64, bidId uint64) (bid typ
 }
 
 // SetBid sets a bid with the given arguments.
+// The price index and the price level of the batch auction bid and the bidder aggregate
+// are updated only when the price, the type or the coin of the bid is changed.
 func (k Keeper) SetBid(ctx sdk.Context, bid types.Bid) {
+	oldBid, found := k.GetBid(ctx, bid.AuctionId, bid.Id)
+	if !found || oldBid.Type != bid.Type || !oldBid.Price.Equal(bid.Price) ||
+		oldBid.Coin.Denom != bid.Coin.Denom || !oldBid.Coin.Amount.Equal(bid.Coin.Amount) {
+		if found {
+			k.deleteBidPriceIndex(ctx, oldBid)
+			k.removeBidFromBidderAggregate(ctx, oldBid)
+		}
+		k.setBidPriceIndex(ctx, bid)
+		k.addBidToBidderAggregate(ctx, bid)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&bid)
 	store.Set(types.GetBidKey(bid.AuctionId, bid.Id), bz)
 	store.Set(types.GetBidIndexKey(bid.GetBidder(), bid.AuctionId, bid.Id), []byte{})
 }
 
+// DeleteBid deletes the bid and its bidder index from the store.
+// The bid is removed from the price index, the price level and the bidder aggregate as well.
+func (k Keeper) DeleteBid(ctx sdk.Context, bid types.Bid) {
+	k.deleteBidPriceIndex(ctx, bid)
+	k.removeBidFromBidderAggregate(ctx, bid)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBidKey(bid.AuctionId, bid.Id))
+	store.Delete(types.GetBidIndexKey(bid.GetBidder(), bid.AuctionId, bid.Id))
+}
+
+// setBidPriceIndex sets the price index of the batch auction bid and adds the bid to its price level.
+func (k Keeper) setBidPriceIndex(ctx sdk.Context, bid types.Bid) {
+	if !bid.IsPriceIndexed() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBidPriceIndexKey(bid.AuctionId, bid.Price, bid.Id), []byte{})
+
+	level, found := k.GetPriceLevel(ctx, bid.AuctionId, bid.Price)
+	if !found {
+		level = types.NewPriceLevel(bid.AuctionId, bid.Price)
+	}
+	level.AddBid(bid)
+	k.SetPriceLevel(ctx, level)
+}
+
+// deleteBidPriceIndex deletes the price index of the batch auction bid and removes the bid from its price level.
+// The price level is deleted when there is no bid left at the price.
+func (k Keeper) deleteBidPriceIndex(ctx sdk.Context, bid types.Bid) {
+	if !bid.IsPriceIndexed() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBidPriceIndexKey(bid.AuctionId, bid.Price, bid.Id))
+
+	level, found := k.GetPriceLevel(ctx, bid.AuctionId, bid.Price)
+	if !found {
+		return
+	}
+	level.RemoveBid(bid)
+	if level.BidsCount == 0 {
+		k.DeletePriceLevel(ctx, level)
+		return
+	}
+	k.SetPriceLevel(ctx, level)
+}
+
+// addBidToBidderAggregate adds the bid to the running totals of the bidder for the auction.
+// The bid amounts are converted with the paying coin denom and rates of the auction,
+// so it panics if the auction doesn't exist.
+func (k Keeper) addBidToBidderAggregate(ctx sdk.Context, bid types.Bid) {
+	auction, found := k.GetAuction(ctx, bid.AuctionId)
+	if !found {
+		panic(fmt.Sprintf("auction %d is not found", bid.AuctionId))
+	}
+
+	aggregate, found := k.GetBidderAggregate(ctx, bid.AuctionId, bid.GetBidder())
+	if !found {
+		aggregate = types.NewBidderAggregate(bid.AuctionId, bid.GetBidder())
+	}
+	aggregate.AddBid(bid, auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+	k.SetBidderAggregate(ctx, aggregate)
+}
+
+// removeBidFromBidderAggregate removes the bid from the running totals of the bidder for the auction.
+// The bidder aggregate is deleted when there is no bid left.
+func (k Keeper) removeBidFromBidderAggregate(ctx sdk.Context, bid types.Bid) {
+	auction, found := k.GetAuction(ctx, bid.AuctionId)
+	if !found {
+		panic(fmt.Sprintf("auction %d is not found", bid.AuctionId))
+	}
+
+	aggregate, found := k.GetBidderAggregate(ctx, bid.AuctionId, bid.GetBidder())
+	if !found {
+		return
+	}
+	aggregate.RemoveBid(bid, auction.GetPayingCoinDenom(), auction.GetPayingCoinRates())
+	if aggregate.BidsCount == 0 {
+		k.DeleteBidderAggregate(ctx, aggregate)
+		return
+	}
+	k.SetBidderAggregate(ctx, aggregate)
+}
+
+// IterateBidsByPrice iterates through all bids of the batch auction in descending order of the bid price
+// and then in ascending order of the bid id, and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidsByPrice(ctx sdk.Context, auctionId uint64, cb func(bid types.Bid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBidPriceIndexByAuctionIdPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, bidId := types.ParseBidPriceIndexKey(iter.Key())
+		bid, _ := k.GetBid(ctx, auctionId, bidId)
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// GetPriceLevel returns the price level of the batch auction at the given price.
+func (k Keeper) GetPriceLevel(ctx sdk.Context, auctionId uint64, price sdk.Dec) (level types.PriceLevel, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceLevelKey(auctionId, price))
+	if bz == nil {
+		return level, false
+	}
+	k.cdc.MustUnmarshal(bz, &level)
+	return level, true
+}
+
+// SetPriceLevel sets the price level.
+func (k Keeper) SetPriceLevel(ctx sdk.Context, level types.PriceLevel) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&level)
+	store.Set(types.GetPriceLevelKey(level.AuctionId, level.Price), bz)
+}
+
+// DeletePriceLevel deletes the price level from the store.
+func (k Keeper) DeletePriceLevel(ctx sdk.Context, level types.PriceLevel) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceLevelKey(level.AuctionId, level.Price))
+}
+
+// GetPriceLevelsByAuctionId returns all price levels of the batch auction in descending order of the price.
+func (k Keeper) GetPriceLevelsByAuctionId(ctx sdk.Context, auctionId uint64) []types.PriceLevel {
+	levels := []types.PriceLevel{}
+	k.IteratePriceLevelsByAuctionId(ctx, auctionId, func(level types.PriceLevel) (stop bool) {
+		levels = append(levels, level)
+		return false
+	})
+	return levels
+}
+
+// GetBidderAggregate returns the running totals of the bids that the bidder placed for the auction.
+func (k Keeper) GetBidderAggregate(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress) (aggregate types.BidderAggregate, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBidderAggregateKey(auctionId, bidderAddr))
+	if bz == nil {
+		return aggregate, false
+	}
+	k.cdc.MustUnmarshal(bz, &aggregate)
+	return aggregate, true
+}
+
+// SetBidderAggregate sets the bidder aggregate.
+func (k Keeper) SetBidderAggregate(ctx sdk.Context, aggregate types.BidderAggregate) {
+	bidderAddr, err := sdk.AccAddressFromBech32(aggregate.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&aggregate)
+	store.Set(types.GetBidderAggregateKey(aggregate.AuctionId, bidderAddr), bz)
+}
+
+// DeleteBidderAggregate deletes the bidder aggregate from the store.
+func (k Keeper) DeleteBidderAggregate(ctx sdk.Context, aggregate types.BidderAggregate) {
+	bidderAddr, err := sdk.AccAddressFromBech32(aggregate.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBidderAggregateKey(aggregate.AuctionId, bidderAddr))
+}
+
+// GetBidderAggregatesByAuctionId returns all bidder aggregates associated with the auction id.
+func (k Keeper) GetBidderAggregatesByAuctionId(ctx sdk.Context, auctionId uint64) []types.BidderAggregate {
+	aggregates := []types.BidderAggregate{}
+	k.IterateBidderAggregatesByAuctionId(ctx, auctionId, func(aggregate types.BidderAggregate) (stop bool) {
+		aggregates = append(aggregates, aggregate)
+		return false
+	})
+	return aggregates
+}
+
+// IterateBidderAggregatesByAuctionId iterates through all bidder aggregates associated with the auction id
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidderAggregatesByAuctionId(ctx sdk.Context, auctionId uint64, cb func(aggregate types.BidderAggregate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBidderAggregatesByAuctionIdPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var aggregate types.BidderAggregate
+		k.cdc.MustUnmarshal(iter.Value(), &aggregate)
+		if cb(aggregate) {
+			break
+		}
+	}
+}
+
+// IteratePriceLevelsByAuctionId iterates through all price levels of the batch auction in descending order
+// of the price and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePriceLevelsByAuctionId(ctx sdk.Context, auctionId uint64, cb func(level types.PriceLevel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPriceLevelsByAuctionIdPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var level types.PriceLevel
+		k.cdc.MustUnmarshal(iter.Value(), &level)
+		if cb(level) {
+			break
+		}
+	}
+}
+
 // GetBids returns all bids registered in the store.
 func (k Keeper) GetBids(ctx sdk.Context) []types.Bid {
 	bids := []types.Bid{}
@@ -264,11 +622,86 @@ func (k Keeper) SetMatchedBidsLen(ctx sdk.Context, auctionId uint64, matchedLen
 	store.Set(types.GetLastMatchedBidsLenKey(auctionId), bz)
 }
 
+// GetBidCommitment returns the bid commitment for the given auction id and commitment id.
+func (k Keeper) GetBidCommitment(ctx sdk.Context, auctionId uint64, commitmentId uint64) (commitment types.BidCommitment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBidCommitmentKey(auctionId, commitmentId))
+	if bz == nil {
+		return commitment, false
+	}
+	k.cdc.MustUnmarshal(bz, &commitment)
+	return commitment, true
+}
+
+// SetBidCommitment sets the bid commitment.
+func (k Keeper) SetBidCommitment(ctx sdk.Context, commitment types.BidCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&commitment)
+	store.Set(types.GetBidCommitmentKey(commitment.AuctionId, commitment.Id), bz)
+}
+
+// DeleteBidCommitment deletes the bid commitment from the store.
+func (k Keeper) DeleteBidCommitment(ctx sdk.Context, commitment types.BidCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBidCommitmentKey(commitment.AuctionId, commitment.Id))
+}
+
+// GetBidCommitments returns all bid commitments registered in the store.
+func (k Keeper) GetBidCommitments(ctx sdk.Context) []types.BidCommitment {
+	commitments := []types.BidCommitment{}
+	k.IterateBidCommitments(ctx, func(commitment types.BidCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return commitments
+}
+
+// GetBidCommitmentsByAuctionId returns all bid commitments associated with the auction id.
+func (k Keeper) GetBidCommitmentsByAuctionId(ctx sdk.Context, auctionId uint64) []types.BidCommitment {
+	commitments := []types.BidCommitment{}
+	k.IterateBidCommitmentsByAuctionId(ctx, auctionId, func(commitment types.BidCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return commitments
+}
+
+// IterateBidCommitments iterates through all bid commitments and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidCommitments(ctx sdk.Context, cb func(commitment types.BidCommitment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BidCommitmentKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var commitment types.BidCommitment
+		k.cdc.MustUnmarshal(iter.Value(), &commitment)
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
+// IterateBidCommitmentsByAuctionId iterates through all bid commitments associated with the auction id
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidCommitmentsByAuctionId(ctx sdk.Context, auctionId uint64, cb func(commitment types.BidCommitment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBidCommitmentsByAuctionPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var commitment types.BidCommitment
+		k.cdc.MustUnmarshal(iter.Value(), &commitment)
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
 // GetVestingQueue returns a slice of vesting queues that the auction is complete and
 // waiting in a queue to release the vesting amount of coin at the respective release time.
-func (k Keeper) GetVestingQueue(ctx sdk.Context, auctionId uint64, releaseTime time.Time) types.VestingQueue {
+func (k Keeper) GetVestingQueue(ctx sdk.Context, auctionId uint64, releaseTime time.Time, denom string) types.VestingQueue {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetVestingQueueKey(auctionId, releaseTime))
+	bz := store.Get(types.GetVestingQueueKey(auctionId, releaseTime, denom))
 	if bz == nil {
 		return types.VestingQueue{}
 	}
@@ -280,10 +713,18 @@ func (k Keeper) GetVestingQueue(ctx sdk.Context, auctionId uint64, releaseTime t
 }
 
 // SetVestingQueue sets vesting queue into with the given release time and auction id.
+// The release time index is kept only while the vesting queue is not released.
 func (k Keeper) SetVestingQueue(ctx sdk.Context, queue types.VestingQueue) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&queue)
-	store.Set(types.GetVestingQueueKey(queue.AuctionId, queue.ReleaseTime), bz)
+	store.Set(types.GetVestingQueueKey(queue.AuctionId, queue.ReleaseTime, queue.PayingCoin.Denom), bz)
+
+	indexKey := types.GetVestingQueueReleaseTimeIndexKey(queue.ReleaseTime, queue.PayingCoin.Denom, queue.AuctionId)
+	if queue.Released {
+		store.Delete(indexKey)
+	} else {
+		store.Set(indexKey, []byte{})
+	}
 }
 
 // GetVestingQueues returns all vesting queues registered in the store.
@@ -336,3 +777,439 @@ func (k Keeper) IterateVestingQueuesByAuctionId(ctx sdk.Context, auctionId uint6
 		}
 	}
 }
+
+// GetBidderVestingQueue returns the bidder vesting queue for the given auction id, bidder and release time.
+func (k Keeper) GetBidderVestingQueue(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress, releaseTime time.Time) (queue types.BidderVestingQueue, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBidderVestingQueueKey(auctionId, bidderAddr, releaseTime))
+	if bz == nil {
+		return queue, false
+	}
+	k.cdc.MustUnmarshal(bz, &queue)
+	return queue, true
+}
+
+// SetBidderVestingQueue sets the bidder vesting queue with its indexes.
+// The release time index is kept only while the bidder vesting queue is not released.
+func (k Keeper) SetBidderVestingQueue(ctx sdk.Context, queue types.BidderVestingQueue) {
+	bidderAddr, err := sdk.AccAddressFromBech32(queue.Bidder)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&queue)
+	store.Set(types.GetBidderVestingQueueKey(queue.AuctionId, bidderAddr, queue.ReleaseTime), bz)
+	store.Set(types.GetBidderVestingQueueIndexKey(bidderAddr, queue.AuctionId, queue.ReleaseTime), []byte{})
+
+	indexKey := types.GetBidderVestingQueueReleaseTimeIndexKey(queue.ReleaseTime, bidderAddr, queue.AuctionId)
+	if queue.Released {
+		store.Delete(indexKey)
+	} else {
+		store.Set(indexKey, []byte{})
+	}
+}
+
+// GetBidderVestingQueues returns all bidder vesting queues registered in the store.
+func (k Keeper) GetBidderVestingQueues(ctx sdk.Context) []types.BidderVestingQueue {
+	queues := []types.BidderVestingQueue{}
+	k.IterateBidderVestingQueues(ctx, func(queue types.BidderVestingQueue) (stop bool) {
+		queues = append(queues, queue)
+		return false
+	})
+	return queues
+}
+
+// GetBidderVestingQueuesByAuctionId returns all bidder vesting queues associated with the auction id.
+func (k Keeper) GetBidderVestingQueuesByAuctionId(ctx sdk.Context, auctionId uint64) []types.BidderVestingQueue {
+	queues := []types.BidderVestingQueue{}
+	k.IterateBidderVestingQueuesByAuctionId(ctx, auctionId, func(queue types.BidderVestingQueue) (stop bool) {
+		queues = append(queues, queue)
+		return false
+	})
+	return queues
+}
+
+// IterateBidderVestingQueues iterates through all BidderVestingQueues and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidderVestingQueues(ctx sdk.Context, cb func(queue types.BidderVestingQueue) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BidderVestingQueueKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var queue types.BidderVestingQueue
+		k.cdc.MustUnmarshal(iter.Value(), &queue)
+		if cb(queue) {
+			break
+		}
+	}
+}
+
+// IterateBidderVestingQueuesByAuctionId iterates through all BidderVestingQueues associated with the auction id
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidderVestingQueuesByAuctionId(ctx sdk.Context, auctionId uint64, cb func(queue types.BidderVestingQueue) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBidderVestingQueueByAuctionIdPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var queue types.BidderVestingQueue
+		k.cdc.MustUnmarshal(iter.Value(), &queue)
+		if cb(queue) {
+			break
+		}
+	}
+}
+
+// setReleaseTimeIndexes sets the release time indexes of the vesting queues and
+// the bidder vesting queues of the auction that are not released yet.
+func (k Keeper) setReleaseTimeIndexes(ctx sdk.Context, auctionId uint64) {
+	for _, queue := range k.GetVestingQueuesByAuctionId(ctx, auctionId) {
+		k.SetVestingQueue(ctx, queue)
+	}
+	for _, queue := range k.GetBidderVestingQueuesByAuctionId(ctx, auctionId) {
+		k.SetBidderVestingQueue(ctx, queue)
+	}
+}
+
+// deleteReleaseTimeIndexes deletes the release time indexes of the vesting queues and
+// the bidder vesting queues of the auction, so that the auction is not read by the begin blocker.
+func (k Keeper) deleteReleaseTimeIndexes(ctx sdk.Context, auctionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, queue := range k.GetVestingQueuesByAuctionId(ctx, auctionId) {
+		store.Delete(types.GetVestingQueueReleaseTimeIndexKey(queue.ReleaseTime, queue.PayingCoin.Denom, queue.AuctionId))
+	}
+	for _, queue := range k.GetBidderVestingQueuesByAuctionId(ctx, auctionId) {
+		bidderAddr, err := sdk.AccAddressFromBech32(queue.Bidder)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.GetBidderVestingQueueReleaseTimeIndexKey(queue.ReleaseTime, bidderAddr, queue.AuctionId))
+	}
+}
+
+// GetLinearVesting returns the linear vesting of the auction.
+func (k Keeper) GetLinearVesting(ctx sdk.Context, auctionId uint64) (vesting types.LinearVesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLinearVestingKey(auctionId))
+	if bz == nil {
+		return vesting, false
+	}
+	k.cdc.MustUnmarshal(bz, &vesting)
+	return vesting, true
+}
+
+// SetLinearVesting sets the linear vesting of the auction.
+func (k Keeper) SetLinearVesting(ctx sdk.Context, vesting types.LinearVesting) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vesting)
+	store.Set(types.GetLinearVestingKey(vesting.AuctionId), bz)
+}
+
+// GetLinearVestings returns all linear vestings registered in the store.
+func (k Keeper) GetLinearVestings(ctx sdk.Context) []types.LinearVesting {
+	vestings := []types.LinearVesting{}
+	k.IterateLinearVestings(ctx, func(vesting types.LinearVesting) (stop bool) {
+		vestings = append(vestings, vesting)
+		return false
+	})
+	return vestings
+}
+
+// IterateLinearVestings iterates through all linear vestings and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLinearVestings(ctx sdk.Context, cb func(vesting types.LinearVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LinearVestingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vesting types.LinearVesting
+		k.cdc.MustUnmarshal(iter.Value(), &vesting)
+		if cb(vesting) {
+			break
+		}
+	}
+}
+
+// GetAllocationClaim returns the allocation claim of the bidder for the auction.
+func (k Keeper) GetAllocationClaim(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress) (claim types.AllocationClaim, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAllocationClaimKey(auctionId, bidderAddr))
+	if bz == nil {
+		return claim, false
+	}
+	k.cdc.MustUnmarshal(bz, &claim)
+	return claim, true
+}
+
+// SetAllocationClaim sets the allocation claim of the bidder for the auction.
+func (k Keeper) SetAllocationClaim(ctx sdk.Context, claim types.AllocationClaim) {
+	bidderAddr, err := sdk.AccAddressFromBech32(claim.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&claim)
+	store.Set(types.GetAllocationClaimKey(claim.AuctionId, bidderAddr), bz)
+}
+
+// GetAllocationClaims returns all allocation claims registered in the store.
+func (k Keeper) GetAllocationClaims(ctx sdk.Context) []types.AllocationClaim {
+	claims := []types.AllocationClaim{}
+	k.IterateAllocationClaims(ctx, func(claim types.AllocationClaim) (stop bool) {
+		claims = append(claims, claim)
+		return false
+	})
+	return claims
+}
+
+// GetAllocationClaimsByAuctionId returns all allocation claims associated with the auction id.
+func (k Keeper) GetAllocationClaimsByAuctionId(ctx sdk.Context, auctionId uint64) []types.AllocationClaim {
+	claims := []types.AllocationClaim{}
+	k.IterateAllocationClaimsByAuctionId(ctx, auctionId, func(claim types.AllocationClaim) (stop bool) {
+		claims = append(claims, claim)
+		return false
+	})
+	return claims
+}
+
+// IterateAllocationClaims iterates through all allocation claims and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAllocationClaims(ctx sdk.Context, cb func(claim types.AllocationClaim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AllocationClaimKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.AllocationClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// IterateAllocationClaimsByAuctionId iterates through all allocation claims associated with the auction id
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAllocationClaimsByAuctionId(ctx sdk.Context, auctionId uint64, cb func(claim types.AllocationClaim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAllocationClaimsByAuctionPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.AllocationClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// GetAuctionSettlement returns the settlement record of the auction.
+func (k Keeper) GetAuctionSettlement(ctx sdk.Context, auctionId uint64) (settlement types.AuctionSettlement, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAuctionSettlementKey(auctionId))
+	if bz == nil {
+		return settlement, false
+	}
+	k.cdc.MustUnmarshal(bz, &settlement)
+	return settlement, true
+}
+
+// SetAuctionSettlement sets the settlement record of the auction.
+func (k Keeper) SetAuctionSettlement(ctx sdk.Context, settlement types.AuctionSettlement) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&settlement)
+	store.Set(types.GetAuctionSettlementKey(settlement.AuctionId), bz)
+}
+
+// GetAuctionSettlements returns all auction settlements registered in the store.
+func (k Keeper) GetAuctionSettlements(ctx sdk.Context) []types.AuctionSettlement {
+	settlements := []types.AuctionSettlement{}
+	k.IterateAuctionSettlements(ctx, func(settlement types.AuctionSettlement) (stop bool) {
+		settlements = append(settlements, settlement)
+		return false
+	})
+	return settlements
+}
+
+// IterateAuctionSettlements iterates through all auction settlements and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAuctionSettlements(ctx sdk.Context, cb func(settlement types.AuctionSettlement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AuctionSettlementKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var settlement types.AuctionSettlement
+		k.cdc.MustUnmarshal(iter.Value(), &settlement)
+		if cb(settlement) {
+			break
+		}
+	}
+}
+
+// GetBidderSettlement returns the settlement record of the bidder for the auction.
+func (k Keeper) GetBidderSettlement(ctx sdk.Context, auctionId uint64, bidderAddr sdk.AccAddress) (settlement types.BidderSettlement, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBidderSettlementKey(auctionId, bidderAddr))
+	if bz == nil {
+		return settlement, false
+	}
+	k.cdc.MustUnmarshal(bz, &settlement)
+	return settlement, true
+}
+
+// SetBidderSettlement sets the settlement record of the bidder for the auction.
+func (k Keeper) SetBidderSettlement(ctx sdk.Context, settlement types.BidderSettlement) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&settlement)
+	store.Set(types.GetBidderSettlementKey(settlement.AuctionId, settlement.GetBidder()), bz)
+}
+
+// GetBidderSettlements returns all bidder settlements registered in the store.
+func (k Keeper) GetBidderSettlements(ctx sdk.Context) []types.BidderSettlement {
+	settlements := []types.BidderSettlement{}
+	k.IterateBidderSettlements(ctx, func(settlement types.BidderSettlement) (stop bool) {
+		settlements = append(settlements, settlement)
+		return false
+	})
+	return settlements
+}
+
+// GetBidderSettlementsByAuctionId returns all bidder settlements associated with the auction id that are registered in the store.
+func (k Keeper) GetBidderSettlementsByAuctionId(ctx sdk.Context, auctionId uint64) []types.BidderSettlement {
+	settlements := []types.BidderSettlement{}
+	k.IterateBidderSettlementsByAuctionId(ctx, auctionId, func(settlement types.BidderSettlement) (stop bool) {
+		settlements = append(settlements, settlement)
+		return false
+	})
+	return settlements
+}
+
+// IterateBidderSettlements iterates through all bidder settlements and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidderSettlements(ctx sdk.Context, cb func(settlement types.BidderSettlement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BidderSettlementKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var settlement types.BidderSettlement
+		k.cdc.MustUnmarshal(iter.Value(), &settlement)
+		if cb(settlement) {
+			break
+		}
+	}
+}
+
+// IterateBidderSettlementsByAuctionId iterates through all bidder settlements associated with the auction id
+// and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateBidderSettlementsByAuctionId(ctx sdk.Context, auctionId uint64, cb func(settlement types.BidderSettlement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBidderSettlementsByAuctionPrefix(auctionId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var settlement types.BidderSettlement
+		k.cdc.MustUnmarshal(iter.Value(), &settlement)
+		if cb(settlement) {
+			break
+		}
+	}
+}
+
+// GetSettlementCursor returns the settlement cursor of the settling auction.
+func (k Keeper) GetSettlementCursor(ctx sdk.Context, auctionId uint64) (cursor types.SettlementCursor, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSettlementCursorKey(auctionId))
+	if bz == nil {
+		return cursor, false
+	}
+	k.cdc.MustUnmarshal(bz, &cursor)
+	return cursor, true
+}
+
+// SetSettlementCursor sets the settlement cursor of the settling auction.
+func (k Keeper) SetSettlementCursor(ctx sdk.Context, cursor types.SettlementCursor) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&cursor)
+	store.Set(types.GetSettlementCursorKey(cursor.AuctionId), bz)
+}
+
+// DeleteSettlementCursor deletes the settlement cursor of the auction.
+func (k Keeper) DeleteSettlementCursor(ctx sdk.Context, auctionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSettlementCursorKey(auctionId))
+}
+
+// GetSettlementCursors returns all settlement cursors registered in the store.
+func (k Keeper) GetSettlementCursors(ctx sdk.Context) []types.SettlementCursor {
+	cursors := []types.SettlementCursor{}
+	k.IterateSettlementCursors(ctx, func(cursor types.SettlementCursor) (stop bool) {
+		cursors = append(cursors, cursor)
+		return false
+	})
+	return cursors
+}
+
+// IterateSettlementCursors iterates through all settlement cursors and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateSettlementCursors(ctx sdk.Context, cb func(cursor types.SettlementCursor) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SettlementCursorKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var cursor types.SettlementCursor
+		k.cdc.MustUnmarshal(iter.Value(), &cursor)
+		if cb(cursor) {
+			break
+		}
+	}
+}
+
+// GetAuctionFailure returns the failure record of the auction.
+func (k Keeper) GetAuctionFailure(ctx sdk.Context, auctionId uint64) (failure types.AuctionFailure, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAuctionFailureKey(auctionId))
+	if bz == nil {
+		return failure, false
+	}
+	k.cdc.MustUnmarshal(bz, &failure)
+	return failure, true
+}
+
+// SetAuctionFailure sets the failure record of the auction.
+func (k Keeper) SetAuctionFailure(ctx sdk.Context, failure types.AuctionFailure) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&failure)
+	store.Set(types.GetAuctionFailureKey(failure.AuctionId), bz)
+}
+
+// DeleteAuctionFailure deletes the failure record of the auction.
+func (k Keeper) DeleteAuctionFailure(ctx sdk.Context, auctionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAuctionFailureKey(auctionId))
+}
+
+// GetAuctionFailures returns all auction failures registered in the store.
+func (k Keeper) GetAuctionFailures(ctx sdk.Context) []types.AuctionFailure {
+	failures := []types.AuctionFailure{}
+	k.IterateAuctionFailures(ctx, func(failure types.AuctionFailure) (stop bool) {
+		failures = append(failures, failure)
+		return false
+	})
+	return failures
+}
+
+// IterateAuctionFailures iterates through all auction failures and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAuctionFailures(ctx sdk.Context, cb func(failure types.AuctionFailure) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AuctionFailureKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var failure types.AuctionFailure
+		k.cdc.MustUnmarshal(iter.Value(), &failure)
+		if cb(failure) {
+			break
+		}
+	}
+}
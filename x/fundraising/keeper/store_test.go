@@ -167,7 +167,7 @@ func (s *KeeperTestSuite) TestVestingQueue() {
 	)
 	s.keeper.SetVestingQueue(s.ctx, vestingQueue)
 
-	vq := s.keeper.GetVestingQueue(s.ctx, 1, vestingQueue.ReleaseTime)
+	vq := s.keeper.GetVestingQueue(s.ctx, 1, vestingQueue.ReleaseTime, vestingQueue.PayingCoin.Denom)
 	s.Require().EqualValues(vestingQueue, vq)
 }
 
@@ -238,3 +238,349 @@ func (s *KeeperTestSuite) TestVestingQueueIterator() {
 	}
 	s.Require().Equal(reserveCoin, totalPayingCoin)
 }
+
+func (s *KeeperTestSuite) TestAuctionIndexes() {
+	standByAuction := s.createFixedPriceAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, 1),
+		s.ctx.BlockTime().AddDate(0, 0, 2),
+		true,
+	)
+	startedAuction := s.createBatchAuction(
+		s.addr(1),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom3"),
+		"denom4",
+		[]types.VestingSchedule{},
+		1,
+		parseDec("0.2"),
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		s.ctx.BlockTime().AddDate(0, 0, 1),
+		true,
+	)
+
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusStandBy), 1)
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusStarted), 1)
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusFinished), 0)
+
+	s.Require().Len(s.keeper.GetAuctionsToStart(s.ctx, s.ctx.BlockTime()), 0)
+	s.Require().Len(s.keeper.GetAuctionsToStart(s.ctx, standByAuction.StartTime), 1)
+	s.Require().Len(s.keeper.GetAuctionsToClose(s.ctx, s.ctx.BlockTime()), 0)
+	s.Require().Len(s.keeper.GetAuctionsToClose(s.ctx, startedAuction.EndTimes[0]), 1)
+
+	// Extending a round moves the auction to the new end time in the queue
+	s.Require().NoError(s.keeper.ExtendRound(s.ctx, startedAuction))
+	s.Require().Len(s.keeper.GetAuctionsToClose(s.ctx, startedAuction.EndTimes[0]), 0)
+	s.Require().Len(s.keeper.GetAuctionsToClose(s.ctx, startedAuction.EndTimes[1]), 1)
+
+	// Changing the status removes the auction from the previous index and queue
+	_ = standByAuction.SetStatus(types.AuctionStatusCancelled)
+	s.keeper.SetAuction(s.ctx, standByAuction)
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusStandBy), 0)
+	s.Require().Len(s.keeper.GetAuctionsByStatus(s.ctx, types.AuctionStatusCancelled), 1)
+	s.Require().Len(s.keeper.GetAuctionsToStart(s.ctx, standByAuction.StartTime), 0)
+
+	// Only the vesting queues that are not released yet are in the release time index
+	releaseTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	s.keeper.SetVestingQueue(s.ctx, types.VestingQueue{
+		AuctionId:   startedAuction.Id,
+		Auctioneer:  startedAuction.Auctioneer,
+		PayingCoin:  parseCoin("100_000_000denom4"),
+		ReleaseTime: releaseTime,
+		Released:    false,
+	})
+	s.Require().Len(s.keeper.GetAuctionsToRelease(s.ctx, s.ctx.BlockTime()), 0)
+	s.Require().Len(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime), 1)
+
+	// The auction stays in the index until the vesting queues of all paying coin denoms are released
+	s.keeper.SetVestingQueue(s.ctx, types.VestingQueue{
+		AuctionId:   startedAuction.Id,
+		Auctioneer:  startedAuction.Auctioneer,
+		PayingCoin:  parseCoin("50_000_000denom5"),
+		ReleaseTime: releaseTime,
+		Released:    false,
+	})
+	s.keeper.SetVestingQueue(s.ctx, types.VestingQueue{
+		AuctionId:   startedAuction.Id,
+		Auctioneer:  startedAuction.Auctioneer,
+		PayingCoin:  parseCoin("100_000_000denom4"),
+		ReleaseTime: releaseTime,
+		Released:    true,
+	})
+	s.Require().Len(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime), 1)
+
+	s.keeper.SetVestingQueue(s.ctx, types.VestingQueue{
+		AuctionId:   startedAuction.Id,
+		Auctioneer:  startedAuction.Auctioneer,
+		PayingCoin:  parseCoin("50_000_000denom5"),
+		ReleaseTime: releaseTime,
+		Released:    true,
+	})
+	s.Require().Len(s.keeper.GetAuctionsToRelease(s.ctx, releaseTime), 0)
+}
+
+func (s *KeeperTestSuite) TestBidPriceIndex() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	bid1 := s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	bid2 := s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	bid3 := s.placeBidBatchWorth(auction.Id, s.addr(3), parseDec("0.8"), parseCoin("50_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+
+	bidIds := func() (ids []uint64) {
+		s.keeper.IterateBidsByPrice(s.ctx, auction.Id, func(bid types.Bid) (stop bool) {
+			ids = append(ids, bid.Id)
+			return false
+		})
+		return
+	}
+	s.Require().Equal([]uint64{bid3.Id, bid1.Id, bid2.Id}, bidIds())
+
+	levels := s.keeper.GetPriceLevelsByAuctionId(s.ctx, auction.Id)
+	s.Require().Len(levels, 2)
+	s.Require().Equal(parseDec("0.8"), levels[0].Price)
+	s.Require().True(levels[0].ManyAmount.IsZero())
+	s.Require().Equal(parseCoins("50_000_000denom2"), levels[0].WorthCoins)
+	s.Require().Equal(uint64(1), levels[0].BidsCount)
+	s.Require().Equal(parseDec("0.5"), levels[1].Price)
+	s.Require().Equal(sdk.NewInt(200_000_000), levels[1].ManyAmount)
+	s.Require().Equal(parseCoins("100_000_000denom2"), levels[1].WorthCoins)
+	s.Require().Equal(uint64(2), levels[1].BidsCount)
+
+	// Modifying the bid moves it to the new price level
+	s.fundAddr(s.addr(1), parseCoins("20_000_000denom2"))
+	err := s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     bid1.Id,
+		Price:     parseDec("0.8"),
+		Coin:      parseCoin("120_000_000denom2"),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{bid1.Id, bid3.Id, bid2.Id}, bidIds())
+
+	level, found := s.keeper.GetPriceLevel(s.ctx, auction.Id, parseDec("0.8"))
+	s.Require().True(found)
+	s.Require().Equal(parseCoins("170_000_000denom2"), level.WorthCoins)
+	s.Require().Equal(uint64(2), level.BidsCount)
+	level, found = s.keeper.GetPriceLevel(s.ctx, auction.Id, parseDec("0.5"))
+	s.Require().True(found)
+	s.Require().True(level.WorthCoins.IsZero())
+	s.Require().Equal(uint64(1), level.BidsCount)
+
+	// Matching the bid doesn't change the price level
+	bid2.SetMatched(true)
+	s.keeper.SetBid(s.ctx, bid2)
+	level, found = s.keeper.GetPriceLevel(s.ctx, auction.Id, parseDec("0.5"))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(200_000_000), level.ManyAmount)
+	s.Require().Equal(uint64(1), level.BidsCount)
+
+	// The price level is deleted along with the last bid at the price
+	s.keeper.DeleteBid(s.ctx, bid2)
+	_, found = s.keeper.GetPriceLevel(s.ctx, auction.Id, parseDec("0.5"))
+	s.Require().False(found)
+	s.Require().Equal([]uint64{bid1.Id, bid3.Id}, bidIds())
+	s.Require().Len(s.keeper.GetPriceLevelsByAuctionId(s.ctx, auction.Id), 1)
+}
+
+func (s *KeeperTestSuite) TestBidderAggregate() {
+	auction := s.createBatchAuction(
+		s.addr(0),
+		parseDec("1"),
+		parseDec("0.1"),
+		parseCoin("1_000_000_000denom1"),
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		true,
+	)
+
+	_, found := s.keeper.GetBidderAggregate(s.ctx, auction.Id, s.addr(1))
+	s.Require().False(found)
+
+	bid1 := s.placeBidBatchWorth(auction.Id, s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	bid2 := s.placeBidBatchMany(auction.Id, s.addr(1), parseDec("0.4"), parseCoin("200_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	s.placeBidBatchMany(auction.Id, s.addr(2), parseDec("0.5"), parseCoin("50_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+
+	aggregate, found := s.keeper.GetBidderAggregate(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(s.addr(1).String(), aggregate.Bidder)
+	s.Require().Equal(sdk.NewInt(180_000_000), aggregate.ReservedAmount)
+	s.Require().Equal(parseCoins("180_000_000denom2"), aggregate.ReservedCoins)
+	s.Require().Equal(sdk.NewInt(400_000_000), aggregate.SellingAmount)
+	s.Require().Equal(uint64(2), aggregate.BidsCount)
+	s.Require().Len(s.keeper.GetBidderAggregatesByAuctionId(s.ctx, auction.Id), 2)
+
+	// Modifying the bid replaces its amounts
+	s.fundAddr(s.addr(1), parseCoins("20_000_000denom2"))
+	err := s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: auction.Id,
+		Bidder:    s.addr(1).String(),
+		BidId:     bid1.Id,
+		Price:     parseDec("0.6"),
+		Coin:      parseCoin("120_000_000denom2"),
+	})
+	s.Require().NoError(err)
+
+	aggregate, found = s.keeper.GetBidderAggregate(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(200_000_000), aggregate.ReservedAmount)
+	s.Require().Equal(parseCoins("200_000_000denom2"), aggregate.ReservedCoins)
+	s.Require().Equal(sdk.NewInt(400_000_000), aggregate.SellingAmount)
+	s.Require().Equal(uint64(2), aggregate.BidsCount)
+
+	// Matching the bid doesn't change the aggregate
+	bid2.SetMatched(true)
+	s.keeper.SetBid(s.ctx, bid2)
+	aggregate, found = s.keeper.GetBidderAggregate(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(uint64(2), aggregate.BidsCount)
+
+	// The aggregate is deleted along with the last bid of the bidder
+	s.keeper.DeleteBid(s.ctx, bid2)
+	aggregate, found = s.keeper.GetBidderAggregate(s.ctx, auction.Id, s.addr(1))
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(120_000_000), aggregate.ReservedAmount)
+	s.Require().Equal(parseCoins("120_000_000denom2"), aggregate.ReservedCoins)
+	s.Require().Equal(sdk.NewInt(200_000_000), aggregate.SellingAmount)
+	s.Require().Equal(uint64(1), aggregate.BidsCount)
+
+	bid1, found = s.keeper.GetBid(s.ctx, auction.Id, bid1.Id)
+	s.Require().True(found)
+	s.keeper.DeleteBid(s.ctx, bid1)
+	_, found = s.keeper.GetBidderAggregate(s.ctx, auction.Id, s.addr(1))
+	s.Require().False(found)
+	s.Require().Len(s.keeper.GetBidderAggregatesByAuctionId(s.ctx, auction.Id), 1)
+
+	// The bid of an auction that doesn't exist can't be aggregated
+	s.Require().PanicsWithValue("auction 10 is not found", func() {
+		s.keeper.SetBid(s.ctx, types.NewBid(10, s.addr(1), 1, types.BidTypeBatchMany, parseDec("0.5"), parseCoin("1_000_000denom1"), false))
+	})
+}
+
+func (s *KeeperTestSuite) TestBidderAggregate_SumOfBids() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	auction, err := s.keeper.CreateBatchAuction(s.ctx, types.NewMsgCreateBatchAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		parseDec("0.1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		0,
+		sdk.ZeroDec(),
+		time.Now().AddDate(0, 0, -1),
+		time.Now().AddDate(0, 0, -1).AddDate(0, 2, 0),
+		false,
+		false,
+		sdk.ZeroInt(),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", parseDec("0.5"))),
+		sdk.ZeroInt(),
+		nil,
+		nil,
+		false,
+		nil,
+		types.PartialFillModeNil,
+		types.PricingRuleUniform,
+	))
+	s.Require().NoError(err)
+
+	// requireAggregateEqualsBids checks that the aggregate of the bidder equals the sum over the bids of the bidder
+	requireAggregateEqualsBids := func(bidder sdk.AccAddress) {
+		reservedAmt, sellingAmt := sdk.ZeroInt(), sdk.ZeroInt()
+		reservedCoins := sdk.Coins{}
+		bidsCount := uint64(0)
+		for _, b := range s.keeper.GetBidsByAuctionId(s.ctx, auction.GetId()) {
+			if b.Bidder != bidder.String() {
+				continue
+			}
+			reservedAmt = reservedAmt.Add(b.ConvertToPayingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates()))
+			reservedCoins = reservedCoins.Add(b.ConvertToPayingCoin(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates()))
+			sellingAmt = sellingAmt.Add(b.ConvertToSellingAmount(auction.GetPayingCoinDenom(), auction.GetPayingCoinRates()))
+			bidsCount++
+		}
+
+		aggregate, found := s.keeper.GetBidderAggregate(s.ctx, auction.GetId(), bidder)
+		if bidsCount == 0 {
+			s.Require().False(found)
+			return
+		}
+		s.Require().True(found)
+		s.Require().Equal(reservedAmt, aggregate.ReservedAmount)
+		s.Require().Equal(reservedCoins, aggregate.ReservedCoins)
+		s.Require().Equal(sellingAmt, aggregate.SellingAmount)
+		s.Require().Equal(bidsCount, aggregate.BidsCount)
+	}
+
+	// Place bids in both the paying coin denom and the denom of the paying coin rate
+	bid1 := s.placeBidBatchWorth(auction.GetId(), s.addr(1), parseDec("0.5"), parseCoin("100_000_000denom2"), sdk.NewInt(1_000_000_000), true)
+	bid2 := s.placeBidBatchWorth(auction.GetId(), s.addr(1), parseDec("0.3"), parseCoin("90_000_000denom3"), sdk.ZeroInt(), true)
+	s.placeBidBatchMany(auction.GetId(), s.addr(1), parseDec("0.4"), parseCoin("100_000_000denom1"), sdk.ZeroInt(), true)
+	s.placeBidBatchMany(auction.GetId(), s.addr(2), parseDec("0.5"), parseCoin("50_000_000denom1"), sdk.NewInt(1_000_000_000), true)
+	requireAggregateEqualsBids(s.addr(1))
+	requireAggregateEqualsBids(s.addr(2))
+
+	// Modify the bids
+	s.fundAddr(s.addr(1), parseCoins("20_000_000denom2,30_000_000denom3"))
+	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidId:     bid1.Id,
+		Price:     parseDec("0.6"),
+		Coin:      parseCoin("120_000_000denom2"),
+	})
+	s.Require().NoError(err)
+	err = s.keeper.ModifyBid(s.ctx, &types.MsgModifyBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidId:     bid2.Id,
+		Price:     parseDec("0.35"),
+		Coin:      parseCoin("120_000_000denom3"),
+	})
+	s.Require().NoError(err)
+	requireAggregateEqualsBids(s.addr(1))
+	requireAggregateEqualsBids(s.addr(2))
+
+	// Cancel the bids
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidId:     bid2.Id,
+	})
+	s.Require().NoError(err)
+	requireAggregateEqualsBids(s.addr(1))
+
+	err = s.keeper.CancelBid(s.ctx, &types.MsgCancelBid{
+		AuctionId: auction.GetId(),
+		Bidder:    s.addr(1).String(),
+		BidId:     bid1.Id,
+	})
+	s.Require().NoError(err)
+	requireAggregateEqualsBids(s.addr(1))
+	requireAggregateEqualsBids(s.addr(2))
+}
@@ -1,24 +1,44 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/tendermint/fundraising/x/fundraising/types"
 )
 
-// ApplyVestingSchedules stores vesting queues based on the vesting schedules of the auction and
-// sets status to vesting.
+// ApplyVestingSchedules stores vesting queues for each paying coin denom based on the vesting schedules
+// of the auction and sets status to vesting. If the auction has the linear vesting schedule, it stores
+// the linear vesting instead, so that the auctioneer claims the vested paying coin with MsgClaimVested.
 func (k Keeper) ApplyVestingSchedules(ctx sdk.Context, auction types.AuctionI) error {
 	payingReserveAddr := auction.GetPayingReserveAddress()
 	vestingReserveAddr := auction.GetVestingReserveAddress()
-	payingCoinDenom := auction.GetPayingCoinDenom()
 	spendableCoins := k.bankKeeper.SpendableCoins(ctx, payingReserveAddr)
-	reserveCoin := sdk.NewCoin(payingCoinDenom, spendableCoins.AmountOf(payingCoinDenom))
+
+	reserveCoins := sdk.Coins{}
+	for _, denom := range auction.GetPayingCoinDenoms() {
+		reserveCoins = reserveCoins.Add(sdk.NewCoin(denom, spendableCoins.AmountOf(denom)))
+	}
 
 	vsLen := len(auction.GetVestingSchedules())
-	if vsLen == 0 {
+	if auction.GetLinearVestingSchedule() != nil {
+		// Move reserve coins from the paying reserve to the vesting reserve account
+		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, vestingReserveAddr, reserveCoins); err != nil {
+			return err
+		}
+
+		k.SetLinearVesting(ctx, types.NewLinearVesting(auction.GetId(), auction.GetAuctioneer(), reserveCoins, sdk.Coins{}))
+
+		_ = auction.SetStatus(types.AuctionStatusVesting)
+		k.SetAuction(ctx, auction)
+
+	} else if vsLen == 0 {
 		// Send reserve coins to the auctioneer from the paying reserve account
-		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, auction.GetAuctioneer(), sdk.NewCoins(reserveCoin)); err != nil {
+		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, auction.GetAuctioneer(), reserveCoins); err != nil {
 			return err
 		}
 
@@ -27,28 +47,39 @@ func (k Keeper) ApplyVestingSchedules(ctx sdk.Context, auction types.AuctionI) e
 
 	} else {
 		// Move reserve coins from the paying reserve to the vesting reserve account
-		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, vestingReserveAddr, sdk.NewCoins(reserveCoin)); err != nil {
+		if err := k.bankKeeper.SendCoins(ctx, payingReserveAddr, vestingReserveAddr, reserveCoins); err != nil {
 			return err
 		}
 
-		remaining := reserveCoin
-		for i, schedule := range auction.GetVestingSchedules() {
-			payingAmt := sdk.NewDecFromInt(reserveCoin.Amount).MulTruncate(schedule.Weight).TruncateInt()
+		// Each paying coin denom has its own vesting queues
+		for _, denom := range auction.GetPayingCoinDenoms() {
+			reserveCoin := sdk.NewCoin(denom, reserveCoins.AmountOf(denom))
 
-			// All the remaining paying coin goes to the last vesting queue
-			if i == vsLen-1 {
-				payingAmt = remaining.Amount
+			// Keep the vesting queues of the paying coin denom even if nothing is reserved,
+			// so that the auction always has the vesting queues for every release time
+			if reserveCoin.IsZero() && denom != auction.GetPayingCoinDenom() {
+				continue
 			}
 
-			k.SetVestingQueue(ctx, types.VestingQueue{
-				AuctionId:   auction.GetId(),
-				Auctioneer:  auction.GetAuctioneer().String(),
-				PayingCoin:  sdk.NewCoin(payingCoinDenom, payingAmt),
-				ReleaseTime: schedule.ReleaseTime,
-				Released:    false,
-			})
+			remaining := reserveCoin
+			for i, schedule := range auction.GetVestingSchedules() {
+				payingAmt := sdk.NewDecFromInt(reserveCoin.Amount).MulTruncate(schedule.Weight).TruncateInt()
+
+				// All the remaining paying coin goes to the last vesting queue
+				if i == vsLen-1 {
+					payingAmt = remaining.Amount
+				}
+
+				k.SetVestingQueue(ctx, types.VestingQueue{
+					AuctionId:   auction.GetId(),
+					Auctioneer:  auction.GetAuctioneer().String(),
+					PayingCoin:  sdk.NewCoin(denom, payingAmt),
+					ReleaseTime: schedule.ReleaseTime,
+					Released:    false,
+				})
 
-			remaining = remaining.SubAmount(payingAmt)
+				remaining = remaining.SubAmount(payingAmt)
+			}
 		}
 
 		_ = auction.SetStatus(types.AuctionStatusVesting)
@@ -57,3 +88,155 @@ func (k Keeper) ApplyVestingSchedules(ctx sdk.Context, auction types.AuctionI) e
 
 	return nil
 }
+
+// ClaimVested sends the paying coin vested so far by the linear vesting schedule of the auction to
+// the auctioneer. The auction is finished when the end time of the schedule has passed and
+// all the paying coin is claimed.
+func (k Keeper) ClaimVested(ctx sdk.Context, msg *types.MsgClaimVested) error {
+	auction, found := k.GetAuction(ctx, msg.AuctionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction %d not found", msg.AuctionId)
+	}
+
+	if auction.GetAuctioneer().String() != msg.Auctioneer {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the auctioneer can claim the vested paying coin")
+	}
+
+	schedule := auction.GetLinearVestingSchedule()
+	if schedule == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction %d has no linear vesting schedule", msg.AuctionId)
+	}
+
+	if auction.GetStatus() != types.AuctionStatusVesting {
+		return sdkerrors.Wrap(types.ErrInvalidAuctionStatus, "only the vesting auction can be claimed")
+	}
+
+	vesting, found := k.GetLinearVesting(ctx, auction.GetId())
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "linear vesting of auction %d not found", msg.AuctionId)
+	}
+
+	vestingEnded := !ctx.BlockTime().Before(schedule.EndTime)
+
+	claimableCoins := vesting.ClaimableCoins(*schedule, ctx.BlockTime())
+	if claimableCoins.IsZero() && !vestingEnded {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no vested paying coin to claim")
+	}
+
+	if !claimableCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetVestingReserveAddress(), auction.GetAuctioneer(), claimableCoins); err != nil {
+			return sdkerrors.Wrap(err, "failed to claim vested paying coin")
+		}
+	}
+
+	vesting.ClaimedCoins = vesting.ClaimedCoins.Add(claimableCoins...)
+	k.SetLinearVesting(ctx, vesting)
+
+	// Update status when all the amounts are claimed
+	if vestingEnded {
+		_ = auction.SetStatus(types.AuctionStatusFinished)
+		k.SetAuction(ctx, auction)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVested,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctioneerAddress, msg.Auctioneer),
+			sdk.NewAttribute(types.AttributeKeyReleaseCoin, claimableCoins.String()),
+		),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventVestedClaimed{
+		AuctionId:    auction.GetId(),
+		Auctioneer:   msg.Auctioneer,
+		ClaimedCoins: claimableCoins,
+	})
+}
+
+// ApplyBidderVestingSchedules stores the vesting queues of the bidder for the allocated coins based on
+// the bidder vesting schedules of the auction. The allocated coins must be sent to the bidder vesting reserve account.
+func (k Keeper) ApplyBidderVestingSchedules(ctx sdk.Context, auction types.AuctionI, bidderAddr sdk.AccAddress, allocateCoins sdk.Coins) {
+	schedules := auction.GetBidderVestingSchedules()
+
+	remaining := allocateCoins
+	for i, schedule := range schedules {
+		releaseCoins := sdk.Coins{}
+		for _, coin := range allocateCoins {
+			releaseAmt := sdk.NewDecFromInt(coin.Amount).MulTruncate(schedule.Weight).TruncateInt()
+			releaseCoins = releaseCoins.Add(sdk.NewCoin(coin.Denom, releaseAmt))
+		}
+
+		// All the remaining coins go to the last vesting queue
+		if i == len(schedules)-1 {
+			releaseCoins = remaining
+		}
+
+		if releaseCoins.IsZero() {
+			continue
+		}
+
+		k.SetBidderVestingQueue(ctx, types.NewBidderVestingQueue(auction.GetId(), bidderAddr, releaseCoins, schedule.ReleaseTime, false))
+
+		remaining = remaining.Sub(releaseCoins...)
+	}
+}
+
+// ReleaseBidderVestingCoins releases the vested selling coin to the bidders from the bidder vesting reserve account.
+func (k Keeper) ReleaseBidderVestingCoins(ctx sdk.Context, auction types.AuctionI) error {
+	return k.releaseBidderVestingQueues(ctx, auction, false)
+}
+
+// releaseBidderVestingQueues releases the bidder vesting queues of the auction that are ready to release.
+// If force is true, all the bidder vesting queues that are not released are released regardless of the release time.
+func (k Keeper) releaseBidderVestingQueues(ctx sdk.Context, auction types.AuctionI, force bool) error {
+	bidderVestingReserveAddr := auction.GetBidderVestingReserveAddress()
+
+	inputs := []banktypes.Input{}
+	outputs := []banktypes.Output{}
+	events := sdk.Events{}
+	typedEvents := []proto.Message{}
+
+	for _, queue := range k.GetBidderVestingQueuesByAuctionId(ctx, auction.GetId()) {
+		if queue.Released || (!force && !queue.ShouldRelease(ctx.BlockTime())) {
+			continue
+		}
+
+		bidderAddr, err := sdk.AccAddressFromBech32(queue.Bidder)
+		if err != nil {
+			return err
+		}
+
+		inputs = append(inputs, banktypes.NewInput(bidderVestingReserveAddr, queue.ReleaseCoins))
+		outputs = append(outputs, banktypes.NewOutput(bidderAddr, queue.ReleaseCoins))
+
+		queue.SetReleased(true)
+		k.SetBidderVestingQueue(ctx, queue)
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeReleaseBidderVesting,
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyBidderAddress, queue.Bidder),
+			sdk.NewAttribute(types.AttributeKeyReleaseCoin, queue.ReleaseCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseTime, queue.ReleaseTime.String()),
+		))
+		typedEvents = append(typedEvents, &types.EventBidderVestingReleased{
+			AuctionId:    auction.GetId(),
+			Bidder:       queue.Bidder,
+			ReleaseCoins: queue.ReleaseCoins,
+			ReleaseTime:  queue.ReleaseTime,
+		})
+	}
+
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	// Send all at once
+	if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return sdkerrors.Wrap(err, "failed to release selling coin to the bidders")
+	}
+
+	ctx.EventManager().EmitEvents(events)
+	return ctx.EventManager().EmitTypedEvents(typedEvents...)
+}
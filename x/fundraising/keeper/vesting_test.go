@@ -3,6 +3,11 @@ package keeper_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/fundraising/x/fundraising"
+	"github.com/tendermint/fundraising/x/fundraising/keeper"
 	"github.com/tendermint/fundraising/x/fundraising/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -92,3 +97,104 @@ func (s *KeeperTestSuite) TestApplyVestingSchedules_RemainingCoin() {
 	}
 	s.Require().True(vestingReserveCoin.IsZero())
 }
+
+func (s *KeeperTestSuite) TestClaimVested_LinearVestingSchedule() {
+	auctioneer := s.addr(0)
+	sellingCoin := parseCoin("1_000_000_000denom1")
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	schedule := &types.LinearVestingSchedule{
+		StartTime: endTime,
+		EndTime:   endTime.Add(100 * time.Hour),
+		CliffTime: endTime.Add(20 * time.Hour),
+	}
+
+	params := s.keeper.GetParams(s.ctx)
+	s.fundAddr(auctioneer, params.AuctionCreationFee.Add(sellingCoin))
+
+	a, err := s.keeper.CreateFixedPriceAuction(s.ctx, types.NewMsgCreateFixedPriceAuction(
+		auctioneer.String(),
+		parseDec("1"),
+		sellingCoin,
+		"denom2",
+		[]types.VestingSchedule{},
+		s.ctx.BlockTime().AddDate(0, 0, -1),
+		endTime,
+		false,
+		true,
+		sellingCoin.Amount,
+		nil,
+		nil,
+		sdk.ZeroInt(),
+		false,
+		nil,
+		schedule,
+		false,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(schedule, a.GetLinearVestingSchedule())
+
+	s.placeBidFixedPrice(a.GetId(), s.addr(1), parseDec("1"), parseCoin("500_000_000denom2"), true)
+
+	// The raised paying coin is moved to the vesting reserve account without any vesting queue
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	fundraising.BeginBlocker(s.ctx, s.keeper)
+
+	auction, found := s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusVesting, auction.GetStatus())
+	s.Require().Empty(s.keeper.GetVestingQueuesByAuctionId(s.ctx, a.GetId()))
+	s.Require().Equal(parseCoin("500_000_000denom2"), s.getBalance(auction.GetVestingReserveAddress(), "denom2"))
+
+	vesting, found := s.keeper.GetLinearVesting(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(parseCoins("500_000_000denom2"), vesting.TotalCoins)
+
+	// Only the auctioneer can claim
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(s.addr(1).String(), a.GetId()))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Nothing is vested before the cliff time
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(10 * time.Hour))
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(auctioneer.String(), a.GetId()))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// The vested portion is claimable after the cliff time
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(30 * time.Hour))
+	resp, err := s.querier.Vestings(sdk.WrapSDKContext(s.ctx), &types.QueryVestingsRequest{AuctionId: a.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoins("150_000_000denom2"), resp.ClaimableCoins)
+
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(auctioneer.String(), a.GetId()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("150_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+
+	_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	// Claiming again at the same block time has nothing to claim
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(auctioneer.String(), a.GetId()))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(80 * time.Hour))
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(auctioneer.String(), a.GetId()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("400_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+
+	// The rest is claimed after the end time and the auction is finished
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(200 * time.Hour))
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(auctioneer.String(), a.GetId()))
+	s.Require().NoError(err)
+	s.Require().Equal(parseCoin("500_000_000denom2"), s.getBalance(auctioneer, "denom2"))
+	s.Require().True(s.getBalance(auction.GetVestingReserveAddress(), "denom2").IsZero())
+
+	auction, found = s.keeper.GetAuction(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.GetStatus())
+
+	vesting, found = s.keeper.GetLinearVesting(s.ctx, a.GetId())
+	s.Require().True(found)
+	s.Require().Equal(vesting.TotalCoins, vesting.ClaimedCoins)
+
+	_, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimVested(auctioneer.String(), a.GetId()))
+	s.Require().ErrorIs(err, types.ErrInvalidAuctionStatus)
+}
@@ -44,8 +44,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	genState := types.GenesisState{
 		Params: types.Params{
-			AuctionCreationFee: auctionCreationFee,
-			ExtendedPeriod:     extendedPeriod,
+			AuctionCreationFee:    auctionCreationFee,
+			ExtendedPeriod:        extendedPeriod,
+			BidCancellationCutoff: types.DefaultBidCancellationCutoff,
+			MaxSettlementBidders:  types.DefaultMaxSettlementBidders,
 		},
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genState)
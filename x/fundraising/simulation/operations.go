@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	"github.com/tendermint/fundraising/cmd"
 
@@ -22,6 +23,7 @@ import (
 const (
 	OpWeightMsgCreateFixedPriceAuction = "op_weight_msg_create_fixed_price_auction"
 	OpWeightMsgCreateBatchAuction      = "op_weight_msg_create_batch_auction"
+	OpWeightMsgCreateDutchAuction      = "op_weight_msg_create_dutch_auction"
 	OpWeightMsgCancelAuction           = "op_weight_msg_cancel_auction"
 	OpWeightMsgPlaceBid                = "op_weight_msg_place_bid"
 )
@@ -59,6 +61,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgCreateDutchAuction int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDutchAuction, &weightMsgCreateDutchAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDutchAuction = appparams.DefaultWeightMsgCreateDutchAuction
+		},
+	)
+
 	var weightMsgCancelAuction int
 	appParams.GetOrGenerate(cdc, OpWeightMsgCancelAuction, &weightMsgCancelAuction, nil,
 		func(_ *rand.Rand) {
@@ -82,6 +91,10 @@ func WeightedOperations(
 			weightMsgCreateBatchAuction,
 			SimulateMsgCreateBatchAuction(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateDutchAuction,
+			SimulateMsgCreateDutchAuction(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgCancelAuction,
 			SimulateMsgCancelAuction(ak, bk, k),
@@ -136,6 +149,16 @@ func SimulateMsgCreateFixedPriceAuction(ak types.AccountKeeper, bk types.BankKee
 			vestingSchedules,
 			startTime,
 			endTime,
+			false,
+			false,
+			sdk.ZeroInt(),
+			nil,
+			nil,
+			sdk.ZeroInt(),
+			false,
+			nil,
+			nil,
+			false,
 		)
 
 		txCtx := simulation.OperationInput{
@@ -184,6 +207,10 @@ func SimulateMsgCreateBatchAuction(ak types.AccountKeeper, bk types.BankKeeper,
 		extendedRoundRate := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 3)), 1) // 0.1 ~ 0.3
 		startTime := ctx.BlockTime().AddDate(0, 0, simtypes.RandIntBetween(r, 0, 2))
 		endTime := startTime.AddDate(0, simtypes.RandIntBetween(r, 1, 12), 0)
+		pricingRule := types.PricingRuleUniform
+		if r.Intn(2) == 0 {
+			pricingRule = types.PricingRulePayAsBid
+		}
 
 		if _, err := fundBalances(ctx, r, bk, auctioneer, testCoinDenoms); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateBatchAuction, "failed to fund auctioneer"), nil, err
@@ -206,6 +233,94 @@ func SimulateMsgCreateBatchAuction(ak types.AccountKeeper, bk types.BankKeeper,
 			extendedRoundRate,
 			startTime,
 			endTime,
+			false,
+			false,
+			sdk.ZeroInt(),
+			nil,
+			sdk.ZeroInt(),
+			nil,
+			nil,
+			false,
+			nil,
+			types.PartialFillModeNil,
+			pricingRule,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           cmd.MakeEncodingConfig(simapp.ModuleBasics).TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgCreateDutchAuction generates a MsgCreateDutchAuction with random values
+// nolint: interfacer
+func SimulateMsgCreateDutchAuction(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		params := k.GetParams(ctx)
+		_, hasNeg := spendable.SafeSub(params.AuctionCreationFee...)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDutchAuction, "insufficient balance for auction creation fee"), nil, nil
+		}
+
+		auctioneer := account.GetAddress()
+		startPrice := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 5, 10)), 1) // 0.5 ~ 1.0
+		floorPrice := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 5)), 1)  // 0.1 ~ 0.5
+		priceDecayStep := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 5)), 2)
+		priceDecayPeriod := time.Duration(simtypes.RandIntBetween(r, 1, 24)) * time.Hour
+		sellingCoin := sdk.NewInt64Coin(testCoinDenoms[r.Intn(len(testCoinDenoms))], int64(simtypes.RandIntBetween(r, 10000000000, 1000000000000)))
+		payingCoinDenom := sdk.DefaultBondDenom
+		vestingSchedules := []types.VestingSchedule{}
+		startTime := ctx.BlockTime().AddDate(0, 0, simtypes.RandIntBetween(r, 0, 2))
+		endTime := startTime.AddDate(0, simtypes.RandIntBetween(r, 1, 12), 0)
+
+		if _, err := fundBalances(ctx, r, bk, auctioneer, testCoinDenoms); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDutchAuction, "failed to fund auctioneer"), nil, err
+		}
+
+		// Call spendable coins here again to get the funded balances
+		_, hasNeg = bk.SpendableCoins(ctx, account.GetAddress()).SafeSub(sdk.NewCoins(sellingCoin)...)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDutchAuction, "insufficient balance to reserve selling coin"), nil, nil
+		}
+
+		msg := types.NewMsgCreateDutchAuction(
+			auctioneer.String(),
+			startPrice,
+			floorPrice,
+			priceDecayStep,
+			priceDecayPeriod,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			startTime,
+			endTime,
+			false,
+			false,
+			sdk.ZeroInt(),
+			nil,
+			nil,
+			nil,
+			false,
 		)
 
 		txCtx := simulation.OperationInput{
@@ -338,10 +453,18 @@ func SimulateMsgPlaceBid(ak types.AccountKeeper, bk types.BankKeeper, k keeper.K
 				bid.Type = types.BidTypeBatchMany
 				bid.Coin = sdk.NewInt64Coin(sellingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000)))
 			}
+		case types.AuctionTypeDutch:
+			bid.Type = types.BidTypeDutch
+			bid.Price = auction.(*types.DutchAuction).CurrentPrice(ctx.BlockTime())
+			if r.Int()%2 == 0 {
+				bid.Coin = sdk.NewInt64Coin(payingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000)))
+			} else {
+				bid.Coin = sdk.NewInt64Coin(sellingCoinDenom, int64(simtypes.RandIntBetween(r, 100000, 1000000000)))
+			}
 		}
 
-		bidReserveAmt := bid.ConvertToPayingAmount(payingCoinDenom)
-		maxBidAmt := bid.ConvertToSellingAmount(payingCoinDenom)
+		bidReserveAmt := bid.ConvertToPayingAmount(payingCoinDenom, auction.GetPayingCoinRates())
+		maxBidAmt := bid.ConvertToSellingAmount(payingCoinDenom, auction.GetPayingCoinRates())
 
 		if !bk.SpendableCoins(ctx, bidder).AmountOf(payingCoinDenom).GT(bidReserveAmt) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceBid, "insufficient balance to place a bid"), nil, nil
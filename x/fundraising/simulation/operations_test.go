@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -41,6 +42,7 @@ func TestWeightedOperations(t *testing.T) {
 	}{
 		{params.DefaultWeightMsgCreateFixedPriceAuction, types.ModuleName, types.TypeMsgCreateFixedPriceAuction},
 		{params.DefaultWeightMsgCreateBatchAuction, types.ModuleName, types.TypeMsgCreateBatchAuction},
+		{params.DefaultWeightMsgCreateDutchAuction, types.ModuleName, types.TypeMsgCreateDutchAuction},
 		{params.DefaultWeightMsgCancelAuction, types.ModuleName, types.TypeMsgCancelAuction},
 		{params.DefaultWeightMsgPlaceBid, types.ModuleName, types.TypeMsgPlaceBid},
 	}
@@ -113,6 +115,34 @@ func TestSimulateCreateBatchAuction(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("0.1"), msg.ExtendedRoundRate)
 }
 
+func TestSimulateCreateDutchAuction(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	op := simulation.SimulateMsgCreateDutchAuction(app.AccountKeeper, app.BankKeeper, app.FundraisingKeeper)
+	opMsg, futureOps, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.True(t, opMsg.OK)
+	require.Len(t, futureOps, 0)
+
+	// The price decay period is amino JSON encoded in the sign bytes
+	var msg types.MsgCreateDutchAuction
+	legacy.Cdc.MustUnmarshalJSON(opMsg.Msg, &msg)
+
+	require.Equal(t, types.TypeMsgCreateDutchAuction, msg.Type())
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Auctioneer)
+	require.Equal(t, sdk.DefaultBondDenom, msg.PayingCoinDenom)
+	require.True(t, msg.FloorPrice.LTE(msg.StartPrice))
+}
+
 func TestSimulateCancelAuction(t *testing.T) {
 	app, ctx := createTestApp(false)
 
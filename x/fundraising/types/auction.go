@@ -18,6 +18,9 @@ const (
 	VestingReserveAddressPrefix string = "VestingReserveAddress"
 	ModuleAddressNameSplitter   string = "|"
 
+	BidderVestingReserveAddressPrefix string = "BidderVestingReserveAddress"
+	ClaimReserveAddressPrefix         string = "ClaimReserveAddress"
+
 	// ReserveAddressType is an address type of reserve for selling, paying, and vesting.
 	// The module uses the address type of 32 bytes length, but it can be changed depending on Cosmos SDK's direction.
 	ReserveAddressType = AddressType32Bytes
@@ -26,6 +29,7 @@ const (
 var (
 	_ AuctionI = (*FixedPriceAuction)(nil)
 	_ AuctionI = (*BatchAuction)(nil)
+	_ AuctionI = (*DutchAuction)(nil)
 )
 
 // NewBaseAuction creates a new BaseAuction object
@@ -37,21 +41,25 @@ func NewBaseAuction(
 	startPrice sdk.Dec, sellingCoin sdk.Coin, payingCoinDenom string,
 	vestingPoolAddr string, vestingSchedules []VestingSchedule,
 	startTime time.Time, endTimes []time.Time, status AuctionStatus,
+	auctioneerManagedAllowlist bool, openBidding bool, defaultMaxBidAmount sdk.Int,
 ) *BaseAuction {
 	return &BaseAuction{
-		Id:                    id,
-		Type:                  typ,
-		Auctioneer:            auctioneerAddr,
-		SellingReserveAddress: sellingPoolAddr,
-		PayingReserveAddress:  payingPoolAddr,
-		StartPrice:            startPrice,
-		SellingCoin:           sellingCoin,
-		PayingCoinDenom:       payingCoinDenom,
-		VestingReserveAddress: vestingPoolAddr,
-		VestingSchedules:      vestingSchedules,
-		StartTime:             startTime,
-		EndTimes:              endTimes,
-		Status:                status,
+		Id:                         id,
+		Type:                       typ,
+		Auctioneer:                 auctioneerAddr,
+		SellingReserveAddress:      sellingPoolAddr,
+		PayingReserveAddress:       payingPoolAddr,
+		StartPrice:                 startPrice,
+		SellingCoin:                sellingCoin,
+		PayingCoinDenom:            payingCoinDenom,
+		VestingReserveAddress:      vestingPoolAddr,
+		VestingSchedules:           vestingSchedules,
+		StartTime:                  startTime,
+		EndTimes:                   endTimes,
+		Status:                     status,
+		AuctioneerManagedAllowlist: auctioneerManagedAllowlist,
+		OpenBidding:                openBidding,
+		DefaultMaxBidAmount:        defaultMaxBidAmount,
 	}
 }
 
@@ -130,6 +138,24 @@ func (ba *BaseAuction) SetSellingCoin(coin sdk.Coin) error {
 	return nil
 }
 
+func (ba BaseAuction) GetSellingBasket() sdk.Coins {
+	return ba.SellingBasket
+}
+
+func (ba *BaseAuction) SetSellingBasket(coins sdk.Coins) error {
+	ba.SellingBasket = coins
+	return nil
+}
+
+func (ba BaseAuction) GetPayingCoinRates() sdk.DecCoins {
+	return ba.PayingCoinRates
+}
+
+func (ba *BaseAuction) SetPayingCoinRates(rates sdk.DecCoins) error {
+	ba.PayingCoinRates = rates
+	return nil
+}
+
 func (ba BaseAuction) GetPayingCoinDenom() string {
 	return ba.PayingCoinDenom
 }
@@ -188,9 +214,90 @@ func (ba *BaseAuction) SetStatus(status AuctionStatus) error {
 	return nil
 }
 
+func (ba BaseAuction) GetAuctioneerManagedAllowlist() bool {
+	return ba.AuctioneerManagedAllowlist
+}
+
+func (ba *BaseAuction) SetAuctioneerManagedAllowlist(managed bool) error {
+	ba.AuctioneerManagedAllowlist = managed
+	return nil
+}
+
+func (ba BaseAuction) GetOpenBidding() bool {
+	return ba.OpenBidding
+}
+
+func (ba *BaseAuction) SetOpenBidding(open bool) error {
+	ba.OpenBidding = open
+	return nil
+}
+
+func (ba BaseAuction) GetDefaultMaxBidAmount() sdk.Int {
+	if ba.DefaultMaxBidAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return ba.DefaultMaxBidAmount
+}
+
+func (ba *BaseAuction) SetDefaultMaxBidAmount(amt sdk.Int) error {
+	ba.DefaultMaxBidAmount = amt
+	return nil
+}
+
+func (ba BaseAuction) GetMinRaiseAmount() sdk.Int {
+	if ba.MinRaiseAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return ba.MinRaiseAmount
+}
+
+func (ba *BaseAuction) SetMinRaiseAmount(amt sdk.Int) error {
+	ba.MinRaiseAmount = amt
+	return nil
+}
+
+func (ba BaseAuction) GetBidderVestingSchedules() []VestingSchedule {
+	return ba.BidderVestingSchedules
+}
+
+func (ba *BaseAuction) SetBidderVestingSchedules(schedules []VestingSchedule) error {
+	ba.BidderVestingSchedules = schedules
+	return nil
+}
+
+func (ba BaseAuction) GetLinearVestingSchedule() *LinearVestingSchedule {
+	return ba.LinearVestingSchedule
+}
+
+func (ba *BaseAuction) SetLinearVestingSchedule(schedule *LinearVestingSchedule) error {
+	ba.LinearVestingSchedule = schedule
+	return nil
+}
+
+func (ba BaseAuction) GetClaimMode() bool {
+	return ba.ClaimMode
+}
+
+func (ba *BaseAuction) SetClaimMode(claimMode bool) error {
+	ba.ClaimMode = claimMode
+	return nil
+}
+
+// GetClaimReserveAddress returns the reserve address that keeps the allocated selling coin and
+// the refunded paying coin of the auction in claim mode until the bidders claim them.
+func (ba BaseAuction) GetClaimReserveAddress() sdk.AccAddress {
+	return ClaimReserveAddress(ba.Id)
+}
+
+// GetBidderVestingReserveAddress returns the reserve address that locks the selling coin allocated to the bidders.
+// It is derived from the auction id, so that it doesn't need to be stored in the auction.
+func (ba BaseAuction) GetBidderVestingReserveAddress() sdk.AccAddress {
+	return BidderVestingReserveAddress(ba.Id)
+}
+
 // Validate checks for errors on the Auction fields
 func (ba BaseAuction) Validate() error {
-	if ba.Type != AuctionTypeFixedPrice && ba.Type != AuctionTypeBatch {
+	if ba.Type != AuctionTypeFixedPrice && ba.Type != AuctionTypeBatch && ba.Type != AuctionTypeDutch {
 		return sdkerrors.Wrapf(ErrInvalidAuctionType, "unknown plan type: %s", ba.Type)
 	}
 	if _, err := sdk.AccAddressFromBech32(ba.Auctioneer); err != nil {
@@ -220,6 +327,168 @@ func (ba BaseAuction) Validate() error {
 	if err := ValidateVestingSchedules(ba.VestingSchedules, ba.EndTimes[len(ba.EndTimes)-1]); err != nil {
 		return err
 	}
+	if err := ValidateVestingSchedules(ba.BidderVestingSchedules, ba.EndTimes[len(ba.EndTimes)-1]); err != nil {
+		return sdkerrors.Wrap(err, "invalid bidder vesting schedules")
+	}
+	if err := ValidateLinearVestingSchedule(ba.LinearVestingSchedule, ba.VestingSchedules, ba.EndTimes[len(ba.EndTimes)-1]); err != nil {
+		return err
+	}
+	if err := ValidateOpenBidding(ba.OpenBidding, ba.GetDefaultMaxBidAmount(), ba.SellingCoin); err != nil {
+		return err
+	}
+	if !ba.SellingBasket.Empty() && ba.Type != AuctionTypeFixedPrice {
+		return sdkerrors.Wrapf(ErrInvalidAuctionType, "selling basket is only supported for the fixed price auction")
+	}
+	if err := ValidateSellingBasket(ba.SellingBasket, ba.SellingCoin, ba.PayingCoinDenom); err != nil {
+		return err
+	}
+	if err := ValidatePayingCoinRates(ba.PayingCoinRates, ba.SellingCoin, ba.PayingCoinDenom, ba.SellingBasket); err != nil {
+		return err
+	}
+	if err := ValidateMinRaiseAmount(ba.GetMinRaiseAmount()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateMinRaiseAmount validates the minimum raise amount of the auction.
+// It is optional, so it is valid when it is not set or zero.
+func ValidateMinRaiseAmount(minRaiseAmount sdk.Int) error {
+	if !minRaiseAmount.IsNil() && minRaiseAmount.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min raise amount must not be negative: %s", minRaiseAmount)
+	}
+	return nil
+}
+
+// IsSoftCapMet returns true if the raised amount is equal or greater than the minimum raise amount of the auction.
+func (ba BaseAuction) IsSoftCapMet(raisedAmt sdk.Int) bool {
+	return raisedAmt.GTE(ba.GetMinRaiseAmount())
+}
+
+// ValidatePayingCoinRates validates the additional paying coin denoms and their conversion rates to the paying coin denom.
+// The rates must be valid and positive, and must not contain the paying coin denom, the selling coin denom nor
+// any of the basket coin denoms.
+func ValidatePayingCoinRates(payingCoinRates sdk.DecCoins, sellingCoin sdk.Coin, payingCoinDenom string, sellingBasket sdk.Coins) error {
+	if payingCoinRates.Empty() {
+		return nil
+	}
+	if err := payingCoinRates.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid paying coin rates: %v", err)
+	}
+	if !payingCoinRates.AmountOf(payingCoinDenom).IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "paying coin rates must not contain the paying coin denom")
+	}
+	if !payingCoinRates.AmountOf(sellingCoin.Denom).IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "paying coin rates must not contain the selling coin denom")
+	}
+	for _, coin := range sellingBasket {
+		if !payingCoinRates.AmountOf(coin.Denom).IsZero() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "paying coin rates must not contain the selling basket denom %s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// IsPayingCoinDenom returns true if bidders can use the denom to bid for the auction,
+// which is either the paying coin denom or one of the denoms of the paying coin rates.
+func (ba BaseAuction) IsPayingCoinDenom(denom string) bool {
+	return denom == ba.PayingCoinDenom || ba.PayingCoinRates.AmountOf(denom).IsPositive()
+}
+
+// GetPaidCoins returns the coins out of the reserved paying coins that are worth the given paid amount
+// in the paying coin denom. The paying coin denom is taken first and then the other denoms in the order of
+// the paying coin rates. The amounts of the other denoms are truncated in favor of the bidder, so the paid coins
+// are never worth more than the paid amount.
+func (ba BaseAuction) GetPaidCoins(paidAmt sdk.Int, reservedCoins sdk.Coins) sdk.Coins {
+	amt := sdk.MinInt(paidAmt, reservedCoins.AmountOf(ba.PayingCoinDenom))
+	paidCoins := sdk.NewCoins(sdk.NewCoin(ba.PayingCoinDenom, amt))
+	remainingAmt := paidAmt.Sub(amt)
+
+	for _, rate := range ba.PayingCoinRates {
+		if !remainingAmt.IsPositive() {
+			break
+		}
+		amt := sdk.NewDecFromInt(remainingAmt).QuoTruncate(rate.Amount).TruncateInt() // RemainingAmount / Rate
+		amt = sdk.MinInt(amt, reservedCoins.AmountOf(rate.Denom))
+		paidCoins = paidCoins.Add(sdk.NewCoin(rate.Denom, amt))
+
+		worthAmt := sdk.NewDecFromInt(amt).MulTruncate(rate.Amount).TruncateInt()
+		remainingAmt = remainingAmt.Sub(sdk.MinInt(remainingAmt, worthAmt))
+	}
+	return paidCoins
+}
+
+// GetPayingCoinDenoms returns all the denoms that bidders can use to bid for the auction,
+// starting with the paying coin denom.
+func (ba BaseAuction) GetPayingCoinDenoms() []string {
+	denoms := []string{ba.PayingCoinDenom}
+	for _, rate := range ba.PayingCoinRates {
+		denoms = append(denoms, rate.Denom)
+	}
+	return denoms
+}
+
+// ValidateSellingBasket validates the basket coins that are sold together with the selling coin.
+// The basket coins must be valid and positive, and must not contain the selling coin denom nor the paying coin denom.
+func ValidateSellingBasket(sellingBasket sdk.Coins, sellingCoin sdk.Coin, payingCoinDenom string) error {
+	if sellingBasket.Empty() {
+		return nil
+	}
+	if err := sellingBasket.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid selling basket: %v", err)
+	}
+	if !sellingBasket.AmountOf(sellingCoin.Denom).IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "selling basket must not contain the selling coin denom")
+	}
+	if !sellingBasket.AmountOf(payingCoinDenom).IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "selling basket must not contain the paying coin denom")
+	}
+	return nil
+}
+
+// GetSellingCoins returns all the coins that are sold in the auction,
+// which are the selling coin and the basket coins.
+func (ba BaseAuction) GetSellingCoins() sdk.Coins {
+	return sdk.NewCoins(ba.SellingCoin).Add(ba.SellingBasket...)
+}
+
+// GetBasketCoins returns the basket coins that come with the given amount of the selling coin
+// in the fixed ratio of the basket coin amounts to the selling coin amount.
+// The amounts are truncated and the truncated remainder is returned to the auctioneer when the auction is closed.
+func (ba BaseAuction) GetBasketCoins(sellingAmt sdk.Int) sdk.Coins {
+	basketCoins := sdk.Coins{}
+	for _, coin := range ba.SellingBasket {
+		amt := coin.Amount.Mul(sellingAmt).Quo(ba.SellingCoin.Amount)
+		basketCoins = basketCoins.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+	return basketCoins
+}
+
+// ValidateOpenBidding validates the default maximum bid amount of the auction.
+// It must be positive and not greater than the selling amount for the open bidding auction.
+// Otherwise, it must not be set.
+func ValidateOpenBidding(openBidding bool, defaultMaxBidAmount sdk.Int, sellingCoin sdk.Coin) error {
+	if !openBidding {
+		if !defaultMaxBidAmount.IsNil() && !defaultMaxBidAmount.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidMaxBidAmount, "default maximum bid amount must not be set unless the auction is open")
+		}
+		return nil
+	}
+	if defaultMaxBidAmount.IsNil() || !defaultMaxBidAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidMaxBidAmount, "default maximum bid amount must be positive")
+	}
+	if defaultMaxBidAmount.GT(sellingCoin.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidMaxBidAmount, "default maximum bid amount %s must not be greater than the selling amount %s", defaultMaxBidAmount, sellingCoin.Amount)
+	}
+	return nil
+}
+
+// ValidateClaimMode validates that the claim mode is not used together with the bidder vesting schedules,
+// since the allocated selling coin goes to the bidder vesting queues instead of the allocation claims.
+func ValidateClaimMode(claimMode bool, bidderVestingSchedules []VestingSchedule) error {
+	if claimMode && len(bidderVestingSchedules) > 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingSchedules, "claim mode cannot be used with bidder vesting schedules")
+	}
 	return nil
 }
 
@@ -253,6 +522,36 @@ func NewBatchAuction(baseAuction *BaseAuction, minBidPrice sdk.Dec, matchedPrice
 	}
 }
 
+// NewDutchAuction returns a new dutch auction.
+func NewDutchAuction(
+	baseAuction *BaseAuction, floorPrice sdk.Dec, priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration, remainingSellingCoin sdk.Coin,
+) *DutchAuction {
+	return &DutchAuction{
+		BaseAuction:          baseAuction,
+		FloorPrice:           floorPrice,
+		PriceDecayStep:       priceDecayStep,
+		PriceDecayPeriod:     priceDecayPeriod,
+		RemainingSellingCoin: remainingSellingCoin,
+	}
+}
+
+// CurrentPrice returns the price of the dutch auction at the given time t.
+// The price decreases by PriceDecayStep for every PriceDecayPeriod that has passed
+// since the start time and it never goes below the floor price.
+func (da DutchAuction) CurrentPrice(t time.Time) sdk.Dec {
+	if !t.After(da.StartTime) || da.PriceDecayPeriod <= 0 {
+		return da.StartPrice
+	}
+
+	steps := int64(t.Sub(da.StartTime) / da.PriceDecayPeriod)
+	price := da.StartPrice.Sub(da.PriceDecayStep.MulInt64(steps))
+	if price.LT(da.FloorPrice) {
+		return da.FloorPrice
+	}
+	return price
+}
+
 // AuctionI is an interface that inherits the BaseAuction and exposes common functions
 // to get and set standard auction data.
 type AuctionI interface {
@@ -279,15 +578,39 @@ type AuctionI interface {
 	GetSellingCoin() sdk.Coin
 	SetSellingCoin(sdk.Coin) error
 
+	GetSellingBasket() sdk.Coins
+	SetSellingBasket(sdk.Coins) error
+
+	GetSellingCoins() sdk.Coins
+	GetBasketCoins(sellingAmt sdk.Int) sdk.Coins
+
 	GetPayingCoinDenom() string
 	SetPayingCoinDenom(string) error
 
+	GetPayingCoinRates() sdk.DecCoins
+	SetPayingCoinRates(sdk.DecCoins) error
+
+	IsPayingCoinDenom(denom string) bool
+	GetPayingCoinDenoms() []string
+	GetPaidCoins(paidAmt sdk.Int, reservedCoins sdk.Coins) sdk.Coins
+
 	GetVestingReserveAddress() sdk.AccAddress
 	SetVestingReserveAddress(sdk.AccAddress) error
 
 	GetVestingSchedules() []VestingSchedule
 	SetVestingSchedules([]VestingSchedule) error
 
+	GetBidderVestingSchedules() []VestingSchedule
+	SetBidderVestingSchedules([]VestingSchedule) error
+	GetBidderVestingReserveAddress() sdk.AccAddress
+
+	GetLinearVestingSchedule() *LinearVestingSchedule
+	SetLinearVestingSchedule(*LinearVestingSchedule) error
+
+	GetClaimMode() bool
+	SetClaimMode(bool) error
+	GetClaimReserveAddress() sdk.AccAddress
+
 	GetStartTime() time.Time
 	SetStartTime(time.Time) error
 
@@ -297,6 +620,20 @@ type AuctionI interface {
 	GetStatus() AuctionStatus
 	SetStatus(AuctionStatus) error
 
+	GetAuctioneerManagedAllowlist() bool
+	SetAuctioneerManagedAllowlist(bool) error
+
+	GetOpenBidding() bool
+	SetOpenBidding(bool) error
+
+	GetDefaultMaxBidAmount() sdk.Int
+	SetDefaultMaxBidAmount(sdk.Int) error
+
+	GetMinRaiseAmount() sdk.Int
+	SetMinRaiseAmount(sdk.Int) error
+
+	IsSoftCapMet(raisedAmt sdk.Int) bool
+
 	ShouldAuctionStarted(t time.Time) bool
 	ShouldAuctionClosed(t time.Time) bool
 
@@ -399,3 +736,13 @@ func PayingReserveAddress(auctionId uint64) sdk.AccAddress {
 func VestingReserveAddress(auctionId uint64) sdk.AccAddress {
 	return DeriveAddress(ReserveAddressType, ModuleName, VestingReserveAddressPrefix+ModuleAddressNameSplitter+fmt.Sprint(auctionId))
 }
+
+// BidderVestingReserveAddress returns the bidder vesting reserve address with the given auction id.
+func BidderVestingReserveAddress(auctionId uint64) sdk.AccAddress {
+	return DeriveAddress(ReserveAddressType, ModuleName, BidderVestingReserveAddressPrefix+ModuleAddressNameSplitter+fmt.Sprint(auctionId))
+}
+
+// ClaimReserveAddress returns the claim reserve address with the given auction id.
+func ClaimReserveAddress(auctionId uint64) sdk.AccAddress {
+	return DeriveAddress(ReserveAddressType, ModuleName, ClaimReserveAddressPrefix+ModuleAddressNameSplitter+fmt.Sprint(auctionId))
+}
@@ -32,6 +32,9 @@ func TestUnpackAuction(t *testing.T) {
 			types.MustParseRFC3339("2022-01-01T00:00:00Z"),
 			[]time.Time{types.MustParseRFC3339("2022-02-01T00:00:00Z")},
 			types.AuctionStatusStarted,
+			false,
+			false,
+			sdk.ZeroInt(),
 		),
 		sdk.NewInt64Coin("denom3", 1_000_000_000_000),
 	)
@@ -107,6 +110,9 @@ func TestUnpackAuctionJSON(t *testing.T) {
 			time.Now().AddDate(0, 0, -1),
 			[]time.Time{time.Now().AddDate(0, 1, -1)},
 			types.AuctionStatusStarted,
+			false,
+			false,
+			sdk.ZeroInt(),
 		),
 		sdk.NewInt64Coin("denom2", 1_000_000_000_000),
 	)
@@ -147,6 +153,9 @@ func TestUnpackAuctions(t *testing.T) {
 				time.Now().AddDate(0, 0, -1),
 				[]time.Time{time.Now().AddDate(0, 1, -1)},
 				types.AuctionStatusStarted,
+				false,
+				false,
+				sdk.ZeroInt(),
 			),
 			sdk.NewInt64Coin("denom2", 1_000_000_000_000),
 		),
@@ -165,6 +174,9 @@ func TestUnpackAuctions(t *testing.T) {
 				time.Now().AddDate(0, 0, -1),
 				[]time.Time{time.Now().AddDate(0, 1, -1)},
 				types.AuctionStatusStarted,
+				false,
+				false,
+				sdk.ZeroInt(),
 			),
 			sdk.MustNewDecFromStr("0.1"),
 			sdk.ZeroDec(),
@@ -231,6 +243,146 @@ func TestShouldAuctionStarted(t *testing.T) {
 	}
 }
 
+func TestDutchAuctionCurrentPrice(t *testing.T) {
+	auction := types.NewDutchAuction(
+		&types.BaseAuction{
+			Id:         1,
+			Type:       types.AuctionTypeDutch,
+			StartPrice: sdk.MustNewDecFromStr("2.0"),
+			StartTime:  types.MustParseRFC3339("2021-12-01T00:00:00Z"),
+			EndTimes:   []time.Time{types.MustParseRFC3339("2021-12-02T00:00:00Z")},
+		},
+		sdk.MustNewDecFromStr("1.2"),
+		sdk.MustNewDecFromStr("0.25"),
+		time.Hour,
+		sdk.NewInt64Coin("denom1", 1_000_000_000_000),
+	)
+
+	for _, tc := range []struct {
+		currentTime string
+		expected    string
+	}{
+		{"2021-11-30T00:00:00Z", "2.0"},
+		{"2021-12-01T00:00:00Z", "2.0"},
+		{"2021-12-01T00:59:59Z", "2.0"},
+		{"2021-12-01T01:00:00Z", "1.75"},
+		{"2021-12-01T02:30:00Z", "1.5"},
+		{"2021-12-01T03:00:00Z", "1.25"},
+		{"2021-12-01T04:00:00Z", "1.2"},
+		{"2021-12-01T23:00:00Z", "1.2"},
+	} {
+		require.Equal(t, sdk.MustNewDecFromStr(tc.expected), auction.CurrentPrice(types.MustParseRFC3339(tc.currentTime)), tc.currentTime)
+	}
+}
+
+func TestGetBasketCoins(t *testing.T) {
+	auction := types.BaseAuction{
+		Id:          1,
+		Type:        types.AuctionTypeFixedPrice,
+		SellingCoin: sdk.NewInt64Coin("denom1", 1_000_000),
+		SellingBasket: sdk.NewCoins(
+			sdk.NewInt64Coin("denom3", 1_000),
+			sdk.NewInt64Coin("denom4", 3_000_000),
+		),
+	}
+
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("denom1", 1_000_000),
+		sdk.NewInt64Coin("denom3", 1_000),
+		sdk.NewInt64Coin("denom4", 3_000_000),
+	), auction.GetSellingCoins())
+
+	for _, tc := range []struct {
+		sellingAmt int64
+		expected   sdk.Coins
+	}{
+		{1_000_000, sdk.NewCoins(sdk.NewInt64Coin("denom3", 1_000), sdk.NewInt64Coin("denom4", 3_000_000))},
+		{500_000, sdk.NewCoins(sdk.NewInt64Coin("denom3", 500), sdk.NewInt64Coin("denom4", 1_500_000))},
+		{1_999, sdk.NewCoins(sdk.NewInt64Coin("denom3", 1), sdk.NewInt64Coin("denom4", 5_997))},
+		{999, sdk.NewCoins(sdk.NewInt64Coin("denom4", 2_997))},
+		{0, sdk.Coins{}},
+	} {
+		require.True(t, tc.expected.IsEqual(auction.GetBasketCoins(sdk.NewInt(tc.sellingAmt))), tc.sellingAmt)
+	}
+}
+
+func TestGetPaidCoins(t *testing.T) {
+	auction := types.BaseAuction{
+		Id:              1,
+		Type:            types.AuctionTypeBatch,
+		SellingCoin:     sdk.NewInt64Coin("denom1", 1_000_000),
+		PayingCoinDenom: "denom2",
+		PayingCoinRates: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.5")),
+			sdk.NewDecCoinFromDec("denom4", sdk.MustNewDecFromStr("3")),
+		),
+	}
+
+	require.True(t, auction.IsPayingCoinDenom("denom2"))
+	require.True(t, auction.IsPayingCoinDenom("denom3"))
+	require.False(t, auction.IsPayingCoinDenom("denom1"))
+	require.Equal(t, []string{"denom2", "denom3", "denom4"}, auction.GetPayingCoinDenoms())
+
+	reservedCoins := sdk.NewCoins(
+		sdk.NewInt64Coin("denom2", 1_000),
+		sdk.NewInt64Coin("denom3", 1_000),
+		sdk.NewInt64Coin("denom4", 1_000),
+	)
+	for _, tc := range []struct {
+		paidAmt  int64
+		expected sdk.Coins
+	}{
+		{0, sdk.Coins{}},
+		{500, sdk.NewCoins(sdk.NewInt64Coin("denom2", 500))},
+		{1_000, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000))},
+		{1_001, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000), sdk.NewInt64Coin("denom3", 2))},
+		{1_500, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000), sdk.NewInt64Coin("denom3", 1_000))},
+		{1_501, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000), sdk.NewInt64Coin("denom3", 1_000))},
+		{1_503, sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000), sdk.NewInt64Coin("denom3", 1_000), sdk.NewInt64Coin("denom4", 1))},
+		{4_500, reservedCoins},
+	} {
+		require.True(t, tc.expected.IsEqual(auction.GetPaidCoins(sdk.NewInt(tc.paidAmt), reservedCoins)), tc.paidAmt)
+	}
+}
+
+func TestGetPaidCoins_NonIntegralRate(t *testing.T) {
+	auction := types.BaseAuction{
+		Id:              1,
+		Type:            types.AuctionTypeBatch,
+		SellingCoin:     sdk.NewInt64Coin("denom1", 1_000_000),
+		PayingCoinDenom: "denom2",
+		PayingCoinRates: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.3")),
+		),
+	}
+
+	reservedCoins := sdk.NewCoins(
+		sdk.NewInt64Coin("denom2", 100),
+		sdk.NewInt64Coin("denom3", 1_000),
+	)
+	for _, tc := range []struct {
+		paidAmt  int64
+		expected sdk.Coins
+	}{
+		{100, sdk.NewCoins(sdk.NewInt64Coin("denom2", 100))},
+		{101, sdk.NewCoins(sdk.NewInt64Coin("denom2", 100), sdk.NewInt64Coin("denom3", 3))},
+		{102, sdk.NewCoins(sdk.NewInt64Coin("denom2", 100), sdk.NewInt64Coin("denom3", 6))},
+		{103, sdk.NewCoins(sdk.NewInt64Coin("denom2", 100), sdk.NewInt64Coin("denom3", 10))},
+		{400, reservedCoins},
+	} {
+		paidCoins := auction.GetPaidCoins(sdk.NewInt(tc.paidAmt), reservedCoins)
+		require.True(t, tc.expected.IsEqual(paidCoins), tc.paidAmt)
+
+		// The paid coins are never worth more than the paid amount
+		worthAmt := sdk.NewDecFromInt(paidCoins.AmountOf("denom2")).
+			Add(sdk.NewDecFromInt(paidCoins.AmountOf("denom3")).Mul(sdk.MustNewDecFromStr("0.3")))
+		require.True(t, worthAmt.LTE(sdk.NewDec(tc.paidAmt)), tc.paidAmt)
+
+		refundCoins := reservedCoins.Sub(paidCoins...)
+		require.True(t, reservedCoins.IsEqual(paidCoins.Add(refundCoins...)), tc.paidAmt)
+	}
+}
+
 func TestShouldAuctionClosed(t *testing.T) {
 	auction := types.BaseAuction{
 		Id:                    1,
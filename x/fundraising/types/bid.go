@@ -29,20 +29,60 @@ func (b *Bid) SetMatched(status bool) {
 	b.IsMatched = status
 }
 
+// IsPriceIndexed returns true if the bid is kept in the price index and the price level,
+// which is the case for the bids of the batch auction.
+func (b Bid) IsPriceIndexed() bool {
+	return b.Type == BidTypeBatchWorth || b.Type == BidTypeBatchMany
+}
+
+// PayingCoinRate returns the conversion rate of the bid coin to the paying coin denom.
+// It returns false if the bid coin is not one of the paying coins of the auction,
+// which means the bid coin is the selling coin.
+func (b Bid) PayingCoinRate(payingCoinDenom string, payingCoinRates sdk.DecCoins) (rate sdk.Dec, ok bool) {
+	if b.Coin.Denom == payingCoinDenom {
+		return sdk.OneDec(), true
+	}
+	rate = payingCoinRates.AmountOf(b.Coin.Denom)
+	return rate, rate.IsPositive()
+}
+
 // ConvertToSellingAmount converts to selling amount depending on the bid coin denom.
+// The bid coin in one of the paying coin rates denoms is converted to the paying coin denom first.
 // Note that we take as little coins as possible to prevent from overflowing the remaining selling coin.
-func (b Bid) ConvertToSellingAmount(denom string) (amount sdk.Int) {
-	if b.Coin.Denom == denom {
-		return sdk.NewDecFromInt(b.Coin.Amount).QuoTruncate(b.Price).TruncateInt() // BidAmount / BidPrice
+func (b Bid) ConvertToSellingAmount(payingCoinDenom string, payingCoinRates sdk.DecCoins) (amount sdk.Int) {
+	if rate, ok := b.PayingCoinRate(payingCoinDenom, payingCoinRates); ok {
+		return sdk.NewDecFromInt(b.Coin.Amount).MulTruncate(rate).QuoTruncate(b.Price).TruncateInt() // BidAmount * Rate / BidPrice
 	}
 	return b.Coin.Amount
 }
 
-// ConvertToPayingAmount converts to paying amount depending on the bid coin denom.
+// ConvertToPayingAmount converts to paying amount in the paying coin denom depending on the bid coin denom.
+// The bid coin in one of the paying coin rates denoms is worth the amount truncated by its rate.
 // Note that we take as many coins as possible by ceiling numbers from bidder.
-func (b Bid) ConvertToPayingAmount(denom string) (amount sdk.Int) {
-	if b.Coin.Denom == denom {
-		return b.Coin.Amount
+func (b Bid) ConvertToPayingAmount(payingCoinDenom string, payingCoinRates sdk.DecCoins) (amount sdk.Int) {
+	if rate, ok := b.PayingCoinRate(payingCoinDenom, payingCoinRates); ok {
+		return sdk.NewDecFromInt(b.Coin.Amount).MulTruncate(rate).TruncateInt() // BidAmount * Rate
 	}
 	return sdk.NewDecFromInt(b.Coin.Amount).Mul(b.Price).Ceil().TruncateInt() // BidAmount * BidPrice
 }
+
+// ConvertToMatchAmount converts the batch auction bid to the selling amount that it can be matched at the fill price,
+// before the maximum bid amount of the bidder is applied.
+// The how-much-worth bid is converted to the paying coin denom first, and the fraction is truncated.
+func (b Bid) ConvertToMatchAmount(payingCoinDenom string, payingCoinRates sdk.DecCoins, fillPrice sdk.Dec) sdk.Int {
+	if b.Type == BidTypeBatchWorth {
+		payingAmt := b.ConvertToPayingAmount(payingCoinDenom, payingCoinRates)
+		return sdk.NewDecFromInt(payingAmt).QuoTruncate(fillPrice).TruncateInt()
+	}
+	return b.Coin.Amount
+}
+
+// ConvertToPayingCoin returns the paying coin that is reserved for the bid.
+// The bid coin itself is reserved if it is one of the paying coins, otherwise
+// the paying amount of the bid is reserved in the paying coin denom.
+func (b Bid) ConvertToPayingCoin(payingCoinDenom string, payingCoinRates sdk.DecCoins) sdk.Coin {
+	if _, ok := b.PayingCoinRate(payingCoinDenom, payingCoinRates); ok {
+		return b.Coin
+	}
+	return sdk.NewCoin(payingCoinDenom, b.ConvertToPayingAmount(payingCoinDenom, payingCoinRates))
+}
@@ -13,6 +13,7 @@ import (
 
 func TestConvertToSellingAmount(t *testing.T) {
 	payingCoinDenom := "denom2" // auction paying coin denom
+	payingCoinRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.5")))
 
 	testCases := []struct {
 		bid         types.Bid
@@ -53,16 +54,31 @@ func TestConvertToSellingAmount(t *testing.T) {
 			},
 			sdk.NewInt(1),
 		},
+		{
+			types.Bid{
+				Price: sdk.MustNewDecFromStr("0.5"),
+				Coin:  sdk.NewCoin("denom3", sdk.NewInt(100_000)),
+			},
+			sdk.NewInt(100_000),
+		},
+		{
+			types.Bid{
+				Price: sdk.MustNewDecFromStr("3"),
+				Coin:  sdk.NewCoin("denom3", sdk.NewInt(11)),
+			},
+			sdk.NewInt(1),
+		},
 	}
 
 	for _, tc := range testCases {
-		sellingAmt := tc.bid.ConvertToSellingAmount(payingCoinDenom)
+		sellingAmt := tc.bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates)
 		require.Equal(t, tc.expectedAmt, sellingAmt)
 	}
 }
 
 func TestConvertToPayingAmount(t *testing.T) {
 	payingCoinDenom := "denom2" // auction paying coin denom
+	payingCoinRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.5")))
 
 	testCases := []struct {
 		bid         types.Bid
@@ -103,14 +119,53 @@ func TestConvertToPayingAmount(t *testing.T) {
 			},
 			sdk.NewInt(33000),
 		},
+		{
+			types.Bid{
+				Price: sdk.MustNewDecFromStr("0.5"),
+				Coin:  sdk.NewCoin("denom3", sdk.NewInt(100_000)),
+			},
+			sdk.NewInt(50_000),
+		},
+		{
+			types.Bid{
+				Price: sdk.MustNewDecFromStr("0.5"),
+				Coin:  sdk.NewCoin("denom3", sdk.NewInt(3)),
+			},
+			sdk.NewInt(1),
+		},
 	}
 
 	for _, tc := range testCases {
-		payingAmt := tc.bid.ConvertToPayingAmount(payingCoinDenom)
+		payingAmt := tc.bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates)
 		require.Equal(t, tc.expectedAmt, payingAmt)
 	}
 }
 
+func TestConvertToPayingCoin(t *testing.T) {
+	payingCoinDenom := "denom2" // auction paying coin denom
+	payingCoinRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.5")))
+
+	for _, tc := range []struct {
+		bid          types.Bid
+		expectedCoin sdk.Coin
+	}{
+		{
+			types.Bid{Price: sdk.MustNewDecFromStr("0.5"), Coin: sdk.NewInt64Coin("denom1", 100_000)},
+			sdk.NewInt64Coin("denom2", 50_000),
+		},
+		{
+			types.Bid{Price: sdk.MustNewDecFromStr("0.5"), Coin: sdk.NewInt64Coin("denom2", 100_000)},
+			sdk.NewInt64Coin("denom2", 100_000),
+		},
+		{
+			types.Bid{Price: sdk.MustNewDecFromStr("0.5"), Coin: sdk.NewInt64Coin("denom3", 100_000)},
+			sdk.NewInt64Coin("denom3", 100_000),
+		},
+	} {
+		require.Equal(t, tc.expectedCoin, tc.bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates))
+	}
+}
+
 func TestSetMatched(t *testing.T) {
 	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder")))
 
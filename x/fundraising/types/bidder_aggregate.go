@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBidderAggregate returns a new BidderAggregate without any bid.
+func NewBidderAggregate(auctionId uint64, bidder sdk.AccAddress) BidderAggregate {
+	return BidderAggregate{
+		AuctionId:      auctionId,
+		Bidder:         bidder.String(),
+		ReservedAmount: sdk.ZeroInt(),
+		SellingAmount:  sdk.ZeroInt(),
+		BidsCount:      0,
+		ReservedCoins:  sdk.Coins{},
+	}
+}
+
+// AddBid adds the bid to the running totals of the bidder.
+func (a *BidderAggregate) AddBid(bid Bid, payingCoinDenom string, payingCoinRates sdk.DecCoins) {
+	a.ReservedAmount = a.ReservedAmount.Add(bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates))
+	a.ReservedCoins = a.ReservedCoins.Add(bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates))
+	a.SellingAmount = a.SellingAmount.Add(bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates))
+	a.BidsCount++
+}
+
+// RemoveBid removes the bid from the running totals of the bidder.
+func (a *BidderAggregate) RemoveBid(bid Bid, payingCoinDenom string, payingCoinRates sdk.DecCoins) {
+	a.ReservedAmount = a.ReservedAmount.Sub(bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates))
+	a.ReservedCoins = a.ReservedCoins.Sub(bid.ConvertToPayingCoin(payingCoinDenom, payingCoinRates))
+	a.SellingAmount = a.SellingAmount.Sub(bid.ConvertToSellingAmount(payingCoinDenom, payingCoinRates))
+	a.BidsCount--
+}
@@ -15,9 +15,20 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateFixedPriceAuction{},
 		&MsgCreateBatchAuction{},
+		&MsgCreateDutchAuction{},
 		&MsgCancelAuction{},
 		&MsgPlaceBid{},
+		&MsgCancelBid{},
+		&MsgUpdateParams{},
+		&MsgResolveFailedAuction{},
+		&MsgAddAllowedBidders{},
+		&MsgUpdateAllowedBidder{},
+		&MsgRemoveAllowedBidder{},
 		&MsgAddAllowedBidder{},
+		&MsgClaimVested{},
+		&MsgClaimAllocation{},
+		&MsgCommitBid{},
+		&MsgRevealBid{},
 	)
 
 	registry.RegisterInterface(
@@ -25,6 +36,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*AuctionI)(nil),
 		&FixedPriceAuction{},
 		&BatchAuction{},
+		&DutchAuction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
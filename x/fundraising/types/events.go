@@ -1,11 +1,36 @@
 package types
 
 // Event types for the farming module.
+//
+// Deprecated: the events are kept for backward compatibility of the event consumers
+// and will be removed in a future release. Use the typed events defined in events.proto instead.
 const (
 	EventTypeCreateFixedPriceAuction = "create_fixed_price_auction"
 	EventTypeCreateBatchAuction      = "create_batch_auction"
+	EventTypeCreateDutchAuction      = "create_dutch_auction"
 	EventTypeCancelAuction           = "cancel_auction"
 	EventTypePlaceBid                = "place_bid"
+	EventTypeModifyBid               = "modify_bid"
+	EventTypeCancelBid               = "cancel_bid"
+	EventTypeAddAllowedBidders       = "add_allowed_bidders"
+	EventTypeUpdateAllowedBidder     = "update_allowed_bidder"
+	EventTypeRemoveAllowedBidder     = "remove_allowed_bidder"
+	EventTypeAuctionFailed           = "auction_failed"
+	EventTypeResolveFailedAuction    = "resolve_failed_auction"
+	EventTypeAuctionStarted          = "auction_started"
+	EventTypeRoundExtended           = "round_extended"
+	EventTypeAuctionClosed           = "auction_closed"
+	EventTypeAuctionFailedSoftCap    = "auction_failed_soft_cap"
+	EventTypeAuctionSettled          = "auction_settled"
+	EventTypeAllocateSellingCoin     = "allocate_selling_coin"
+	EventTypeRefundPayingCoin        = "refund_paying_coin"
+	EventTypeReleaseVesting          = "release_vesting"
+	EventTypeReleaseBidderVesting    = "release_bidder_vesting"
+	EventTypeClaimVested             = "claim_vested"
+	EventTypeClaimAllocation         = "claim_allocation"
+	EventTypeCommitBid               = "commit_bid"
+	EventTypeRevealBid               = "reveal_bid"
+	EventTypeCommitmentForfeited     = "commitment_forfeited"
 
 	AttributeKeyAuctionId             = "auction_id" //nolint:golint
 	AttributeKeyAuctioneerAddress     = "auctioneer_address"
@@ -21,10 +46,32 @@ const (
 	AttributeKeyStartTime             = "start_time"
 	AttributeKeyEndTime               = "end_time"
 	AttributeKeyBidderAddress         = "bidder_address"
+	AttributeKeyBidId                 = "bid_id"
 	AttributeKeyBidPrice              = "bid_price"
 	AttributeKeyBidCoin               = "bid_coin"
 	AttributeKeyBidAmount             = "bid_amount"
 	AttributeKeyMinBidPrice           = "min_bid_price"
 	AttributeKeyMaxExtendedRound      = "maximum_extended_round"
 	AttributeKeyExtendedRoundRate     = "extended_round_rate"
+	AttributeKeyFloorPrice            = "floor_price"
+	AttributeKeyPriceDecayStep        = "price_decay_step"
+	AttributeKeyPriceDecayPeriod      = "price_decay_period"
+	AttributeKeyRefundCoin            = "refund_coin"
+	AttributeKeyMaxBidAmount          = "max_bid_amount"
+	AttributeKeyFailedStatus          = "failed_status"
+	AttributeKeyFailureReason         = "failure_reason"
+	AttributeKeyForceRefund           = "force_refund"
+	AttributeKeyMatchedPrice          = "matched_price"
+	AttributeKeySoldAmount            = "sold_amount"
+	AttributeKeyWinnersCount          = "winners_count"
+	AttributeKeySettledBidders        = "settled_bidders"
+	AttributeKeyMinRaiseAmount        = "min_raise_amount"
+	AttributeKeyRaisedAmount          = "raised_amount"
+	AttributeKeyAllocatedCoin         = "allocated_coin"
+	AttributeKeyReleaseCoin           = "release_coin"
+	AttributeKeyReleaseTime           = "release_time"
+	AttributeKeyCommitmentId          = "commitment_id"
+	AttributeKeyDeposit               = "deposit"
+	AttributeKeyPenaltyCoin           = "penalty_coin"
+	AttributeKeyPricingRule           = "pricing_rule"
 )
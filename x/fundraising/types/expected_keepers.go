@@ -88,6 +88,35 @@ type FundraisingHooks interface {
 		endTime time.Time,
 	)
 
+	BeforeDutchAuctionCreated(
+		ctx sdk.Context,
+		auctioneer string,
+		startPrice sdk.Dec,
+		floorPrice sdk.Dec,
+		priceDecayStep sdk.Dec,
+		priceDecayPeriod time.Duration,
+		sellingCoin sdk.Coin,
+		payingCoinDenom string,
+		vestingSchedules []VestingSchedule,
+		startTime time.Time,
+		endTime time.Time,
+	)
+
+	AfterDutchAuctionCreated(
+		ctx sdk.Context,
+		auctionId uint64,
+		auctioneer string,
+		startPrice sdk.Dec,
+		floorPrice sdk.Dec,
+		priceDecayStep sdk.Dec,
+		priceDecayPeriod time.Duration,
+		sellingCoin sdk.Coin,
+		payingCoinDenom string,
+		vestingSchedules []VestingSchedule,
+		startTime time.Time,
+		endTime time.Time,
+	)
+
 	BeforeAuctionCanceled(
 		ctx sdk.Context,
 		auctionId uint64,
@@ -114,6 +143,13 @@ type FundraisingHooks interface {
 		coin sdk.Coin,
 	)
 
+	BeforeBidCanceled(
+		ctx sdk.Context,
+		auctionId uint64,
+		bidId uint64,
+		bidder string,
+	)
+
 	BeforeAllowedBiddersAdded(
 		ctx sdk.Context,
 		allowedBidders []AllowedBidder,
@@ -126,6 +162,12 @@ type FundraisingHooks interface {
 		maxBidAmount sdk.Int,
 	)
 
+	BeforeAllowedBidderRemoved(
+		ctx sdk.Context,
+		auctionId uint64,
+		bidder sdk.AccAddress,
+	)
+
 	BeforeSellingCoinsAllocated(
 		ctx sdk.Context,
 		auctionId uint64,
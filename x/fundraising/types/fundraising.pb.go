@@ -40,18 +40,22 @@ const (
 	AuctionTypeFixedPrice AuctionType = 1
 	// AUCTION_TYPE_BATCH defines the batch auction type
 	AuctionTypeBatch AuctionType = 2
+	// AUCTION_TYPE_DUTCH defines the dutch auction type
+	AuctionTypeDutch AuctionType = 3
 )
 
 var AuctionType_name = map[int32]string{
 	0: "AUCTION_TYPE_UNSPECIFIED",
 	1: "AUCTION_TYPE_FIXED_PRICE",
 	2: "AUCTION_TYPE_BATCH",
+	3: "AUCTION_TYPE_DUTCH",
 }
 
 var AuctionType_value = map[string]int32{
 	"AUCTION_TYPE_UNSPECIFIED": 0,
 	"AUCTION_TYPE_FIXED_PRICE": 1,
 	"AUCTION_TYPE_BATCH":       2,
+	"AUCTION_TYPE_DUTCH":       3,
 }
 
 func (x AuctionType) String() string {
@@ -78,6 +82,16 @@ const (
 	AuctionStatusFinished AuctionStatus = 4
 	// AUCTION_STATUS_CANCELLED defines the cancelled auction status
 	AuctionStatusCancelled AuctionStatus = 5
+	// AUCTION_STATUS_FAILED defines the auction status that the execution of
+	// the auction failed at the end of the block
+	AuctionStatusFailed AuctionStatus = 6
+	// AUCTION_STATUS_FAILED_SOFT_CAP defines the auction status that the auction
+	// did not raise the minimum raise amount when it is closed
+	AuctionStatusFailedSoftCap AuctionStatus = 7
+	// AUCTION_STATUS_SETTLING defines the auction status that the closed batch
+	// auction pays out the allocations and refunds to the bidders over multiple
+	// blocks
+	AuctionStatusSettling AuctionStatus = 8
 )
 
 var AuctionStatus_name = map[int32]string{
@@ -87,15 +101,21 @@ var AuctionStatus_name = map[int32]string{
 	3: "AUCTION_STATUS_VESTING",
 	4: "AUCTION_STATUS_FINISHED",
 	5: "AUCTION_STATUS_CANCELLED",
+	6: "AUCTION_STATUS_FAILED",
+	7: "AUCTION_STATUS_FAILED_SOFT_CAP",
+	8: "AUCTION_STATUS_SETTLING",
 }
 
 var AuctionStatus_value = map[string]int32{
-	"AUCTION_STATUS_UNSPECIFIED": 0,
-	"AUCTION_STATUS_STANDBY":     1,
-	"AUCTION_STATUS_STARTED":     2,
-	"AUCTION_STATUS_VESTING":     3,
-	"AUCTION_STATUS_FINISHED":    4,
-	"AUCTION_STATUS_CANCELLED":   5,
+	"AUCTION_STATUS_UNSPECIFIED":     0,
+	"AUCTION_STATUS_STANDBY":         1,
+	"AUCTION_STATUS_STARTED":         2,
+	"AUCTION_STATUS_VESTING":         3,
+	"AUCTION_STATUS_FINISHED":        4,
+	"AUCTION_STATUS_CANCELLED":       5,
+	"AUCTION_STATUS_FAILED":          6,
+	"AUCTION_STATUS_FAILED_SOFT_CAP": 7,
+	"AUCTION_STATUS_SETTLING":        8,
 }
 
 func (x AuctionStatus) String() string {
@@ -120,6 +140,8 @@ const (
 	// BID_TYPE_BATCH_MANY defines a bid type for How-Many-Coins-to-Buy of a batch
 	// auction
 	BidTypeBatchMany BidType = 3
+	// BID_TYPE_DUTCH defines a bid type for a dutch auction type
+	BidTypeDutch BidType = 4
 )
 
 var BidType_name = map[int32]string{
@@ -127,6 +149,7 @@ var BidType_name = map[int32]string{
 	1: "BID_TYPE_FIXED_PRICE",
 	2: "BID_TYPE_BATCH_WORTH",
 	3: "BID_TYPE_BATCH_MANY",
+	4: "BID_TYPE_DUTCH",
 }
 
 var BidType_value = map[string]int32{
@@ -134,6 +157,7 @@ var BidType_value = map[string]int32{
 	"BID_TYPE_FIXED_PRICE": 1,
 	"BID_TYPE_BATCH_WORTH": 2,
 	"BID_TYPE_BATCH_MANY":  3,
+	"BID_TYPE_DUTCH":       4,
 }
 
 func (x BidType) String() string {
@@ -144,6 +168,75 @@ func (BidType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a97a388085f27061, []int{2}
 }
 
+// PartialFillMode enumerates the valid ways to fill the bids at the matched
+// price of a batch auction.
+type PartialFillMode int32
+
+const (
+	// PARTIAL_FILL_MODE_UNSPECIFIED defines the default mode where the bids are
+	// never filled partially, so the matched price is raised until all the bids
+	// at the price are filled fully
+	PartialFillModeNil PartialFillMode = 0
+	// PARTIAL_FILL_MODE_PRO_RATA defines the mode where the bids at the matched
+	// price share the remaining selling coin in proportion to their amounts
+	PartialFillModeProRata PartialFillMode = 1
+	// PARTIAL_FILL_MODE_TIME_PRIORITY defines the mode where the bids at the
+	// matched price are filled in the order of the bid ids until the remaining
+	// selling coin runs out
+	PartialFillModeTimePriority PartialFillMode = 2
+)
+
+var PartialFillMode_name = map[int32]string{
+	0: "PARTIAL_FILL_MODE_UNSPECIFIED",
+	1: "PARTIAL_FILL_MODE_PRO_RATA",
+	2: "PARTIAL_FILL_MODE_TIME_PRIORITY",
+}
+
+var PartialFillMode_value = map[string]int32{
+	"PARTIAL_FILL_MODE_UNSPECIFIED":   0,
+	"PARTIAL_FILL_MODE_PRO_RATA":      1,
+	"PARTIAL_FILL_MODE_TIME_PRIORITY": 2,
+}
+
+func (x PartialFillMode) String() string {
+	return proto.EnumName(PartialFillMode_name, int32(x))
+}
+
+func (PartialFillMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{3}
+}
+
+// PricingRule enumerates the valid rules for the price that the winning bids
+// of a batch auction pay.
+type PricingRule int32
+
+const (
+	// PRICING_RULE_UNSPECIFIED defines the default uniform pricing rule where
+	// every winning bid pays the matched price
+	PricingRuleUniform PricingRule = 0
+	// PRICING_RULE_PAY_AS_BID defines the discriminatory pricing rule where every
+	// winning bid pays its own bid price
+	PricingRulePayAsBid PricingRule = 1
+)
+
+var PricingRule_name = map[int32]string{
+	0: "PRICING_RULE_UNSPECIFIED",
+	1: "PRICING_RULE_PAY_AS_BID",
+}
+
+var PricingRule_value = map[string]int32{
+	"PRICING_RULE_UNSPECIFIED": 0,
+	"PRICING_RULE_PAY_AS_BID":  1,
+}
+
+func (x PricingRule) String() string {
+	return proto.EnumName(PricingRule_name, int32(x))
+}
+
+func (PricingRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{4}
+}
+
 // AddressType enumerates the available types of a address.
 type AddressType int32
 
@@ -169,7 +262,7 @@ func (x AddressType) String() string {
 }
 
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a97a388085f27061, []int{3}
+	return fileDescriptor_a97a388085f27061, []int{5}
 }
 
 // BaseAuction defines a base auction type. It contains all the necessary fields
@@ -180,7 +273,7 @@ type BaseAuction struct {
 	// id specifies index of the auction
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// type specifies the auction type
-	// type 1 is fixed price and 2 is batch auction
+	// type 1 is fixed price, 2 is batch auction, and 3 is dutch auction
 	Type AuctionType `protobuf:"varint,2,opt,name=type,proto3,enum=tendermint.fundraising.AuctionType" json:"type,omitempty"`
 	// auctioneer specifies the bech32-encoded address that creates the auction
 	Auctioneer string `protobuf:"bytes,3,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
@@ -209,6 +302,45 @@ type BaseAuction struct {
 	EndTimes []time.Time `protobuf:"bytes,12,rep,name=end_times,json=endTimes,proto3,stdtime" json:"end_times"`
 	// status specifies the auction status
 	Status AuctionStatus `protobuf:"varint,13,opt,name=status,proto3,enum=tendermint.fundraising.AuctionStatus" json:"status,omitempty"`
+	// auctioneer_managed_allowlist specifies whether the allowed bidders of the
+	// auction are managed by the auctioneer or by an external module
+	AuctioneerManagedAllowlist bool `protobuf:"varint,14,opt,name=auctioneer_managed_allowlist,json=auctioneerManagedAllowlist,proto3" json:"auctioneer_managed_allowlist,omitempty"`
+	// open_bidding specifies whether any address can place a bid for the auction
+	// without being registered as an allowed bidder
+	OpenBidding bool `protobuf:"varint,15,opt,name=open_bidding,json=openBidding,proto3" json:"open_bidding,omitempty"`
+	// default_max_bid_amount specifies the maximum bid amount per bidder for the
+	// open bidding auction; the allowed bidder's maximum bid amount takes
+	// precedence if it exists
+	DefaultMaxBidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=default_max_bid_amount,json=defaultMaxBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"default_max_bid_amount"`
+	// selling_basket specifies the additional coins that are sold together with
+	// the selling coin in the fixed ratio of their amounts to the selling coin
+	// amount; bids purchase units of the selling coin and each unit comes with
+	// the basket coins in the ratio
+	SellingBasket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=selling_basket,json=sellingBasket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"selling_basket"`
+	// paying_coin_rates specifies the additional denoms that bidders can use to
+	// bid for along with the paying coin denom; the amount of each rate is the
+	// fixed amount of the paying coin denom that one unit of the denom is worth
+	PayingCoinRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,18,rep,name=paying_coin_rates,json=payingCoinRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"paying_coin_rates"`
+	// min_raise_amount specifies the minimum amount of the paying coin denom that
+	// the auction must raise; if it is not met when the auction is closed, all
+	// the coins are returned to their owners and the auction fails; zero means
+	// that the auction has no minimum raise
+	MinRaiseAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,19,opt,name=min_raise_amount,json=minRaiseAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_raise_amount"`
+	// bidder_vesting_schedules specifies the vesting schedules for the selling
+	// coin allocated to the bidders; if it is not empty, the allocated selling
+	// coin is locked in the bidder vesting reserve account and released to each
+	// bidder according to the schedules
+	BidderVestingSchedules []VestingSchedule `protobuf:"bytes,20,rep,name=bidder_vesting_schedules,json=bidderVestingSchedules,proto3" json:"bidder_vesting_schedules"`
+	// linear_vesting_schedule specifies the continuous vesting schedule for the
+	// auctioneer; if it is set, the paying coin is vested linearly between the
+	// start and end time and the auctioneer claims the vested portion with
+	// MsgClaimVested; it cannot be used together with vesting_schedules
+	LinearVestingSchedule *LinearVestingSchedule `protobuf:"bytes,21,opt,name=linear_vesting_schedule,json=linearVestingSchedule,proto3" json:"linear_vesting_schedule,omitempty"`
+	// claim_mode specifies whether the bidders claim the allocated selling coin
+	// and the refunded paying coin with MsgClaimAllocation instead of receiving
+	// them when the auction is closed; the coins are kept in the claim reserve
+	// account until they are claimed
+	ClaimMode bool `protobuf:"varint,22,opt,name=claim_mode,json=claimMode,proto3" json:"claim_mode,omitempty"`
 }
 
 func (m *BaseAuction) Reset()         { *m = BaseAuction{} }
@@ -251,6 +383,9 @@ type FixedPriceAuction struct {
 	*BaseAuction `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction,omitempty"`
 	// remaining_coin specifies the remaining amount of selling coin to sell
 	RemainingSellingCoin types.Coin `protobuf:"bytes,2,opt,name=remaining_selling_coin,json=remainingSellingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"remaining_selling_coin"`
+	// close_when_sold_out specifies whether the auction is closed at the next
+	// block once the selling coin is sold out instead of waiting for the end time
+	CloseWhenSoldOut bool `protobuf:"varint,3,opt,name=close_when_sold_out,json=closeWhenSoldOut,proto3" json:"close_when_sold_out,omitempty"`
 }
 
 func (m *FixedPriceAuction) Reset()         { *m = FixedPriceAuction{} }
@@ -303,6 +438,15 @@ type BatchAuction struct {
 	// extended_round_rate specifies the rate that decides if the auction needs
 	// another round
 	ExtendedRoundRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=extended_round_rate,json=extendedRoundRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"extended_round_rate"`
+	// sealed_bid_config specifies the sealed bid mode of the auction; bids are
+	// placed as commitments and revealed before the end time when it is set
+	SealedBidConfig *SealedBidConfig `protobuf:"bytes,6,opt,name=sealed_bid_config,json=sealedBidConfig,proto3" json:"sealed_bid_config,omitempty"`
+	// partial_fill_mode specifies how the bids at the matched price are filled
+	// when they can't be filled fully with the remaining selling coin
+	PartialFillMode PartialFillMode `protobuf:"varint,7,opt,name=partial_fill_mode,json=partialFillMode,proto3,enum=tendermint.fundraising.PartialFillMode" json:"partial_fill_mode,omitempty"`
+	// pricing_rule specifies the price that the winning bids pay for the
+	// selling coin
+	PricingRule PricingRule `protobuf:"varint,8,opt,name=pricing_rule,json=pricingRule,proto3,enum=tendermint.fundraising.PricingRule" json:"pricing_rule,omitempty"`
 }
 
 func (m *BatchAuction) Reset()         { *m = BatchAuction{} }
@@ -338,6 +482,59 @@ func (m *BatchAuction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BatchAuction proto.InternalMessageInfo
 
+// DutchAuction defines a dutch (descending price) auction type. The price
+// starts from the start price and decreases by the price decay step every
+// price decay period until it reaches the floor price. Bidders purchase the
+// selling coin at the current price and their bids are filled immediately
+// against the remaining selling coin.
+type DutchAuction struct {
+	*BaseAuction `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction,omitempty"`
+	// floor_price specifies the lowest price that the auction price can decay
+	// to
+	FloorPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=floor_price,json=floorPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"floor_price"`
+	// price_decay_step specifies the amount of price that decreases every price
+	// decay period
+	PriceDecayStep github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_decay_step,json=priceDecayStep,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_decay_step"`
+	// price_decay_period specifies the period of time between price decreases
+	PriceDecayPeriod time.Duration `protobuf:"bytes,4,opt,name=price_decay_period,json=priceDecayPeriod,proto3,stdduration" json:"price_decay_period"`
+	// remaining_selling_coin specifies the remaining amount of selling coin to
+	// sell
+	RemainingSellingCoin types.Coin `protobuf:"bytes,5,opt,name=remaining_selling_coin,json=remainingSellingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"remaining_selling_coin"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{3}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
 // VestingSchedule defines the vesting schedule for the owner of an auction.
 type VestingSchedule struct {
 	// release_time specifies the time for distribution of the vesting coin
@@ -350,7 +547,7 @@ func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
 func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
 func (*VestingSchedule) ProtoMessage()    {}
 func (*VestingSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a97a388085f27061, []int{3}
+	return fileDescriptor_a97a388085f27061, []int{4}
 }
 func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,6 +583,199 @@ func (m *VestingSchedule) GetReleaseTime() time.Time {
 	return time.Time{}
 }
 
+// LinearVestingSchedule defines the continuous vesting schedule for the owner
+// of an auction.
+type LinearVestingSchedule struct {
+	// start_time specifies the time when the vesting starts
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time specifies the time when all the paying coin is vested
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cliff_time specifies the time before which nothing is vested; zero time
+	// means that the schedule has no cliff
+	CliffTime time.Time `protobuf:"bytes,3,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time"`
+}
+
+func (m *LinearVestingSchedule) Reset()         { *m = LinearVestingSchedule{} }
+func (m *LinearVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*LinearVestingSchedule) ProtoMessage()    {}
+func (*LinearVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{5}
+}
+func (m *LinearVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinearVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinearVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinearVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinearVestingSchedule.Merge(m, src)
+}
+func (m *LinearVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinearVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinearVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinearVestingSchedule proto.InternalMessageInfo
+
+func (m *LinearVestingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *LinearVestingSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *LinearVestingSchedule) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+// SealedBidConfig defines the sealed bid mode of a batch auction. Bidders
+// commit the hash of their bids with a collateral deposit until the reveal
+// window starts, and reveal the bids in the reveal window, which is the
+// reveal period before the end time of the auction.
+type SealedBidConfig struct {
+	// reveal_period specifies the length of the reveal window before the end
+	// time of the auction
+	RevealPeriod time.Duration `protobuf:"bytes,1,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period"`
+	// unrevealed_penalty_rate specifies the rate of the deposit that is
+	// forfeited to the community pool for a commitment that is not revealed
+	UnrevealedPenaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=unrevealed_penalty_rate,json=unrevealedPenaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrevealed_penalty_rate"`
+}
+
+func (m *SealedBidConfig) Reset()         { *m = SealedBidConfig{} }
+func (m *SealedBidConfig) String() string { return proto.CompactTextString(m) }
+func (*SealedBidConfig) ProtoMessage()    {}
+func (*SealedBidConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{6}
+}
+func (m *SealedBidConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidConfig.Merge(m, src)
+}
+func (m *SealedBidConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidConfig proto.InternalMessageInfo
+
+func (m *SealedBidConfig) GetRevealPeriod() time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+// LinearVesting defines the state of the linear vesting of an auction.
+type LinearVesting struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// auctioneer specifies the bech32-encoded address of the auctioneer
+	Auctioneer string `protobuf:"bytes,2,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// total_coins specifies the paying coins that are vested over the schedule
+	TotalCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_coins,json=totalCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_coins"`
+	// claimed_coins specifies the paying coins that are already claimed by the
+	// auctioneer
+	ClaimedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=claimed_coins,json=claimedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_coins"`
+}
+
+func (m *LinearVesting) Reset()         { *m = LinearVesting{} }
+func (m *LinearVesting) String() string { return proto.CompactTextString(m) }
+func (*LinearVesting) ProtoMessage()    {}
+func (*LinearVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{7}
+}
+func (m *LinearVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinearVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinearVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinearVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinearVesting.Merge(m, src)
+}
+func (m *LinearVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinearVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinearVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinearVesting proto.InternalMessageInfo
+
+func (m *LinearVesting) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *LinearVesting) GetAuctioneer() string {
+	if m != nil {
+		return m.Auctioneer
+	}
+	return ""
+}
+
+func (m *LinearVesting) GetTotalCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalCoins
+	}
+	return nil
+}
+
+func (m *LinearVesting) GetClaimedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedCoins
+	}
+	return nil
+}
+
 // VestingQueue defines the vesting queue.
 type VestingQueue struct {
 	// auction_id specifies the id of the auction
@@ -404,7 +794,7 @@ func (m *VestingQueue) Reset()         { *m = VestingQueue{} }
 func (m *VestingQueue) String() string { return proto.CompactTextString(m) }
 func (*VestingQueue) ProtoMessage()    {}
 func (*VestingQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a97a388085f27061, []int{4}
+	return fileDescriptor_a97a388085f27061, []int{8}
 }
 func (m *VestingQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,6 +858,89 @@ func (m *VestingQueue) GetReleased() bool {
 	return false
 }
 
+// BidderVestingQueue defines the vesting queue of the selling coin allocated
+// to a bidder.
+type BidderVestingQueue struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// release_coins specifies the selling coin and the basket coins to release
+	ReleaseCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=release_coins,json=releaseCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"release_coins"`
+	// release_time specifies the timestamp of the bidder vesting schedule
+	ReleaseTime time.Time `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+	// released specifies the status of distribution
+	Released bool `protobuf:"varint,5,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *BidderVestingQueue) Reset()         { *m = BidderVestingQueue{} }
+func (m *BidderVestingQueue) String() string { return proto.CompactTextString(m) }
+func (*BidderVestingQueue) ProtoMessage()    {}
+func (*BidderVestingQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{9}
+}
+func (m *BidderVestingQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidderVestingQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidderVestingQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidderVestingQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidderVestingQueue.Merge(m, src)
+}
+func (m *BidderVestingQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidderVestingQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidderVestingQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidderVestingQueue proto.InternalMessageInfo
+
+func (m *BidderVestingQueue) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *BidderVestingQueue) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BidderVestingQueue) GetReleaseCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReleaseCoins
+	}
+	return nil
+}
+
+func (m *BidderVestingQueue) GetReleaseTime() time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return time.Time{}
+}
+
+func (m *BidderVestingQueue) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
 // AllowedBidder defines an allowed bidder for the auction.
 type AllowedBidder struct {
 	// bidder specifies the bech32-encoded address that bids for the auction
@@ -480,7 +953,7 @@ func (m *AllowedBidder) Reset()         { *m = AllowedBidder{} }
 func (m *AllowedBidder) String() string { return proto.CompactTextString(m) }
 func (*AllowedBidder) ProtoMessage()    {}
 func (*AllowedBidder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a97a388085f27061, []int{5}
+	return fileDescriptor_a97a388085f27061, []int{10}
 }
 func (m *AllowedBidder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +991,7 @@ type Bid struct {
 	// id specifies an index of a bid for the bidder
 	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
-	// is how-many-coins
+	// is how-many-coins, and 4 is dutch
 	Type BidType `protobuf:"varint,4,opt,name=type,proto3,enum=tendermint.fundraising.BidType" json:"type,omitempty"`
 	// price specifies the bid price in which price the bidder places the bid
 	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
@@ -526,6 +999,7 @@ type Bid struct {
 	// for a fixed price auction, the denom is of the paying coin.
 	// for a batch auction of how-much-worth, the denom is of the paying coin.
 	// for a batch auction of how-many-coins, the denom is of the selling coin.
+	// for a dutch auction, the denom is of either the paying or selling coin.
 	Coin types.Coin `protobuf:"bytes,6,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
 	// is_matched specifies the bid that is a winning bid and enables the bidder
 	// to purchase the selling coin
@@ -536,7 +1010,7 @@ func (m *Bid) Reset()         { *m = Bid{} }
 func (m *Bid) String() string { return proto.CompactTextString(m) }
 func (*Bid) ProtoMessage()    {}
 func (*Bid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a97a388085f27061, []int{6}
+	return fileDescriptor_a97a388085f27061, []int{11}
 }
 func (m *Bid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,498 +1039,719 @@ func (m *Bid) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bid proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("tendermint.fundraising.AuctionType", AuctionType_name, AuctionType_value)
-	proto.RegisterEnum("tendermint.fundraising.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
-	proto.RegisterEnum("tendermint.fundraising.BidType", BidType_name, BidType_value)
-	proto.RegisterEnum("tendermint.fundraising.AddressType", AddressType_name, AddressType_value)
-	proto.RegisterType((*BaseAuction)(nil), "tendermint.fundraising.BaseAuction")
-	proto.RegisterType((*FixedPriceAuction)(nil), "tendermint.fundraising.FixedPriceAuction")
-	proto.RegisterType((*BatchAuction)(nil), "tendermint.fundraising.BatchAuction")
-	proto.RegisterType((*VestingSchedule)(nil), "tendermint.fundraising.VestingSchedule")
-	proto.RegisterType((*VestingQueue)(nil), "tendermint.fundraising.VestingQueue")
-	proto.RegisterType((*AllowedBidder)(nil), "tendermint.fundraising.AllowedBidder")
-	proto.RegisterType((*Bid)(nil), "tendermint.fundraising.Bid")
+// BidCommitment defines the sealed bid of a bidder for the batch auction in
+// sealed bid mode. It holds the hash of the bid and the collateral deposit
+// until the bidder reveals the bid in the reveal window.
+type BidCommitment struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// id specifies the id of the commitment, which becomes the id of the bid
+	// when it is revealed
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// bidder specifies the bech32-encoded address that commits the bid
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment specifies the SHA-256 hash of the bid and the salt
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit specifies the paying coin deposited as collateral, which must
+	// cover the paying coin reserved for the revealed bid
+	Deposit types.Coin `protobuf:"bytes,5,opt,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
 }
 
-func init() { proto.RegisterFile("fundraising/fundraising.proto", fileDescriptor_a97a388085f27061) }
-
-var fileDescriptor_a97a388085f27061 = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xf7, 0xda, 0x26, 0xd8, 0x63, 0x27, 0x6c, 0x86, 0xc4, 0x5f, 0x63, 0x09, 0x7b, 0x95, 0xaf,
-	0x5a, 0x22, 0x54, 0xd6, 0xe0, 0xd0, 0x52, 0x21, 0xf5, 0xe0, 0xb5, 0x37, 0x60, 0x09, 0x9c, 0x74,
-	0x77, 0x81, 0x86, 0x03, 0xab, 0xb5, 0x67, 0x70, 0x46, 0x78, 0x77, 0xa3, 0xdd, 0x75, 0x88, 0x6f,
-	0x95, 0x7a, 0x41, 0x3e, 0x71, 0xed, 0xc1, 0x6a, 0xd5, 0xde, 0xaa, 0x1e, 0x7b, 0xed, 0x1d, 0xa1,
-	0x1e, 0x38, 0x56, 0x3d, 0x40, 0x05, 0xb7, 0xfe, 0x15, 0xd5, 0xfc, 0x70, 0xb2, 0x76, 0x8c, 0x80,
-	0x88, 0x9e, 0xbc, 0xf3, 0xde, 0xfb, 0xbc, 0x79, 0xf3, 0xde, 0xe7, 0xbd, 0x19, 0x83, 0xf3, 0x0f,
-	0x07, 0x1e, 0x0a, 0x1c, 0x12, 0x12, 0xaf, 0x57, 0x8d, 0x7d, 0xab, 0x7b, 0x81, 0x1f, 0xf9, 0xb0,
-	0x10, 0x61, 0x0f, 0xe1, 0xc0, 0x25, 0x5e, 0xa4, 0xc6, 0xb4, 0xa5, 0x72, 0xd7, 0x0f, 0x5d, 0x3f,
-	0xac, 0x76, 0x9c, 0x10, 0x57, 0xf7, 0xaf, 0x74, 0x70, 0xe4, 0x5c, 0xa9, 0x76, 0x7d, 0xe2, 0x71,
-	0x5c, 0xe9, 0x1c, 0xd7, 0xdb, 0x6c, 0x55, 0xe5, 0x0b, 0xa1, 0x5a, 0xe9, 0xf9, 0x3d, 0x9f, 0xcb,
-	0xe9, 0x97, 0x90, 0x56, 0x7a, 0xbe, 0xdf, 0xeb, 0xe3, 0x2a, 0x5b, 0x75, 0x06, 0x0f, 0xab, 0x11,
-	0x71, 0x71, 0x18, 0x39, 0xee, 0x1e, 0x37, 0x58, 0xfb, 0x7d, 0x01, 0xe4, 0x34, 0x27, 0xc4, 0xf5,
-	0x41, 0x37, 0x22, 0xbe, 0x07, 0x97, 0x40, 0x92, 0xa0, 0xa2, 0xa4, 0x48, 0xeb, 0x69, 0x23, 0x49,
-	0x10, 0xbc, 0x06, 0xd2, 0xd1, 0x70, 0x0f, 0x17, 0x93, 0x8a, 0xb4, 0xbe, 0x54, 0xfb, 0xbf, 0x3a,
-	0x3f, 0x70, 0x55, 0xc0, 0xad, 0xe1, 0x1e, 0x36, 0x18, 0x00, 0x96, 0x01, 0x70, 0xb8, 0x10, 0xe3,
-	0xa0, 0x98, 0x52, 0xa4, 0xf5, 0xac, 0x11, 0x93, 0xc0, 0x2f, 0xc0, 0xff, 0x42, 0xdc, 0xef, 0x13,
-	0xaf, 0x67, 0x07, 0x38, 0xc4, 0xc1, 0x3e, 0xb6, 0x1d, 0x84, 0x02, 0x1c, 0x86, 0xc5, 0x34, 0x33,
-	0x5e, 0x15, 0x6a, 0x83, 0x6b, 0xeb, 0x5c, 0x09, 0xaf, 0x82, 0xc2, 0x9e, 0x33, 0x9c, 0x07, 0x3b,
-	0xc5, 0x60, 0x2b, 0x5c, 0x3b, 0x83, 0xda, 0x02, 0xb9, 0x30, 0x72, 0x82, 0xc8, 0xde, 0x0b, 0x48,
-	0x17, 0x17, 0x17, 0xa8, 0xa9, 0xa6, 0x3e, 0x7b, 0x59, 0x49, 0xfc, 0xf5, 0xb2, 0xf2, 0x69, 0x8f,
-	0x44, 0xbb, 0x83, 0x8e, 0xda, 0xf5, 0x5d, 0x91, 0x53, 0xf1, 0x73, 0x29, 0x44, 0x8f, 0xaa, 0xf4,
-	0x34, 0xa1, 0xda, 0xc4, 0x5d, 0x03, 0x30, 0x17, 0xdb, 0xd4, 0x03, 0x74, 0x41, 0x7e, 0x12, 0x3e,
-	0xad, 0x4f, 0xf1, 0xb4, 0x22, 0xad, 0xe7, 0x6a, 0xe7, 0x54, 0x51, 0x13, 0x5a, 0x40, 0x55, 0x14,
-	0x50, 0x6d, 0xf8, 0xc4, 0xd3, 0xaa, 0x74, 0xb3, 0x5f, 0x5e, 0x55, 0x2e, 0xbc, 0xc7, 0x66, 0x14,
-	0x60, 0xe4, 0x84, 0x7f, 0xba, 0x80, 0x17, 0xc1, 0xb2, 0x38, 0x35, 0xdd, 0xcd, 0x46, 0xd8, 0xf3,
-	0xdd, 0x62, 0x86, 0x1d, 0xf8, 0x0c, 0x57, 0x50, 0xb3, 0x26, 0x15, 0xd3, 0xcc, 0xee, 0xe3, 0x30,
-	0x9a, 0x97, 0xa2, 0x2c, 0xcf, 0xac, 0x50, 0xcf, 0xe4, 0xe8, 0x3e, 0x58, 0x9e, 0xe0, 0xc2, 0xee,
-	0x2e, 0x46, 0x83, 0x3e, 0x0e, 0x8b, 0x40, 0x49, 0xad, 0xe7, 0x6a, 0x17, 0xde, 0x56, 0xf7, 0xbb,
-	0x1c, 0x60, 0x0a, 0x7b, 0x2d, 0x4d, 0x4f, 0x69, 0xc8, 0xfb, 0xd3, 0xe2, 0x10, 0x36, 0x00, 0x4f,
-	0x9e, 0x4d, 0xf9, 0x57, 0xcc, 0xb1, 0x64, 0x95, 0x54, 0x4e, 0x4e, 0x75, 0x42, 0x4e, 0xd5, 0x9a,
-	0x90, 0x53, 0xcb, 0x50, 0x3f, 0x4f, 0x5f, 0x55, 0x24, 0x23, 0xcb, 0x70, 0x54, 0x03, 0xeb, 0x20,
-	0x8b, 0x3d, 0xc4, 0x5c, 0x84, 0xc5, 0x3c, 0x0b, 0xec, 0xfd, 0x7c, 0x64, 0xb0, 0x87, 0x98, 0x1c,
-	0x7e, 0x05, 0x16, 0xc2, 0xc8, 0x89, 0x06, 0x61, 0x71, 0x91, 0x11, 0xfa, 0x93, 0x77, 0x10, 0xda,
-	0x64, 0xc6, 0x86, 0x00, 0x5d, 0x97, 0x9f, 0xfc, 0x58, 0x49, 0x3c, 0xff, 0xed, 0x52, 0x46, 0xa8,
-	0x5b, 0x6b, 0xff, 0x48, 0x60, 0x79, 0x93, 0x1c, 0x60, 0xc4, 0x68, 0x31, 0xe9, 0xa2, 0x5b, 0x20,
-	0x4f, 0x19, 0x60, 0x0b, 0xbe, 0xb3, 0x7e, 0xca, 0xbd, 0xbd, 0x7b, 0x62, 0x0d, 0xa8, 0xa5, 0x5f,
-	0xbc, 0xac, 0x48, 0x46, 0xae, 0x13, 0xeb, 0xc9, 0x6f, 0x25, 0x50, 0x08, 0xb0, 0xeb, 0x10, 0x8f,
-	0xd5, 0x26, 0x4e, 0xbb, 0xe4, 0x47, 0xa7, 0xdd, 0xca, 0xe1, 0x4e, 0xe6, 0x11, 0xff, 0xae, 0xa7,
-	0xe9, 0xc1, 0xd7, 0xbe, 0x4f, 0x81, 0xbc, 0xe6, 0x44, 0xdd, 0xdd, 0xff, 0xe6, 0x9c, 0x06, 0x58,
-	0x74, 0x89, 0x67, 0x77, 0x08, 0x12, 0x6d, 0x9a, 0x3c, 0x51, 0x9b, 0xe6, 0x5c, 0xe2, 0x69, 0x84,
-	0x17, 0x04, 0x9a, 0x60, 0xd1, 0xa5, 0x11, 0xe3, 0x89, 0xcf, 0xd4, 0x89, 0x7c, 0xe6, 0x85, 0x13,
-	0xee, 0xf4, 0x33, 0x00, 0x5d, 0xe7, 0xc0, 0xc6, 0x07, 0xec, 0x9c, 0xc8, 0x0e, 0xfc, 0x81, 0x87,
-	0xd8, 0xd8, 0x5a, 0x34, 0x64, 0xd7, 0x39, 0xd0, 0x85, 0xc2, 0xa0, 0x72, 0xf8, 0x00, 0x9c, 0x9d,
-	0xb6, 0xb4, 0x03, 0x27, 0xc2, 0x7c, 0x5c, 0x7d, 0x70, 0x20, 0xcb, 0x38, 0xee, 0xdb, 0x70, 0x22,
-	0x2c, 0x6a, 0xf3, 0x93, 0x04, 0xce, 0xcc, 0x74, 0x23, 0xbc, 0x01, 0xf2, 0x01, 0xee, 0x63, 0x5a,
-	0x21, 0xd6, 0x77, 0xd2, 0x07, 0xf4, 0x5d, 0x4e, 0x20, 0x59, 0xe7, 0x6d, 0x82, 0x85, 0xc7, 0x98,
-	0xf4, 0x76, 0xa3, 0x13, 0x96, 0x44, 0xa0, 0xd7, 0x7e, 0x48, 0x82, 0xbc, 0x08, 0xf2, 0xeb, 0x01,
-	0x1e, 0x60, 0x78, 0xfe, 0xf0, 0x96, 0xb0, 0x0f, 0xaf, 0x9d, 0xac, 0x90, 0xb4, 0xd0, 0xcc, 0x25,
-	0x92, 0x3c, 0x76, 0x89, 0x3c, 0x02, 0xb9, 0xd8, 0x58, 0x64, 0xb5, 0xfd, 0xb8, 0xdd, 0x00, 0x8e,
-	0x86, 0xeb, 0xb1, 0x6c, 0xa6, 0x4f, 0x9a, 0xcd, 0x12, 0xc8, 0x88, 0x25, 0x62, 0x2c, 0xc8, 0x18,
-	0x87, 0xeb, 0xb5, 0xef, 0x24, 0xb0, 0x58, 0xef, 0xf7, 0xfd, 0xc7, 0x18, 0x69, 0x04, 0x21, 0x1c,
-	0xc0, 0x02, 0x58, 0xe8, 0xb0, 0x2f, 0x96, 0x9e, 0xac, 0x21, 0x56, 0xd0, 0x02, 0x4b, 0x94, 0x84,
-	0xb4, 0x5b, 0x1c, 0xd7, 0x1f, 0x78, 0x27, 0xa9, 0x4d, 0xcb, 0x8b, 0x28, 0xb5, 0x0f, 0x34, 0x82,
-	0xea, 0xcc, 0x87, 0x20, 0xd3, 0x1f, 0x49, 0x90, 0xd2, 0x08, 0x7a, 0x57, 0x79, 0x8e, 0x42, 0x4b,
-	0x4e, 0x85, 0xc6, 0x1f, 0x11, 0xa9, 0xc3, 0x47, 0xc4, 0x86, 0x78, 0x44, 0xa4, 0xd9, 0xcc, 0xad,
-	0xbc, 0x75, 0x3c, 0x10, 0x14, 0x7b, 0x40, 0x34, 0xc1, 0x29, 0xde, 0xb1, 0x27, 0x6b, 0x14, 0x0e,
-	0x86, 0x0f, 0x40, 0x9a, 0x51, 0x63, 0xe1, 0xa3, 0x53, 0x83, 0xf9, 0xa5, 0x19, 0x22, 0xa1, 0x2d,
-	0xa6, 0x03, 0x7b, 0x05, 0x64, 0x8c, 0x2c, 0x09, 0x6f, 0x73, 0x01, 0x4f, 0xe7, 0xc5, 0x5f, 0x25,
-	0x90, 0x8b, 0xbd, 0x90, 0xe0, 0x65, 0x50, 0xac, 0xdf, 0x69, 0x58, 0xad, 0xad, 0xb6, 0x6d, 0xed,
-	0x6c, 0xeb, 0xf6, 0x9d, 0xb6, 0xb9, 0xad, 0x37, 0x5a, 0x9b, 0x2d, 0xbd, 0x29, 0x27, 0x4a, 0x70,
-	0x34, 0x56, 0x96, 0x62, 0xe6, 0x6d, 0xd2, 0x87, 0xd7, 0x66, 0x10, 0x9b, 0xad, 0x6f, 0xf4, 0xa6,
-	0xbd, 0x6d, 0xb4, 0x1a, 0xba, 0x2c, 0x95, 0xce, 0x8d, 0xc6, 0xca, 0x6a, 0x0c, 0x71, 0x74, 0x21,
-	0xd1, 0x51, 0x35, 0x05, 0xd4, 0xea, 0x56, 0xe3, 0xa6, 0x9c, 0x2c, 0xad, 0x8c, 0xc6, 0x8a, 0x1c,
-	0x83, 0xb0, 0xb1, 0x5e, 0x4a, 0x3f, 0xf9, 0xb9, 0x9c, 0xb8, 0xf8, 0x2a, 0x09, 0x16, 0xa7, 0xee,
-	0x3f, 0x78, 0x15, 0x94, 0x26, 0x5e, 0x4c, 0xab, 0x6e, 0xdd, 0x31, 0x67, 0x42, 0x8e, 0x7b, 0xe3,
-	0x10, 0x1a, 0xf4, 0x55, 0x50, 0x98, 0x41, 0x99, 0x56, 0xbd, 0xdd, 0xd4, 0x76, 0x64, 0xa9, 0x54,
-	0x1c, 0x8d, 0x95, 0x95, 0x29, 0x84, 0x19, 0x39, 0x1e, 0xd2, 0x86, 0xf3, 0x51, 0x86, 0xa5, 0x37,
-	0xe5, 0xe4, 0x7c, 0x54, 0x10, 0x61, 0x34, 0x07, 0x75, 0x57, 0x37, 0xad, 0x56, 0xfb, 0x86, 0x9c,
-	0x9a, 0x83, 0x12, 0x33, 0x88, 0x3e, 0x95, 0x66, 0x50, 0x9b, 0xad, 0x76, 0xcb, 0xbc, 0xa9, 0x37,
-	0xe5, 0xf4, 0x54, 0x56, 0x39, 0x6c, 0x93, 0x78, 0x24, 0xdc, 0xc5, 0x08, 0x7e, 0x79, 0x54, 0x0e,
-	0x81, 0x6b, 0xd4, 0xdb, 0x0d, 0xfd, 0xd6, 0x2d, 0xbd, 0x29, 0x9f, 0x2a, 0x95, 0x46, 0x63, 0xa5,
-	0x30, 0x05, 0x6c, 0x38, 0x5e, 0x17, 0xf7, 0xfb, 0x18, 0x89, 0x0c, 0x3f, 0x97, 0xc0, 0x69, 0xc1,
-	0x76, 0xb8, 0x0e, 0x56, 0xb4, 0x56, 0x73, 0x1e, 0x11, 0x96, 0x46, 0x63, 0x05, 0x08, 0x33, 0x9a,
-	0xcf, 0x6a, 0xcc, 0x72, 0x9a, 0x00, 0xab, 0xa3, 0xb1, 0xb2, 0x2c, 0x2c, 0x63, 0xc5, 0x8f, 0x03,
-	0x58, 0xe1, 0xed, 0x7b, 0x5b, 0x86, 0x45, 0xcb, 0x1f, 0x07, 0xb0, 0xd2, 0xdf, 0xf3, 0x83, 0x68,
-	0x17, 0x5e, 0x02, 0x67, 0x67, 0x00, 0xb7, 0xeb, 0xed, 0x1d, 0x39, 0xc5, 0x0b, 0x1c, 0xb7, 0xbf,
-	0xed, 0x78, 0x43, 0x71, 0x98, 0x21, 0xc8, 0x89, 0x27, 0x24, 0x3b, 0xcf, 0x15, 0xb0, 0x5a, 0x6f,
-	0x36, 0x0d, 0xdd, 0x34, 0xb9, 0x9f, 0x8d, 0x9a, 0xad, 0xed, 0x58, 0xba, 0x29, 0x27, 0x4a, 0x85,
-	0xd1, 0x58, 0x81, 0x31, 0xdb, 0x8d, 0x9a, 0x36, 0x8c, 0x70, 0x78, 0x0c, 0x52, 0xbb, 0x2c, 0x20,
-	0xd2, 0x31, 0x48, 0xed, 0x32, 0x83, 0xf0, 0xad, 0xb5, 0xad, 0x67, 0xaf, 0xcb, 0xd2, 0x8b, 0xd7,
-	0x65, 0xe9, 0xef, 0xd7, 0x65, 0xe9, 0xe9, 0x9b, 0x72, 0xe2, 0xc5, 0x9b, 0x72, 0xe2, 0xcf, 0x37,
-	0xe5, 0xc4, 0xfd, 0xcf, 0x63, 0x6d, 0x7c, 0x34, 0x6e, 0xe2, 0x7f, 0xc5, 0xaa, 0x07, 0x53, 0x2b,
-	0xd6, 0xd9, 0x9d, 0x05, 0x36, 0xc5, 0x37, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xa0, 0x8c,
-	0x4e, 0xc0, 0x0d, 0x00, 0x00,
+func (m *BidCommitment) Reset()         { *m = BidCommitment{} }
+func (m *BidCommitment) String() string { return proto.CompactTextString(m) }
+func (*BidCommitment) ProtoMessage()    {}
+func (*BidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{12}
 }
-
-func (m *BaseAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidCommitment.Merge(m, src)
+}
+func (m *BidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidCommitment.DiscardUnknown(m)
 }
 
-func (m *BaseAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BidCommitment proto.InternalMessageInfo
+
+// PriceLevel defines the cumulative demand of the bids at a bid price of the
+// batch auction. It is maintained along with the price index of the bids so
+// that the matching price can be found without loading all bids.
+type PriceLevel struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// price specifies the bid price of the level
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// many_amount specifies the total selling coin amount of the
+	// how-many-coins bids at the price
+	ManyAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=many_amount,json=manyAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"many_amount"`
+	// worth_coins specifies the total bid coins of the how-much-worth bids at
+	// the price
+	WorthCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=worth_coins,json=worthCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"worth_coins"`
+	// bids_count specifies the number of the bids at the price
+	BidsCount uint64 `protobuf:"varint,5,opt,name=bids_count,json=bidsCount,proto3" json:"bids_count,omitempty"`
 }
 
-func (m *BaseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintFundraising(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.EndTimes) > 0 {
-		for iNdEx := len(m.EndTimes) - 1; iNdEx >= 0; iNdEx-- {
-			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTimes[iNdEx]):])
-			if err != nil {
-				return 0, err
-			}
-			i -= n
-			i = encodeVarintFundraising(dAtA, i, uint64(n))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintFundraising(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x5a
-	if len(m.VestingSchedules) > 0 {
-		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFundraising(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.VestingReserveAddress) > 0 {
-		i -= len(m.VestingReserveAddress)
-		copy(dAtA[i:], m.VestingReserveAddress)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.VestingReserveAddress)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.PayingCoinDenom) > 0 {
-		i -= len(m.PayingCoinDenom)
-		copy(dAtA[i:], m.PayingCoinDenom)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.PayingCoinDenom)))
-		i--
-		dAtA[i] = 0x42
-	}
-	{
-		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{13}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.StartPrice.Size()
-		i -= size
-		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.PayingReserveAddress) > 0 {
-		i -= len(m.PayingReserveAddress)
-		copy(dAtA[i:], m.PayingReserveAddress)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.PayingReserveAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SellingReserveAddress) > 0 {
-		i -= len(m.SellingReserveAddress)
-		copy(dAtA[i:], m.SellingReserveAddress)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.SellingReserveAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Type != 0 {
-		i = encodeVarintFundraising(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintFundraising(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *FixedPriceAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
 }
 
-func (m *FixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+// BidderAggregate defines the running totals of the bids that a bidder placed
+// for an auction. It is updated whenever the bids are placed, modified or
+// cancelled, so that the cumulative bid amount of the bidder can be validated
+// without iterating all their bids.
+type BidderAggregate struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// reserved_amount specifies the total paying amount reserved for the bids,
+	// converted to the paying coin denom
+	ReservedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=reserved_amount,json=reservedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserved_amount"`
+	// selling_amount specifies the total selling coin amount that the bids
+	// request at their bid prices
+	SellingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=selling_amount,json=sellingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"selling_amount"`
+	// bids_count specifies the number of the bids
+	BidsCount uint64 `protobuf:"varint,5,opt,name=bids_count,json=bidsCount,proto3" json:"bids_count,omitempty"`
+	// reserved_coins specifies the paying coins reserved for the bids in their
+	// denoms
+	ReservedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=reserved_coins,json=reservedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_coins"`
 }
 
-func (m *FixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RemainingSellingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *BidderAggregate) Reset()         { *m = BidderAggregate{} }
+func (m *BidderAggregate) String() string { return proto.CompactTextString(m) }
+func (*BidderAggregate) ProtoMessage()    {}
+func (*BidderAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{14}
+}
+func (m *BidderAggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidderAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidderAggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.BaseAuction != nil {
-		{
-			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFundraising(dAtA, i, uint64(size))
+}
+func (m *BidderAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidderAggregate.Merge(m, src)
+}
+func (m *BidderAggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidderAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidderAggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidderAggregate proto.InternalMessageInfo
+
+// OrderBookPriceLevel defines the aggregated bids of the batch auction at a
+// price level.
+type OrderBookPriceLevel struct {
+	// price specifies the price of the level
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// bids_count specifies the number of bids at the level
+	BidsCount uint64 `protobuf:"varint,2,opt,name=bids_count,json=bidsCount,proto3" json:"bids_count,omitempty"`
+	// worth_amount specifies the paying coin amount of the BidTypeBatchWorth
+	// bids at the level in the paying coin denom
+	WorthAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=worth_amount,json=worthAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"worth_amount"`
+	// many_amount specifies the selling coin amount of the BidTypeBatchMany bids
+	// at the level
+	ManyAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=many_amount,json=manyAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"many_amount"`
+	// demand_amount specifies the selling coin amount that the bids at the level
+	// demand at the price of the level
+	DemandAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=demand_amount,json=demandAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"demand_amount"`
+	// cumulative_demand_amount specifies the selling coin amount that all the
+	// bids with the price higher than or equal to the price of the level demand
+	// at the price of the level
+	CumulativeDemandAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=cumulative_demand_amount,json=cumulativeDemandAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_demand_amount"`
+}
+
+func (m *OrderBookPriceLevel) Reset()         { *m = OrderBookPriceLevel{} }
+func (m *OrderBookPriceLevel) String() string { return proto.CompactTextString(m) }
+func (*OrderBookPriceLevel) ProtoMessage()    {}
+func (*OrderBookPriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{15}
+}
+func (m *OrderBookPriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookPriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookPriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrderBookPriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookPriceLevel.Merge(m, src)
+}
+func (m *OrderBookPriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookPriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookPriceLevel.DiscardUnknown(m)
 }
 
-func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrderBookPriceLevel proto.InternalMessageInfo
+
+// AuctionSettlement defines the result of the auction that is recorded when
+// the auction is closed.
+type AuctionSettlement struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// matched_price specifies the final price that the selling coin is sold at
+	MatchedPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=matched_price,json=matchedPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"matched_price"`
+	// total_sold_amount specifies the total amount of selling coin that is sold
+	TotalSoldAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_sold_amount,json=totalSoldAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_sold_amount"`
+	// total_raised_amount specifies the total amount of paying coin that is paid
+	// by the winning bidders
+	TotalRaisedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_raised_amount,json=totalRaisedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_raised_amount"`
+	// total_refunded_amount specifies the total amount of paying coin that is
+	// refunded to the bidders
+	TotalRefundedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_refunded_amount,json=totalRefundedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_refunded_amount"`
+	// winners_count specifies the number of bidders who are allocated the selling
+	// coin
+	WinnersCount uint64 `protobuf:"varint,6,opt,name=winners_count,json=winnersCount,proto3" json:"winners_count,omitempty"`
+	// close_height specifies the block height that the auction is closed at
+	CloseHeight int64 `protobuf:"varint,7,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
+	// close_time specifies the block time that the auction is closed at
+	CloseTime time.Time `protobuf:"bytes,8,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time"`
+	// extended_rounds specifies the number of extended rounds of the auction
+	ExtendedRounds uint32 `protobuf:"varint,9,opt,name=extended_rounds,json=extendedRounds,proto3" json:"extended_rounds,omitempty"`
+}
+
+func (m *AuctionSettlement) Reset()         { *m = AuctionSettlement{} }
+func (m *AuctionSettlement) String() string { return proto.CompactTextString(m) }
+func (*AuctionSettlement) ProtoMessage()    {}
+func (*AuctionSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{16}
+}
+func (m *AuctionSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AuctionSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionSettlement.Merge(m, src)
+}
+func (m *AuctionSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionSettlement.DiscardUnknown(m)
 }
 
-func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AuctionSettlement proto.InternalMessageInfo
+
+// BidderSettlement defines the result of the auction for a bidder that is
+// recorded when the auction is closed.
+type BidderSettlement struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// allocated_amount specifies the amount of selling coin that is allocated to
+	// the bidder
+	AllocatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=allocated_amount,json=allocatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allocated_amount"`
+	// paid_amount specifies the amount of paying coin that the bidder paid for
+	// the allocated selling coin
+	PaidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=paid_amount,json=paidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"paid_amount"`
+	// refunded_amount specifies the amount of paying coin that is refunded to
+	// the bidder
+	RefundedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=refunded_amount,json=refundedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refunded_amount"`
 }
 
-func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExtendedRoundRate.Size()
-		i -= size
-		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *BidderSettlement) Reset()         { *m = BidderSettlement{} }
+func (m *BidderSettlement) String() string { return proto.CompactTextString(m) }
+func (*BidderSettlement) ProtoMessage()    {}
+func (*BidderSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{17}
+}
+func (m *BidderSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidderSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidderSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.MaxExtendedRound != 0 {
-		i = encodeVarintFundraising(dAtA, i, uint64(m.MaxExtendedRound))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.MatchedPrice.Size()
-		i -= size
-		if _, err := m.MatchedPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MinBidPrice.Size()
-		i -= size
-		if _, err := m.MinBidPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *BidderSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidderSettlement.Merge(m, src)
+}
+func (m *BidderSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidderSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidderSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidderSettlement proto.InternalMessageInfo
+
+// SettlementCursor defines the progress of the settlement of the auction that
+// pays out the allocations and refunds to the bidders over multiple blocks.
+type SettlementCursor struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// last_bidder specifies the bech32-encoded address of the last bidder that is
+	// settled; empty means that no bidder is settled yet
+	LastBidder string `protobuf:"bytes,2,opt,name=last_bidder,json=lastBidder,proto3" json:"last_bidder,omitempty"`
+	// settled_bidders specifies the number of bidders that are settled so far
+	SettledBidders uint64 `protobuf:"varint,3,opt,name=settled_bidders,json=settledBidders,proto3" json:"settled_bidders,omitempty"`
+}
+
+func (m *SettlementCursor) Reset()         { *m = SettlementCursor{} }
+func (m *SettlementCursor) String() string { return proto.CompactTextString(m) }
+func (*SettlementCursor) ProtoMessage()    {}
+func (*SettlementCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{18}
+}
+func (m *SettlementCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.BaseAuction != nil {
-		{
-			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFundraising(dAtA, i, uint64(size))
+}
+func (m *SettlementCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementCursor.Merge(m, src)
+}
+func (m *SettlementCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementCursor proto.InternalMessageInfo
+
+// AllocationClaim defines the coins that a bidder can claim from the claim
+// reserve account of the auction in claim mode.
+type AllocationClaim struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address of the bidder
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// allocated_coins specifies the selling coin and the basket coins that are
+	// allocated to the bidder
+	AllocatedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=allocated_coins,json=allocatedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allocated_coins"`
+	// refund_coins specifies the paying coins that are refunded to the bidder
+	RefundCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=refund_coins,json=refundCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund_coins"`
+	// claimed specifies whether the bidder already claimed the coins
+	Claimed bool `protobuf:"varint,5,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *AllocationClaim) Reset()         { *m = AllocationClaim{} }
+func (m *AllocationClaim) String() string { return proto.CompactTextString(m) }
+func (*AllocationClaim) ProtoMessage()    {}
+func (*AllocationClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{19}
+}
+func (m *AllocationClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AllocationClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationClaim.Merge(m, src)
+}
+func (m *AllocationClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationClaim.DiscardUnknown(m)
 }
 
-func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllocationClaim proto.InternalMessageInfo
+
+func (m *AllocationClaim) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllocationClaim) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
+func (m *AllocationClaim) GetAllocatedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AllocatedCoins
 	}
-	i--
-	dAtA[i] = 0x12
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
-	if err6 != nil {
-		return 0, err6
+	return nil
+}
+
+func (m *AllocationClaim) GetRefundCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundCoins
 	}
-	i -= n6
-	i = encodeVarintFundraising(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *VestingQueue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AllocationClaim) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *VestingQueue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AuctionFailure defines the record of the auction whose execution failed at
+// the end of the block.
+type AuctionFailure struct {
+	// auction_id specifies the id of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// failed_status specifies the status of the auction when the execution
+	// failed
+	FailedStatus AuctionStatus `protobuf:"varint,2,opt,name=failed_status,json=failedStatus,proto3,enum=tendermint.fundraising.AuctionStatus" json:"failed_status,omitempty"`
+	// reason specifies the error that the execution failed with
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// fail_height specifies the block height that the execution failed at
+	FailHeight int64 `protobuf:"varint,4,opt,name=fail_height,json=failHeight,proto3" json:"fail_height,omitempty"`
+	// fail_time specifies the block time that the execution failed at
+	FailTime time.Time `protobuf:"bytes,5,opt,name=fail_time,json=failTime,proto3,stdtime" json:"fail_time"`
 }
 
-func (m *VestingQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Released {
-		i--
-		if m.Released {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintFundraising(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.PayingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *AuctionFailure) Reset()         { *m = AuctionFailure{} }
+func (m *AuctionFailure) String() string { return proto.CompactTextString(m) }
+func (*AuctionFailure) ProtoMessage()    {}
+func (*AuctionFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a97a388085f27061, []int{20}
+}
+func (m *AuctionFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AllowedBidder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AuctionFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionFailure.Merge(m, src)
+}
+func (m *AuctionFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionFailure.DiscardUnknown(m)
 }
 
-func (m *AllowedBidder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AuctionFailure proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("tendermint.fundraising.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("tendermint.fundraising.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
+	proto.RegisterEnum("tendermint.fundraising.BidType", BidType_name, BidType_value)
+	proto.RegisterEnum("tendermint.fundraising.PartialFillMode", PartialFillMode_name, PartialFillMode_value)
+	proto.RegisterEnum("tendermint.fundraising.PricingRule", PricingRule_name, PricingRule_value)
+	proto.RegisterEnum("tendermint.fundraising.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterType((*BaseAuction)(nil), "tendermint.fundraising.BaseAuction")
+	proto.RegisterType((*FixedPriceAuction)(nil), "tendermint.fundraising.FixedPriceAuction")
+	proto.RegisterType((*BatchAuction)(nil), "tendermint.fundraising.BatchAuction")
+	proto.RegisterType((*DutchAuction)(nil), "tendermint.fundraising.DutchAuction")
+	proto.RegisterType((*VestingSchedule)(nil), "tendermint.fundraising.VestingSchedule")
+	proto.RegisterType((*LinearVestingSchedule)(nil), "tendermint.fundraising.LinearVestingSchedule")
+	proto.RegisterType((*SealedBidConfig)(nil), "tendermint.fundraising.SealedBidConfig")
+	proto.RegisterType((*LinearVesting)(nil), "tendermint.fundraising.LinearVesting")
+	proto.RegisterType((*VestingQueue)(nil), "tendermint.fundraising.VestingQueue")
+	proto.RegisterType((*BidderVestingQueue)(nil), "tendermint.fundraising.BidderVestingQueue")
+	proto.RegisterType((*AllowedBidder)(nil), "tendermint.fundraising.AllowedBidder")
+	proto.RegisterType((*Bid)(nil), "tendermint.fundraising.Bid")
+	proto.RegisterType((*BidCommitment)(nil), "tendermint.fundraising.BidCommitment")
+	proto.RegisterType((*PriceLevel)(nil), "tendermint.fundraising.PriceLevel")
+	proto.RegisterType((*BidderAggregate)(nil), "tendermint.fundraising.BidderAggregate")
+	proto.RegisterType((*OrderBookPriceLevel)(nil), "tendermint.fundraising.OrderBookPriceLevel")
+	proto.RegisterType((*AuctionSettlement)(nil), "tendermint.fundraising.AuctionSettlement")
+	proto.RegisterType((*BidderSettlement)(nil), "tendermint.fundraising.BidderSettlement")
+	proto.RegisterType((*SettlementCursor)(nil), "tendermint.fundraising.SettlementCursor")
+	proto.RegisterType((*AllocationClaim)(nil), "tendermint.fundraising.AllocationClaim")
+	proto.RegisterType((*AuctionFailure)(nil), "tendermint.fundraising.AuctionFailure")
 }
 
-func (m *AllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxBidAmount.Size()
-		i -= size
-		if _, err := m.MaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("fundraising/fundraising.proto", fileDescriptor_a97a388085f27061) }
+
+var fileDescriptor_a97a388085f27061 = []byte{
+	// 2808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x6f, 0xe3, 0xd6,
+	0xf5, 0x37, 0x25, 0xd9, 0x96, 0x8f, 0x5e, 0x34, 0xfd, 0x18, 0x46, 0xff, 0x44, 0x56, 0x9c, 0x7f,
+	0x3b, 0x46, 0xda, 0x91, 0x27, 0x9e, 0x69, 0xd2, 0x06, 0x28, 0x5a, 0x51, 0x92, 0x33, 0x2a, 0xfc,
+	0x50, 0x28, 0x39, 0x13, 0x67, 0x11, 0x82, 0x16, 0xaf, 0xe5, 0x8b, 0xe1, 0x43, 0x20, 0x29, 0xcf,
+	0x78, 0x51, 0xb4, 0x45, 0x37, 0x81, 0xbb, 0x68, 0xba, 0x6b, 0x17, 0x46, 0x8b, 0x74, 0xd7, 0x45,
+	0x57, 0xfd, 0x06, 0xd9, 0x04, 0x45, 0x17, 0x59, 0xa4, 0x40, 0x91, 0xc5, 0xa4, 0x98, 0xf9, 0x02,
+	0xfd, 0x02, 0x05, 0x8a, 0xfb, 0xa0, 0x44, 0xd1, 0xf2, 0x8c, 0x2d, 0xd9, 0x59, 0x59, 0x3c, 0xf7,
+	0xfc, 0x7e, 0x87, 0xf7, 0x9c, 0x73, 0xcf, 0x3d, 0xf7, 0xd2, 0xf0, 0xda, 0x61, 0xcf, 0x36, 0x5c,
+	0x1d, 0x7b, 0xd8, 0xee, 0xac, 0x87, 0x7e, 0x97, 0xba, 0xae, 0xe3, 0x3b, 0xd2, 0xb2, 0x8f, 0x6c,
+	0x03, 0xb9, 0x16, 0xb6, 0xfd, 0x52, 0x68, 0x34, 0x5f, 0x68, 0x3b, 0x9e, 0xe5, 0x78, 0xeb, 0x07,
+	0xba, 0x87, 0xd6, 0x8f, 0xdf, 0x3a, 0x40, 0xbe, 0xfe, 0xd6, 0x7a, 0xdb, 0xc1, 0x36, 0xc3, 0xe5,
+	0x5f, 0x61, 0xe3, 0x1a, 0x7d, 0x5a, 0x67, 0x0f, 0x7c, 0x68, 0xb1, 0xe3, 0x74, 0x1c, 0x26, 0x27,
+	0xbf, 0xb8, 0xb4, 0xd0, 0x71, 0x9c, 0x8e, 0x89, 0xd6, 0xe9, 0xd3, 0x41, 0xef, 0x70, 0xdd, 0xe8,
+	0xb9, 0xba, 0x8f, 0x9d, 0x80, 0x70, 0x25, 0x3a, 0xee, 0x63, 0x0b, 0x79, 0xbe, 0x6e, 0x75, 0x99,
+	0xc2, 0xea, 0xe7, 0x69, 0x48, 0x29, 0xba, 0x87, 0xca, 0xbd, 0x36, 0x81, 0x49, 0x59, 0x88, 0x61,
+	0x43, 0x16, 0x8a, 0xc2, 0x5a, 0x42, 0x8d, 0x61, 0x43, 0x7a, 0x07, 0x12, 0xfe, 0x49, 0x17, 0xc9,
+	0xb1, 0xa2, 0xb0, 0x96, 0xdd, 0x78, 0xa3, 0x34, 0x7a, 0x62, 0x25, 0x0e, 0x6f, 0x9d, 0x74, 0x91,
+	0x4a, 0x01, 0x52, 0x01, 0x40, 0x67, 0x42, 0x84, 0x5c, 0x39, 0x5e, 0x14, 0xd6, 0xe6, 0xd4, 0x90,
+	0x44, 0x7a, 0x1b, 0x6e, 0x79, 0xc8, 0x34, 0xb1, 0xdd, 0xd1, 0x5c, 0xe4, 0x21, 0xf7, 0x18, 0x69,
+	0xba, 0x61, 0xb8, 0xc8, 0xf3, 0xe4, 0x04, 0x55, 0x5e, 0xe2, 0xc3, 0x2a, 0x1b, 0x2d, 0xb3, 0x41,
+	0xe9, 0x3e, 0x2c, 0x77, 0xf5, 0x93, 0x51, 0xb0, 0x69, 0x0a, 0x5b, 0x64, 0xa3, 0x11, 0xd4, 0x2e,
+	0xa4, 0x3c, 0x5f, 0x77, 0x7d, 0xad, 0xeb, 0xe2, 0x36, 0x92, 0x67, 0x88, 0xaa, 0x52, 0xfa, 0xe2,
+	0xe9, 0xca, 0xd4, 0xd7, 0x4f, 0x57, 0xbe, 0xdb, 0xc1, 0xfe, 0x51, 0xef, 0xa0, 0xd4, 0x76, 0x2c,
+	0xee, 0x73, 0xfe, 0xe7, 0x8e, 0x67, 0x3c, 0x5a, 0x27, 0xb3, 0xf1, 0x4a, 0x55, 0xd4, 0x56, 0x81,
+	0x52, 0x34, 0x08, 0x83, 0x64, 0x41, 0x3a, 0x78, 0x7d, 0x12, 0x3f, 0x79, 0xb6, 0x28, 0xac, 0xa5,
+	0x36, 0x5e, 0x29, 0xf1, 0x98, 0x91, 0x00, 0x97, 0x78, 0x80, 0x4b, 0x15, 0x07, 0xdb, 0xca, 0x3a,
+	0x31, 0xf6, 0x97, 0x6f, 0x56, 0x6e, 0x5f, 0xc2, 0x18, 0x01, 0xa8, 0x29, 0xce, 0x4f, 0x1e, 0xa4,
+	0x37, 0x61, 0x9e, 0xcf, 0x9a, 0x58, 0xd3, 0x0c, 0x64, 0x3b, 0x96, 0x9c, 0xa4, 0x13, 0xce, 0xb1,
+	0x01, 0xa2, 0x56, 0x25, 0x62, 0xe2, 0xd9, 0x63, 0xe4, 0xf9, 0xa3, 0x5c, 0x34, 0xc7, 0x3c, 0xcb,
+	0x87, 0x23, 0x3e, 0xfa, 0x08, 0xe6, 0x03, 0x9c, 0xd7, 0x3e, 0x42, 0x46, 0xcf, 0x44, 0x9e, 0x0c,
+	0xc5, 0xf8, 0x5a, 0x6a, 0xe3, 0xf6, 0x45, 0x71, 0xff, 0x80, 0x01, 0x9a, 0x5c, 0x5f, 0x49, 0x90,
+	0x59, 0xaa, 0xe2, 0xf1, 0xb0, 0xd8, 0x93, 0x2a, 0xc0, 0x9c, 0xa7, 0x91, 0xfc, 0x93, 0x53, 0xd4,
+	0x59, 0xf9, 0x12, 0x4b, 0xce, 0x52, 0x90, 0x9c, 0xa5, 0x56, 0x90, 0x9c, 0x4a, 0x92, 0xf0, 0x7c,
+	0xfa, 0xcd, 0x8a, 0xa0, 0xce, 0x51, 0x1c, 0x19, 0x91, 0xca, 0x30, 0x87, 0x6c, 0x83, 0x52, 0x78,
+	0x72, 0xba, 0x18, 0xbf, 0x34, 0x47, 0x12, 0xd9, 0x06, 0x95, 0x4b, 0x3f, 0x86, 0x19, 0xcf, 0xd7,
+	0xfd, 0x9e, 0x27, 0x67, 0x68, 0x42, 0x7f, 0xe7, 0x25, 0x09, 0xdd, 0xa4, 0xca, 0x2a, 0x07, 0x49,
+	0x3f, 0x85, 0x57, 0x07, 0x29, 0xac, 0x59, 0xba, 0xad, 0x77, 0x90, 0xa1, 0xe9, 0xa6, 0xe9, 0x3c,
+	0x36, 0xb1, 0xe7, 0xcb, 0xd9, 0xa2, 0xb0, 0x96, 0x54, 0xf3, 0x03, 0x9d, 0x6d, 0xa6, 0x52, 0x0e,
+	0x34, 0xa4, 0xd7, 0x21, 0xed, 0x74, 0x91, 0xad, 0x1d, 0x60, 0xc3, 0xc0, 0x76, 0x47, 0xce, 0x51,
+	0x44, 0x8a, 0xc8, 0x14, 0x26, 0x92, 0xda, 0xb0, 0x6c, 0xa0, 0x43, 0xbd, 0x67, 0xfa, 0x9a, 0xa5,
+	0x3f, 0x21, 0x9a, 0x9a, 0x6e, 0x39, 0x3d, 0xdb, 0x97, 0xc5, 0x2b, 0xa7, 0x6d, 0xdd, 0xf6, 0xd5,
+	0x05, 0xce, 0xb6, 0xad, 0x3f, 0x51, 0xb0, 0x51, 0xa6, 0x54, 0x92, 0x0b, 0xd9, 0x20, 0x7f, 0x0f,
+	0x74, 0xef, 0x11, 0xf2, 0xe5, 0xf9, 0x62, 0xfc, 0xc5, 0x19, 0x7c, 0x97, 0x67, 0xf0, 0xda, 0x25,
+	0x33, 0xd8, 0x53, 0x33, 0xdc, 0x84, 0x42, 0x2d, 0x48, 0x3f, 0x1f, 0x4e, 0x62, 0x57, 0xf7, 0x91,
+	0x27, 0x4b, 0xd4, 0xec, 0xab, 0x23, 0xcd, 0x56, 0x51, 0x9b, 0x5a, 0xbe, 0xc7, 0x2d, 0x7f, 0xef,
+	0x72, 0x0b, 0x95, 0x19, 0x0f, 0xad, 0x0b, 0x95, 0x58, 0x92, 0x3e, 0x04, 0xd1, 0xa2, 0x66, 0xb1,
+	0x87, 0x02, 0x8f, 0x2e, 0x8c, 0xe5, 0xd1, 0xac, 0x45, 0x38, 0xb1, 0x87, 0xb8, 0x33, 0x3b, 0x20,
+	0x93, 0x78, 0x22, 0x57, 0x3b, 0xbf, 0x80, 0x16, 0xc7, 0x59, 0x40, 0xcb, 0x8c, 0xee, 0x83, 0xe8,
+	0x32, 0x42, 0x70, 0xcb, 0xc4, 0x36, 0xd2, 0xcf, 0x1b, 0x92, 0x97, 0xe8, 0x9a, 0xba, 0x73, 0x91,
+	0x9d, 0x2d, 0x0a, 0x8b, 0x10, 0xaa, 0x4b, 0xe6, 0x28, 0xb1, 0xf4, 0x1a, 0x40, 0xdb, 0xd4, 0xb1,
+	0xa5, 0x59, 0x8e, 0x81, 0xe4, 0x65, 0x9a, 0xa2, 0x73, 0x54, 0xb2, 0xed, 0x18, 0xe8, 0x5d, 0xf1,
+	0x93, 0x3f, 0xad, 0x4c, 0xfd, 0xfd, 0x6f, 0x77, 0x92, 0x7c, 0x91, 0xd4, 0x57, 0xff, 0x10, 0x83,
+	0xf9, 0x4d, 0xfc, 0x04, 0x19, 0xb4, 0x38, 0x72, 0xb1, 0xb4, 0x05, 0x69, 0x12, 0x4e, 0x8d, 0x2f,
+	0x07, 0xba, 0xab, 0xa4, 0x2e, 0xde, 0x43, 0x42, 0xdb, 0x90, 0x92, 0xf8, 0xf2, 0xe9, 0x8a, 0xa0,
+	0xa6, 0x0e, 0x06, 0x22, 0xe9, 0x97, 0x02, 0x2c, 0xbb, 0xc8, 0xd2, 0xb1, 0x4d, 0xe7, 0x1d, 0x2e,
+	0xbe, 0xb1, 0x6b, 0x2f, 0xbe, 0x8b, 0x7d, 0x4b, 0xcd, 0x50, 0x15, 0xbe, 0x03, 0x0b, 0x6d, 0xd3,
+	0xf1, 0x90, 0xf6, 0xf8, 0x08, 0xd9, 0x9a, 0xe7, 0x98, 0x86, 0xe6, 0xf4, 0x7c, 0xba, 0xb9, 0x25,
+	0x55, 0x91, 0x0e, 0x3d, 0x3c, 0x42, 0x76, 0xd3, 0x31, 0x8d, 0xdd, 0x9e, 0xff, 0x6e, 0x82, 0xf8,
+	0x69, 0xf5, 0x37, 0xd3, 0x90, 0x56, 0x74, 0xbf, 0x7d, 0x74, 0x33, 0x6e, 0x51, 0x21, 0x43, 0xb2,
+	0x9a, 0x54, 0x09, 0xb6, 0xb7, 0xc5, 0xc6, 0xda, 0xdb, 0x52, 0x16, 0x26, 0x05, 0x88, 0x6d, 0x6e,
+	0x4d, 0xc8, 0x58, 0xe4, 0x8d, 0x51, 0xc0, 0x19, 0x1f, 0x8b, 0x33, 0xcd, 0x49, 0x18, 0xe9, 0xf7,
+	0x41, 0x22, 0xe5, 0x0c, 0x3d, 0xa1, 0xf3, 0x34, 0x34, 0xd7, 0xe9, 0xd9, 0x06, 0xdd, 0xeb, 0x33,
+	0xaa, 0x68, 0xe9, 0x4f, 0x6a, 0x7c, 0x40, 0x25, 0x72, 0xe9, 0x63, 0x58, 0x18, 0xd6, 0xa4, 0xe5,
+	0x42, 0x9e, 0x1e, 0xeb, 0x45, 0xe6, 0x51, 0x98, 0x9b, 0x54, 0x03, 0xa9, 0x09, 0xf3, 0x1e, 0xd2,
+	0x4d, 0x64, 0x50, 0xcf, 0xb5, 0x1d, 0xfb, 0x10, 0x77, 0x68, 0x5b, 0xf0, 0x82, 0xb5, 0xda, 0xa4,
+	0x00, 0x05, 0x1b, 0x15, 0xaa, 0xae, 0xe6, 0xbc, 0x61, 0x01, 0x21, 0xed, 0xea, 0xae, 0x8f, 0x75,
+	0x53, 0x3b, 0xc4, 0xa6, 0xc9, 0x96, 0xcf, 0x2c, 0xdd, 0x68, 0x2e, 0x24, 0x6d, 0x30, 0xc0, 0x26,
+	0x36, 0x4d, 0xb2, 0xb8, 0x48, 0xd9, 0x1a, 0x12, 0x48, 0x9b, 0x90, 0x26, 0x41, 0xa0, 0xdb, 0x39,
+	0x59, 0xe8, 0xc9, 0x17, 0x77, 0x62, 0x0d, 0xa6, 0xab, 0x92, 0xe5, 0x9d, 0xea, 0x0e, 0x1e, 0x78,
+	0x36, 0x3e, 0x8f, 0x43, 0xba, 0xda, 0xbb, 0xb1, 0x6c, 0xdc, 0x85, 0xd4, 0xa1, 0xe9, 0x38, 0xee,
+	0x44, 0xb9, 0x08, 0x94, 0x82, 0x65, 0xcd, 0x87, 0x20, 0x52, 0x2a, 0xcd, 0x40, 0x6d, 0xfd, 0x44,
+	0xf3, 0x7c, 0xd4, 0x1d, 0x33, 0x1b, 0xb3, 0x94, 0xa7, 0x4a, 0x68, 0x9a, 0x3e, 0xea, 0x4a, 0xef,
+	0x83, 0x14, 0x66, 0xee, 0x22, 0x17, 0x3b, 0x2c, 0x1f, 0x49, 0x29, 0x89, 0xb6, 0x15, 0x55, 0xde,
+	0x57, 0xb3, 0xae, 0xe2, 0xf7, 0xa4, 0xab, 0x10, 0x07, 0x84, 0x0d, 0x0a, 0x7e, 0x51, 0x89, 0x9a,
+	0xfe, 0x76, 0x4a, 0x14, 0x8f, 0xf2, 0x67, 0x02, 0xe4, 0xa2, 0x45, 0xfd, 0x3d, 0x48, 0xbb, 0xc8,
+	0x44, 0x24, 0xd6, 0xb4, 0x09, 0x13, 0xae, 0xd0, 0x84, 0xa5, 0x38, 0x92, 0x8c, 0x49, 0x9b, 0x30,
+	0xf3, 0x18, 0xe1, 0xce, 0x91, 0x3f, 0x66, 0x78, 0x39, 0x7a, 0xf5, 0x99, 0x00, 0x4b, 0x23, 0xb7,
+	0xa5, 0x48, 0xb7, 0x28, 0x8c, 0xd7, 0x2d, 0xfe, 0x04, 0x92, 0x41, 0xb7, 0x28, 0xc7, 0xae, 0x40,
+	0x31, 0xcb, 0x9b, 0x45, 0xf2, 0x16, 0x6d, 0x13, 0x1f, 0x1e, 0x32, 0x8a, 0xf8, 0x55, 0xde, 0x82,
+	0xe2, 0xc8, 0xc8, 0xea, 0xe7, 0x02, 0xe4, 0x22, 0x75, 0x43, 0x7a, 0x00, 0x19, 0x17, 0x1d, 0x23,
+	0xdd, 0x0c, 0x92, 0x4e, 0xb8, 0x7c, 0xd2, 0xa5, 0x19, 0x92, 0x27, 0xdc, 0x21, 0xdc, 0xea, 0xd9,
+	0x4c, 0x42, 0x6a, 0x35, 0xb2, 0x75, 0xd3, 0x3f, 0x61, 0x95, 0x72, 0xbc, 0xd8, 0x2c, 0x0d, 0xe8,
+	0x1a, 0x8c, 0x8d, 0x54, 0xcb, 0xd5, 0xbf, 0xc6, 0x20, 0x33, 0x14, 0x2a, 0xd2, 0x22, 0xf0, 0x8a,
+	0xa1, 0xf5, 0xcf, 0x8b, 0x73, 0x5c, 0x52, 0x37, 0x22, 0xa7, 0xbf, 0xd8, 0xb9, 0xd3, 0x9f, 0x09,
+	0x29, 0xdf, 0xf1, 0x75, 0x93, 0x2e, 0x0e, 0x4f, 0x8e, 0x5f, 0x7f, 0xef, 0x09, 0x94, 0x9f, 0xfe,
+	0x96, 0xba, 0x90, 0xa1, 0xdd, 0x0b, 0x32, 0xb8, 0xbd, 0xc4, 0xf5, 0xdb, 0x4b, 0x73, 0x0b, 0xf4,
+	0x69, 0xf5, 0x8f, 0x31, 0x48, 0x73, 0x57, 0xbd, 0xdf, 0x43, 0x3d, 0x34, 0xa9, 0xbf, 0x1e, 0x41,
+	0x2a, 0xd4, 0x3a, 0xf3, 0x64, 0xbc, 0xce, 0x6a, 0x02, 0x83, 0x6e, 0xf9, 0x5c, 0xa5, 0x48, 0x8c,
+	0x5b, 0x29, 0xf2, 0x90, 0xe4, 0x8f, 0x06, 0x2d, 0x80, 0x49, 0xb5, 0xff, 0xbc, 0xfa, 0x59, 0x0c,
+	0x24, 0x25, 0xdc, 0xe5, 0x5e, 0xca, 0x4f, 0xcb, 0x30, 0xc3, 0x5a, 0x63, 0xee, 0x23, 0xfe, 0x44,
+	0x22, 0x1c, 0xbc, 0xf2, 0x8d, 0x65, 0x54, 0xe0, 0x14, 0xfa, 0xf4, 0xed, 0x38, 0xe9, 0xd7, 0x02,
+	0x64, 0xe8, 0xd9, 0x91, 0x96, 0x0f, 0x32, 0xd1, 0x81, 0x03, 0x84, 0x21, 0x07, 0xb4, 0x20, 0x1b,
+	0x39, 0x2c, 0xc6, 0xc6, 0x3a, 0xda, 0xa4, 0xad, 0xd0, 0x29, 0x91, 0xef, 0x26, 0xff, 0x88, 0x41,
+	0x5c, 0xc1, 0xc6, 0xb8, 0xb1, 0x61, 0x57, 0x4a, 0xf1, 0xfe, 0x95, 0xd2, 0x3d, 0x7e, 0xa5, 0x94,
+	0xa0, 0x8d, 0xcc, 0xca, 0x85, 0x9d, 0x06, 0x36, 0x42, 0xd7, 0x49, 0x55, 0x98, 0x66, 0x2d, 0xc5,
+	0x78, 0x1d, 0x20, 0x03, 0x4b, 0x1f, 0x43, 0x82, 0xae, 0x9f, 0x99, 0x6b, 0x5f, 0x3f, 0x94, 0x97,
+	0x78, 0x08, 0x7b, 0x1a, 0x6f, 0x7b, 0x69, 0xe7, 0x97, 0x54, 0xe7, 0xb0, 0xb7, 0xcd, 0x04, 0xdc,
+	0x9d, 0xcf, 0x04, 0xc8, 0xd0, 0xcd, 0xc0, 0xb2, 0xb0, 0x6f, 0x21, 0xdb, 0x7f, 0x99, 0x63, 0x99,
+	0x03, 0x63, 0x7d, 0x07, 0x0e, 0x1c, 0x1d, 0x1f, 0x72, 0x74, 0x01, 0xa0, 0xdd, 0x27, 0xa5, 0xee,
+	0x4d, 0xab, 0x21, 0x89, 0x64, 0xc0, 0xac, 0x81, 0xba, 0x8e, 0x87, 0xfd, 0x1b, 0x68, 0x47, 0x02,
+	0x6a, 0x3e, 0xc9, 0x7f, 0xc6, 0x00, 0x68, 0x07, 0xb7, 0x85, 0x8e, 0x91, 0xf9, 0xb2, 0x19, 0xf6,
+	0xa3, 0x1b, 0x9b, 0x24, 0xba, 0xbb, 0x90, 0xb2, 0x74, 0xfb, 0x24, 0x58, 0x00, 0xf1, 0xb1, 0x16,
+	0x00, 0x10, 0x0a, 0x7e, 0xae, 0x37, 0x21, 0xf5, 0xd8, 0x71, 0xfd, 0xa3, 0x9b, 0xdb, 0x35, 0x80,
+	0xf2, 0xd3, 0xdf, 0xc4, 0x47, 0x07, 0xd8, 0xf0, 0xb4, 0x36, 0x7d, 0xfb, 0x69, 0xe6, 0x23, 0x22,
+	0xa9, 0x84, 0xd6, 0xe2, 0xef, 0xe2, 0x90, 0x63, 0xa5, 0xa0, 0xdc, 0xe9, 0xb8, 0xa8, 0x43, 0xce,
+	0x32, 0x63, 0xae, 0xcb, 0x87, 0x90, 0xe3, 0xf7, 0x83, 0xc6, 0x64, 0x2e, 0xcb, 0x06, 0x34, 0xdc,
+	0x6d, 0x7b, 0x83, 0xbb, 0x25, 0xce, 0x9b, 0x18, 0x8b, 0x37, 0xb8, 0x3e, 0xe2, 0xb4, 0x2f, 0xf6,
+	0x0f, 0xb9, 0xd1, 0xea, 0x4f, 0x87, 0xc5, 0x6b, 0xe6, 0x06, 0x6e, 0xb4, 0x02, 0x13, 0xf4, 0x71,
+	0x70, 0xa6, 0x5a, 0xd8, 0x75, 0x0d, 0xe4, 0x2a, 0x8e, 0xf3, 0x28, 0x94, 0xf4, 0xfd, 0xac, 0x16,
+	0x26, 0xc9, 0xea, 0xe1, 0x69, 0xc7, 0xa2, 0xd3, 0x7e, 0x1f, 0xd2, 0x2c, 0x47, 0x27, 0x0a, 0x21,
+	0xcb, 0x73, 0xee, 0xe8, 0xc8, 0x3a, 0x4a, 0x4c, 0xbc, 0x8e, 0x9a, 0x90, 0x31, 0x90, 0xa5, 0xdb,
+	0xfd, 0x3c, 0x9b, 0x1e, 0x6f, 0x6f, 0x62, 0x24, 0x9c, 0xf4, 0x08, 0xe4, 0x76, 0xcf, 0xea, 0x99,
+	0xba, 0x8f, 0x8f, 0x91, 0xc6, 0x86, 0x02, 0xfe, 0x99, 0xb1, 0xf8, 0x97, 0x07, 0x7c, 0xd5, 0x90,
+	0xa5, 0x20, 0xca, 0x09, 0x98, 0x0f, 0x6e, 0x85, 0x91, 0xef, 0x9b, 0xe8, 0x32, 0xa5, 0xfb, 0xdc,
+	0x4d, 0x4a, 0xec, 0x1a, 0x6e, 0x52, 0x3e, 0x82, 0x79, 0xd6, 0x3c, 0xd3, 0x1b, 0xa8, 0x89, 0xe2,
+	0x9e, 0xa3, 0x44, 0xe4, 0xc2, 0x8a, 0x7b, 0xf5, 0x63, 0x58, 0x60, 0xdc, 0xf4, 0x9a, 0xd4, 0x98,
+	0x2c, 0x07, 0xd8, 0x6b, 0xd2, 0x9b, 0xd2, 0x80, 0xff, 0x00, 0x96, 0x38, 0x3f, 0x22, 0x9b, 0x3d,
+	0x9a, 0x30, 0x25, 0xd8, 0xcb, 0xaa, 0x9c, 0x8b, 0xdb, 0x78, 0x03, 0x32, 0x8f, 0xb1, 0x6d, 0x23,
+	0x37, 0x58, 0x34, 0x33, 0x34, 0x2c, 0x69, 0x2e, 0x64, 0xeb, 0xe6, 0x75, 0x48, 0xb3, 0xbb, 0xbc,
+	0x23, 0x76, 0x96, 0x25, 0x9b, 0x75, 0x5c, 0x4d, 0x51, 0xd9, 0x03, 0x2a, 0x62, 0x07, 0x40, 0x27,
+	0x68, 0xf0, 0x92, 0x57, 0x3b, 0x00, 0x3a, 0xbc, 0xbd, 0xbb, 0x0d, 0xb9, 0xe1, 0x8b, 0x2c, 0xf6,
+	0x15, 0x26, 0xa3, 0x66, 0x87, 0x2e, 0xa5, 0x82, 0x5a, 0xf2, 0x55, 0x0c, 0x44, 0x56, 0xdf, 0x2f,
+	0x9f, 0x64, 0x17, 0x15, 0xf8, 0x7d, 0x10, 0xc9, 0xa7, 0x89, 0xb6, 0xee, 0x4f, 0x5a, 0xe1, 0x73,
+	0x7d, 0x9e, 0x41, 0x89, 0xe8, 0xea, 0x78, 0xc2, 0xf4, 0x00, 0x42, 0xc1, 0x09, 0xe9, 0x66, 0x74,
+	0x1d, 0x19, 0x91, 0x75, 0x87, 0x92, 0x81, 0xbb, 0xf5, 0x57, 0x02, 0x88, 0x03, 0x87, 0x56, 0x7a,
+	0xae, 0xe7, 0xb8, 0x2f, 0x73, 0xeb, 0x0a, 0xa4, 0x4c, 0xdd, 0xf3, 0xb5, 0x21, 0xdf, 0x02, 0x11,
+	0xf1, 0x5e, 0xfc, 0x36, 0xe4, 0x3c, 0xca, 0x69, 0x70, 0x1d, 0x8f, 0x77, 0xb9, 0x59, 0x2e, 0x66,
+	0x7a, 0x41, 0x68, 0xbf, 0x88, 0x41, 0xae, 0xcc, 0xfc, 0x88, 0x1d, 0xbb, 0x42, 0x8e, 0x8b, 0xe3,
+	0x46, 0xd6, 0x87, 0x41, 0x44, 0x6e, 0xee, 0xc0, 0x93, 0xed, 0xdb, 0xa0, 0xcf, 0x92, 0x0d, 0x69,
+	0xe6, 0xdc, 0x9b, 0xeb, 0x87, 0x52, 0xcc, 0x00, 0xb3, 0x27, 0xc3, 0x2c, 0x3f, 0x54, 0xf3, 0x83,
+	0x51, 0xf0, 0xb8, 0xfa, 0x5f, 0x01, 0xb2, 0xbc, 0x16, 0x6f, 0xea, 0xd8, 0xec, 0xb9, 0x2f, 0x6d,
+	0x82, 0x7e, 0x06, 0x99, 0x43, 0x1d, 0x93, 0x50, 0xf1, 0xef, 0x7f, 0xb1, 0xab, 0x7c, 0xff, 0x4b,
+	0x33, 0x2c, 0x7b, 0x22, 0x51, 0x71, 0x91, 0xee, 0x39, 0x76, 0xd0, 0x7f, 0xb3, 0x27, 0x92, 0x30,
+	0x44, 0x2f, 0xa8, 0x28, 0x09, 0x5a, 0x51, 0x80, 0x88, 0x78, 0x41, 0x29, 0xc3, 0x1c, 0x55, 0xa0,
+	0xf5, 0x64, 0xfa, 0x0a, 0xf5, 0x24, 0x49, 0x60, 0x64, 0x80, 0xa5, 0xd2, 0x9b, 0x5f, 0x0b, 0x90,
+	0x0a, 0x7d, 0x72, 0x97, 0xee, 0x82, 0x5c, 0xde, 0xab, 0xb4, 0xea, 0xbb, 0x3b, 0x5a, 0x6b, 0xbf,
+	0x51, 0xd3, 0xf6, 0x76, 0x9a, 0x8d, 0x5a, 0xa5, 0xbe, 0x59, 0xaf, 0x55, 0xc5, 0xa9, 0xbc, 0x74,
+	0x7a, 0x56, 0xcc, 0x86, 0xd4, 0x77, 0xb0, 0x29, 0xbd, 0x13, 0x41, 0x6c, 0xd6, 0x3f, 0xac, 0x55,
+	0xb5, 0x86, 0x5a, 0xaf, 0xd4, 0x44, 0x21, 0xff, 0xca, 0xe9, 0x59, 0x71, 0x29, 0x84, 0x18, 0x7c,
+	0xdb, 0x21, 0xd7, 0xf8, 0x43, 0x40, 0xa5, 0xdc, 0xaa, 0x3c, 0x10, 0x63, 0xf9, 0xc5, 0xd3, 0xb3,
+	0xa2, 0x18, 0x82, 0xd0, 0x4f, 0x1e, 0xe7, 0xb4, 0xab, 0x7b, 0x44, 0x3b, 0x7e, 0x4e, 0x9b, 0x5e,
+	0x49, 0xe7, 0x13, 0x9f, 0xfc, 0xb9, 0x30, 0xf5, 0xe6, 0x6f, 0x13, 0x90, 0x19, 0x72, 0xbf, 0x74,
+	0x1f, 0xf2, 0x01, 0x4b, 0xb3, 0x55, 0x6e, 0xed, 0x35, 0x23, 0x13, 0x0c, 0xb3, 0x31, 0x08, 0x99,
+	0xe2, 0x7d, 0x58, 0x8e, 0xa0, 0x9a, 0xad, 0xf2, 0x4e, 0x55, 0xd9, 0x17, 0x85, 0xbc, 0x7c, 0x7a,
+	0x56, 0x5c, 0x1c, 0x42, 0x34, 0x7d, 0xdd, 0x36, 0x94, 0x93, 0xd1, 0x28, 0xb5, 0x55, 0xab, 0x8a,
+	0xb1, 0xd1, 0x28, 0xd7, 0x47, 0xc6, 0x08, 0xd4, 0x07, 0xb5, 0x66, 0xab, 0xbe, 0xf3, 0x9e, 0x18,
+	0x1f, 0x81, 0x0a, 0x2e, 0xd1, 0xde, 0x86, 0x5b, 0x11, 0xd4, 0x66, 0x7d, 0xa7, 0xde, 0x7c, 0x50,
+	0xab, 0x8a, 0x89, 0xa1, 0x18, 0x30, 0xd8, 0x26, 0xb6, 0xb1, 0x77, 0x84, 0x0c, 0xe9, 0x87, 0x20,
+	0x47, 0x70, 0x95, 0xf2, 0x4e, 0xa5, 0xb6, 0xb5, 0x55, 0xab, 0x8a, 0xd3, 0xf9, 0xfc, 0xe9, 0x59,
+	0x71, 0x79, 0x08, 0x58, 0xd1, 0xed, 0x36, 0x32, 0x4d, 0x64, 0x48, 0x1b, 0xb0, 0x14, 0xb5, 0x58,
+	0xae, 0x13, 0xd8, 0x4c, 0xfe, 0xd6, 0xe9, 0x59, 0x71, 0x61, 0xd8, 0x1e, 0x4d, 0x7a, 0x49, 0x81,
+	0xc2, 0x48, 0x8c, 0xd6, 0xdc, 0xdd, 0x6c, 0x69, 0x95, 0x72, 0x43, 0x9c, 0xcd, 0x17, 0x4e, 0xcf,
+	0x8a, 0xf9, 0x11, 0xe0, 0xa6, 0x73, 0xe8, 0x57, 0xf4, 0xee, 0x88, 0x99, 0x36, 0x6b, 0xad, 0xd6,
+	0x16, 0x71, 0x50, 0x72, 0xc4, 0x4c, 0x69, 0xa9, 0x26, 0xff, 0x30, 0xc3, 0x32, 0xe2, 0x3f, 0x02,
+	0xcc, 0xf2, 0xeb, 0x00, 0x69, 0x0d, 0x16, 0x95, 0x7a, 0x75, 0x54, 0x9a, 0x67, 0x4f, 0xcf, 0x8a,
+	0xc0, 0xd5, 0x48, 0xfc, 0xd7, 0x43, 0x9a, 0xc3, 0xe9, 0xbd, 0x74, 0x7a, 0x56, 0x9c, 0xe7, 0x9a,
+	0xa1, 0xd4, 0x0e, 0x03, 0x68, 0x5a, 0x6b, 0x0f, 0x77, 0xd5, 0x16, 0x49, 0xee, 0x30, 0x80, 0x26,
+	0xf6, 0x43, 0xd2, 0x2e, 0x93, 0xef, 0x81, 0x11, 0xc0, 0x76, 0x79, 0x67, 0x3f, 0x48, 0xef, 0xb0,
+	0xfe, 0xb6, 0x6e, 0x9f, 0x48, 0xff, 0x0f, 0xd9, 0xbe, 0x3a, 0x5b, 0x08, 0x89, 0xbc, 0x78, 0x7a,
+	0x56, 0x4c, 0x73, 0xcd, 0xf0, 0x22, 0xf8, 0x4a, 0x80, 0x5c, 0xe4, 0xd3, 0x90, 0xf4, 0x23, 0x78,
+	0xad, 0x51, 0x56, 0x5b, 0xf5, 0xf2, 0x96, 0xb6, 0x59, 0xdf, 0xda, 0xd2, 0xb6, 0x77, 0xab, 0x51,
+	0x1f, 0x2c, 0x9f, 0x9e, 0x15, 0xa5, 0x08, 0x8e, 0xf8, 0xe2, 0x5d, 0xc8, 0x9f, 0x87, 0x36, 0xd4,
+	0x5d, 0x4d, 0x2d, 0xb7, 0xca, 0xa2, 0xc0, 0x72, 0x26, 0x82, 0x6b, 0xb8, 0x8e, 0xaa, 0xfb, 0xba,
+	0x54, 0x85, 0x95, 0xf3, 0xd8, 0x56, 0x7d, 0x9b, 0x10, 0xd4, 0x77, 0xd5, 0x7a, 0x6b, 0x5f, 0x8c,
+	0xe5, 0x57, 0x4e, 0xcf, 0x8a, 0xff, 0x17, 0x21, 0x20, 0x05, 0xab, 0xe1, 0x62, 0xc7, 0xc5, 0xfe,
+	0x09, 0x9f, 0xd6, 0x2f, 0x20, 0x15, 0xfa, 0x40, 0x25, 0xdd, 0x07, 0x99, 0xc4, 0xa4, 0xbe, 0xf3,
+	0x9e, 0xa6, 0xee, 0x6d, 0x8d, 0x9e, 0xcc, 0x40, 0x7d, 0xcf, 0xc6, 0x87, 0x8e, 0x6b, 0x49, 0xf7,
+	0xe1, 0xd6, 0x10, 0xaa, 0x51, 0xde, 0xd7, 0xca, 0x4d, 0x4d, 0xa9, 0x57, 0x45, 0x81, 0xa5, 0x71,
+	0x08, 0xd4, 0xd0, 0x4f, 0xca, 0x9e, 0x82, 0x0d, 0xfe, 0x02, 0x27, 0x90, 0xe2, 0xff, 0xef, 0x42,
+	0xb3, 0xe9, 0x2d, 0x58, 0x2a, 0x57, 0xab, 0x6a, 0xad, 0xd9, 0x64, 0x61, 0xb9, 0xb7, 0xa1, 0x29,
+	0xfb, 0xad, 0x5a, 0x33, 0xb0, 0x1e, 0xd2, 0xbd, 0xb7, 0xa1, 0x9c, 0xf8, 0xc8, 0x3b, 0x07, 0xd9,
+	0xb8, 0xcb, 0x21, 0xc2, 0x39, 0xc8, 0xc6, 0x5d, 0x0a, 0x61, 0xa6, 0x95, 0xdd, 0x2f, 0x9e, 0x15,
+	0x84, 0x2f, 0x9f, 0x15, 0x84, 0x7f, 0x3f, 0x2b, 0x08, 0x9f, 0x3e, 0x2f, 0x4c, 0x7d, 0xf9, 0xbc,
+	0x30, 0xf5, 0xaf, 0xe7, 0x85, 0xa9, 0x8f, 0x7e, 0x10, 0xda, 0x1f, 0x07, 0xfb, 0x51, 0xf8, 0xff,
+	0xca, 0xd6, 0x9f, 0x0c, 0x3d, 0xd1, 0x2d, 0xf3, 0x60, 0x86, 0xee, 0x19, 0xf7, 0xfe, 0x37, 0x00,
+	0xd2, 0x50, 0xad, 0x09, 0x8d, 0x26, 0x00, 0x00,
 }
 
-func (m *Bid) Marshal() (dAtA []byte, err error) {
+func (m *BaseAuction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1066,262 +1761,5276 @@ func (m *Bid) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaseAuction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsMatched {
+	if m.ClaimMode {
 		i--
-		if m.IsMatched {
+		if m.ClaimMode {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.LinearVestingSchedule != nil {
+		{
+			size, err := m.LinearVestingSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFundraising(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintFundraising(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.BidderVestingSchedules) > 0 {
+		for iNdEx := len(m.BidderVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size := m.MinRaiseAmount.Size()
+		i -= size
+		if _, err := m.MinRaiseAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.PayingCoinRates) > 0 {
+		for iNdEx := len(m.PayingCoinRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayingCoinRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SellingBasket) > 0 {
+		for iNdEx := len(m.SellingBasket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellingBasket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	{
-		size := m.Price.Size()
+		size := m.DefaultMaxBidAmount.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DefaultMaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFundraising(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.OpenBidding {
+		i--
+		if m.OpenBidding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.AuctioneerManagedAllowlist {
+		i--
+		if m.AuctioneerManagedAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Status != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.EndTimes) > 0 {
+		for iNdEx := len(m.EndTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintFundraising(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFundraising(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VestingReserveAddress) > 0 {
+		i -= len(m.VestingReserveAddress)
+		copy(dAtA[i:], m.VestingReserveAddress)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.VestingReserveAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PayingReserveAddress) > 0 {
+		i -= len(m.PayingReserveAddress)
+		copy(dAtA[i:], m.PayingReserveAddress)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.PayingReserveAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SellingReserveAddress) > 0 {
+		i -= len(m.SellingReserveAddress)
+		copy(dAtA[i:], m.SellingReserveAddress)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.SellingReserveAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Type != 0 {
 		i = encodeVarintFundraising(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
 	if m.Id != 0 {
 		i = encodeVarintFundraising(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x8
 	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedPriceAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseWhenSoldOut {
 		i--
-		dAtA[i] = 0x12
+		if m.CloseWhenSoldOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.AuctionId != 0 {
-		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+	{
+		size, err := m.RemainingSellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BaseAuction != nil {
+		{
+			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFundraising(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFundraising(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFundraising(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovFundraising(uint64(m.Id))
+	if m.PricingRule != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Type != 0 {
-		n += 1 + sovFundraising(uint64(m.Type))
+	if m.PartialFillMode != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.PartialFillMode))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
+	if m.SealedBidConfig != nil {
+		{
+			size, err := m.SealedBidConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFundraising(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.SellingReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
+	{
+		size := m.ExtendedRoundRate.Size()
+		i -= size
+		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
 	}
-	l = len(m.PayingReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxExtendedRound != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.MaxExtendedRound))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.StartPrice.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	l = m.SellingCoin.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	l = len(m.PayingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
+	{
+		size := m.MatchedPrice.Size()
+		i -= size
+		if _, err := m.MatchedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
 	}
-	l = len(m.VestingReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBidPrice.Size()
+		i -= size
+		if _, err := m.MinBidPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
 	}
-	if len(m.VestingSchedules) > 0 {
-		for _, e := range m.VestingSchedules {
-			l = e.Size()
-			n += 1 + l + sovFundraising(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.BaseAuction != nil {
+		{
+			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFundraising(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovFundraising(uint64(l))
-	if len(m.EndTimes) > 0 {
-		for _, e := range m.EndTimes {
-			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
-			n += 1 + l + sovFundraising(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemainingSellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PriceDecayPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceDecayPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintFundraising(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceDecayStep.Size()
+		i -= size
+		if _, err := m.PriceDecayStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FloorPrice.Size()
+		i -= size
+		if _, err := m.FloorPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BaseAuction != nil {
+		{
+			size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFundraising(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintFundraising(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LinearVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinearVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinearVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintFundraising(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintFundraising(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintFundraising(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBidConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnrevealedPenaltyRate.Size()
+		i -= size
+		if _, err := m.UnrevealedPenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevealPeriod):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintFundraising(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LinearVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinearVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinearVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimedCoins) > 0 {
+		for iNdEx := len(m.ClaimedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalCoins) > 0 {
+		for iNdEx := len(m.TotalCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintFundraising(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PayingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidderVestingQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidderVestingQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidderVestingQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintFundraising(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ReleaseCoins) > 0 {
+		for iNdEx := len(m.ReleaseCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedBidder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedBidder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBidAmount.Size()
+		i -= size
+		if _, err := m.MaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsMatched {
+		i--
+		if m.IsMatched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Type != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidsCount != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.BidsCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WorthCoins) > 0 {
+		for iNdEx := len(m.WorthCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorthCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ManyAmount.Size()
+		i -= size
+		if _, err := m.ManyAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidderAggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidderAggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidderAggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReservedCoins) > 0 {
+		for iNdEx := len(m.ReservedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BidsCount != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.BidsCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SellingAmount.Size()
+		i -= size
+		if _, err := m.SellingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReservedAmount.Size()
+		i -= size
+		if _, err := m.ReservedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookPriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookPriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookPriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeDemandAmount.Size()
+		i -= size
+		if _, err := m.CumulativeDemandAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DemandAmount.Size()
+		i -= size
+		if _, err := m.DemandAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ManyAmount.Size()
+		i -= size
+		if _, err := m.ManyAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WorthAmount.Size()
+		i -= size
+		if _, err := m.WorthAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BidsCount != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.BidsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtendedRounds != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.ExtendedRounds))
+		i--
+		dAtA[i] = 0x48
+	}
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CloseTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintFundraising(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x42
+	if m.CloseHeight != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.CloseHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WinnersCount != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.WinnersCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalRefundedAmount.Size()
+		i -= size
+		if _, err := m.TotalRefundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalRaisedAmount.Size()
+		i -= size
+		if _, err := m.TotalRaisedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalSoldAmount.Size()
+		i -= size
+		if _, err := m.TotalSoldAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MatchedPrice.Size()
+		i -= size
+		if _, err := m.MatchedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidderSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidderSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidderSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundedAmount.Size()
+		i -= size
+		if _, err := m.RefundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PaidAmount.Size()
+		i -= size
+		if _, err := m.PaidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AllocatedAmount.Size()
+		i -= size
+		if _, err := m.AllocatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFundraising(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettledBidders != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.SettledBidders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LastBidder) > 0 {
+		i -= len(m.LastBidder)
+		copy(dAtA[i:], m.LastBidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.LastBidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocationClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RefundCoins) > 0 {
+		for iNdEx := len(m.RefundCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllocatedCoins) > 0 {
+		for iNdEx := len(m.AllocatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFundraising(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FailTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FailTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintFundraising(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	if m.FailHeight != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.FailHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFundraising(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailedStatus != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.FailedStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintFundraising(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFundraising(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFundraising(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFundraising(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFundraising(uint64(m.Type))
+	}
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = len(m.SellingReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = len(m.PayingReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = len(m.VestingReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	if len(m.EndTimes) > 0 {
+		for _, e := range m.EndTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovFundraising(uint64(m.Status))
+	}
+	if m.AuctioneerManagedAllowlist {
+		n += 2
+	}
+	if m.OpenBidding {
+		n += 2
+	}
+	l = m.DefaultMaxBidAmount.Size()
+	n += 2 + l + sovFundraising(uint64(l))
+	if len(m.SellingBasket) > 0 {
+		for _, e := range m.SellingBasket {
+			l = e.Size()
+			n += 2 + l + sovFundraising(uint64(l))
+		}
+	}
+	if len(m.PayingCoinRates) > 0 {
+		for _, e := range m.PayingCoinRates {
+			l = e.Size()
+			n += 2 + l + sovFundraising(uint64(l))
+		}
+	}
+	l = m.MinRaiseAmount.Size()
+	n += 2 + l + sovFundraising(uint64(l))
+	if len(m.BidderVestingSchedules) > 0 {
+		for _, e := range m.BidderVestingSchedules {
+			l = e.Size()
+			n += 2 + l + sovFundraising(uint64(l))
+		}
+	}
+	if m.LinearVestingSchedule != nil {
+		l = m.LinearVestingSchedule.Size()
+		n += 2 + l + sovFundraising(uint64(l))
+	}
+	if m.ClaimMode {
+		n += 3
+	}
+	return n
+}
+
+func (m *FixedPriceAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAuction != nil {
+		l = m.BaseAuction.Size()
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.RemainingSellingCoin.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.CloseWhenSoldOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAuction != nil {
+		l = m.BaseAuction.Size()
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.MinBidPrice.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.MatchedPrice.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.MaxExtendedRound != 0 {
+		n += 1 + sovFundraising(uint64(m.MaxExtendedRound))
+	}
+	l = m.ExtendedRoundRate.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.SealedBidConfig != nil {
+		l = m.SealedBidConfig.Size()
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if m.PartialFillMode != 0 {
+		n += 1 + sovFundraising(uint64(m.PartialFillMode))
+	}
+	if m.PricingRule != 0 {
+		n += 1 + sovFundraising(uint64(m.PricingRule))
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAuction != nil {
+		l = m.BaseAuction.Size()
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.PriceDecayStep.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceDecayPeriod)
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.RemainingSellingCoin.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *LinearVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *SealedBidConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.UnrevealedPenaltyRate.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *LinearVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if len(m.TotalCoins) > 0 {
+		for _, e := range m.TotalCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	if len(m.ClaimedCoins) > 0 {
+		for _, e := range m.ClaimedCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.PayingCoin.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *BidderVestingQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if len(m.ReleaseCoins) > 0 {
+		for _, e := range m.ReleaseCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *AllowedBidder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.MaxBidAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *Bid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovFundraising(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFundraising(uint64(m.Type))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.IsMatched {
+		n += 2
+	}
+	return n
+}
+
+func (m *BidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovFundraising(uint64(m.Id))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.ManyAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if len(m.WorthCoins) > 0 {
+		for _, e := range m.WorthCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	if m.BidsCount != 0 {
+		n += 1 + sovFundraising(uint64(m.BidsCount))
+	}
+	return n
+}
+
+func (m *BidderAggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.ReservedAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.SellingAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.BidsCount != 0 {
+		n += 1 + sovFundraising(uint64(m.BidsCount))
+	}
+	if len(m.ReservedCoins) > 0 {
+		for _, e := range m.ReservedCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderBookPriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.BidsCount != 0 {
+		n += 1 + sovFundraising(uint64(m.BidsCount))
+	}
+	l = m.WorthAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.ManyAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.DemandAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.CumulativeDemandAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *AuctionSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = m.MatchedPrice.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.TotalSoldAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.TotalRaisedAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.TotalRefundedAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.WinnersCount != 0 {
+		n += 1 + sovFundraising(uint64(m.WinnersCount))
+	}
+	if m.CloseHeight != 0 {
+		n += 1 + sovFundraising(uint64(m.CloseHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CloseTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	if m.ExtendedRounds != 0 {
+		n += 1 + sovFundraising(uint64(m.ExtendedRounds))
+	}
+	return n
+}
+
+func (m *BidderSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	l = m.AllocatedAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.PaidAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	l = m.RefundedAmount.Size()
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func (m *SettlementCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.LastBidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if m.SettledBidders != 0 {
+		n += 1 + sovFundraising(uint64(m.SettledBidders))
+	}
+	return n
+}
+
+func (m *AllocationClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if len(m.AllocatedCoins) > 0 {
+		for _, e := range m.AllocatedCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	if len(m.RefundCoins) > 0 {
+		for _, e := range m.RefundCoins {
+			l = e.Size()
+			n += 1 + l + sovFundraising(uint64(l))
+		}
+	}
+	if m.Claimed {
+		n += 2
+	}
+	return n
+}
+
+func (m *AuctionFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovFundraising(uint64(m.AuctionId))
+	}
+	if m.FailedStatus != 0 {
+		n += 1 + sovFundraising(uint64(m.FailedStatus))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFundraising(uint64(l))
+	}
+	if m.FailHeight != 0 {
+		n += 1 + sovFundraising(uint64(m.FailHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FailTime)
+	n += 1 + l + sovFundraising(uint64(l))
+	return n
+}
+
+func sovFundraising(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFundraising(x uint64) (n int) {
+	return sovFundraising(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellingReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTimes = append(m.EndTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.EndTimes[len(m.EndTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctioneerManagedAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuctioneerManagedAllowlist = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenBidding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OpenBidding = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingBasket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellingBasket = append(m.SellingBasket, types.Coin{})
+			if err := m.SellingBasket[len(m.SellingBasket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinRates = append(m.PayingCoinRates, types.DecCoin{})
+			if err := m.PayingCoinRates[len(m.PayingCoinRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRaiseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRaiseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderVestingSchedules = append(m.BidderVestingSchedules, VestingSchedule{})
+			if err := m.BidderVestingSchedules[len(m.BidderVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVestingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVestingSchedule == nil {
+				m.LinearVestingSchedule = &LinearVestingSchedule{}
+			}
+			if err := m.LinearVestingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedPriceAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAuction == nil {
+				m.BaseAuction = &BaseAuction{}
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseWhenSoldOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloseWhenSoldOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAuction == nil {
+				m.BaseAuction = &BaseAuction{}
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			}
+			m.MaxExtendedRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SealedBidConfig == nil {
+				m.SealedBidConfig = &SealedBidConfig{}
+			}
+			if err := m.SealedBidConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialFillMode", wireType)
+			}
+			m.PartialFillMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartialFillMode |= PartialFillMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAuction == nil {
+				m.BaseAuction = &BaseAuction{}
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecayStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceDecayStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PriceDecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinearVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinearVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinearVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrevealedPenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrevealedPenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinearVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinearVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinearVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCoins = append(m.TotalCoins, types.Coin{})
+			if err := m.TotalCoins[len(m.TotalCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedCoins = append(m.ClaimedCoins, types.Coin{})
+			if err := m.ClaimedCoins[len(m.ClaimedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidderVestingQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidderVestingQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidderVestingQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseCoins = append(m.ReleaseCoins, types.Coin{})
+			if err := m.ReleaseCoins[len(m.ReleaseCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedBidder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedBidder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedBidder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BidType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMatched = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManyAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ManyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorthCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorthCoins = append(m.WorthCoins, types.Coin{})
+			if err := m.WorthCoins[len(m.WorthCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidsCount", wireType)
+			}
+			m.BidsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidderAggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidderAggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidderAggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidsCount", wireType)
+			}
+			m.BidsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedCoins = append(m.ReservedCoins, types.Coin{})
+			if err := m.ReservedCoins[len(m.ReservedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovFundraising(uint64(m.Status))
-	}
-	return n
-}
-
-func (m *FixedPriceAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAuction != nil {
-		l = m.BaseAuction.Size()
-		n += 1 + l + sovFundraising(uint64(l))
-	}
-	l = m.RemainingSellingCoin.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	return n
-}
-
-func (m *BatchAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAuction != nil {
-		l = m.BaseAuction.Size()
-		n += 1 + l + sovFundraising(uint64(l))
-	}
-	l = m.MinBidPrice.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	l = m.MatchedPrice.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	if m.MaxExtendedRound != 0 {
-		n += 1 + sovFundraising(uint64(m.MaxExtendedRound))
-	}
-	l = m.ExtendedRoundRate.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	return n
-}
-
-func (m *VestingSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
-	n += 1 + l + sovFundraising(uint64(l))
-	l = m.Weight.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	return n
-}
-
-func (m *VestingQueue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovFundraising(uint64(m.AuctionId))
-	}
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
-	}
-	l = m.PayingCoin.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
-	n += 1 + l + sovFundraising(uint64(l))
-	if m.Released {
-		n += 2
-	}
-	return n
-}
-
-func (m *AllowedBidder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
-	}
-	l = m.MaxBidAmount.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	return n
-}
 
-func (m *Bid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovFundraising(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovFundraising(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovFundraising(uint64(m.Id))
-	}
-	if m.Type != 0 {
-		n += 1 + sovFundraising(uint64(m.Type))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	l = m.Coin.Size()
-	n += 1 + l + sovFundraising(uint64(l))
-	if m.IsMatched {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFundraising(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFundraising(x uint64) (n int) {
-	return sovFundraising(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BaseAuction) Unmarshal(dAtA []byte) error {
+func (m *OrderBookPriceLevel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,17 +7053,17 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderBookPriceLevel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderBookPriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1364,16 +7073,31 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BidsCount", wireType)
 			}
-			m.Type = 0
+			m.BidsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1383,14 +7107,14 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= AuctionType(b&0x7F) << shift
+				m.BidsCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorthAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1418,11 +7142,13 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			if err := m.WorthAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellingReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManyAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1450,11 +7176,13 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellingReserveAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ManyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1482,11 +7210,13 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayingReserveAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.DemandAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeDemandAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,18 +7241,68 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthFundraising
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeDemandAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFundraising(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFundraising
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var msglen int
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1532,28 +7312,14 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1581,11 +7347,13 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSoldAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1613,13 +7381,15 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingReserveAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalSoldAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRaisedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1629,31 +7399,31 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFundraising
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFundraising
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
-			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalRaisedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRefundedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1663,30 +7433,31 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFundraising
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFundraising
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalRefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTimes", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnersCount", wireType)
 			}
-			var msglen int
+			m.WinnersCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1696,31 +7467,16 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WinnersCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EndTimes = append(m.EndTimes, time.Time{})
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.EndTimes[len(m.EndTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseHeight", wireType)
 			}
-			m.Status = 0
+			m.CloseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1730,64 +7486,14 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= AuctionStatus(b&0x7F) << shift
+				m.CloseHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFundraising(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFundraising
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FixedPriceAuction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1814,18 +7520,15 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAuction == nil {
-				m.BaseAuction = &BaseAuction{}
-			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSellingCoin", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRounds", wireType)
 			}
-			var msglen int
+			m.ExtendedRounds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1835,25 +7538,11 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExtendedRounds |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RemainingSellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFundraising(dAtA[iNdEx:])
@@ -1875,7 +7564,7 @@ func (m *FixedPriceAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchAuction) Unmarshal(dAtA []byte) error {
+func (m *BidderSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1898,17 +7587,17 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidderSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidderSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var msglen int
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -1918,31 +7607,14 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BaseAuction == nil {
-				m.BaseAuction = &BaseAuction{}
-			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,13 +7642,11 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2004,15 +7674,15 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllocatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
 			}
-			m.MaxExtendedRound = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -2022,14 +7692,29 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFundraising
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,7 +7742,7 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2082,7 +7767,7 @@ func (m *BatchAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+func (m *SettlementCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,17 +7790,17 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettlementCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettlementCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var msglen int
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -2125,28 +7810,14 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2174,10 +7845,27 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastBidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledBidders", wireType)
+			}
+			m.SettledBidders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFundraising
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledBidders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFundraising(dAtA[iNdEx:])
@@ -2199,7 +7887,7 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VestingQueue) Unmarshal(dAtA []byte) error {
+func (m *AllocationClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,10 +7910,10 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VestingQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocationClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VestingQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocationClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2249,7 +7937,7 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2277,11 +7965,11 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2308,13 +7996,14 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PayingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllocatedCoins = append(m.AllocatedCoins, types.Coin{})
+			if err := m.AllocatedCoins[len(m.AllocatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2341,13 +8030,14 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.RefundCoins = append(m.RefundCoins, types.Coin{})
+			if err := m.RefundCoins[len(m.RefundCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2364,7 +8054,7 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Released = bool(v != 0)
+			m.Claimed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFundraising(dAtA[iNdEx:])
@@ -2386,7 +8076,7 @@ func (m *VestingQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedBidder) Unmarshal(dAtA []byte) error {
+func (m *AuctionFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2409,17 +8099,17 @@ func (m *AllowedBidder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedBidder: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedBidder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var stringLen uint64
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -2429,113 +8119,16 @@ func (m *AllowedBidder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBidAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFundraising
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFundraising(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Bid) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFundraising
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStatus", wireType)
 			}
-			m.AuctionId = 0
+			m.FailedStatus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -2545,14 +8138,14 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.FailedStatus |= AuctionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2580,32 +8173,13 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFundraising
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailHeight", wireType)
 			}
-			m.Type = 0
+			m.FailHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFundraising
@@ -2615,48 +8189,14 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= BidType(b&0x7F) << shift
+				m.FailHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFundraising
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFundraising
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2683,30 +8223,10 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FailTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFundraising
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsMatched = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFundraising(dAtA[iNdEx:])
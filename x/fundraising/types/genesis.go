@@ -16,6 +16,15 @@ func DefaultGenesisState() *GenesisState {
 		AllowedBidderRecords: []AllowedBidderRecord{},
 		Bids:                 []Bid{},
 		VestingQueues:        []VestingQueue{},
+		AuctionSettlements:   []AuctionSettlement{},
+		BidderSettlements:    []BidderSettlement{},
+		AuctionFailures:      []AuctionFailure{},
+		BidderVestingQueues:  []BidderVestingQueue{},
+		LinearVestings:       []LinearVesting{},
+		AllocationClaims:     []AllocationClaim{},
+		SettlementCursors:    []SettlementCursor{},
+		BidCommitments:       []BidCommitment{},
+		LastBidIdRecords:     []LastBidIdRecord{},
 	}
 }
 
@@ -43,10 +52,24 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	lastBidIdByAuction := map[uint64]uint64{}
+	for _, r := range gs.LastBidIdRecords {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := lastBidIdByAuction[r.AuctionId]; ok {
+			return fmt.Errorf("duplicate last bid id record for auction %d", r.AuctionId)
+		}
+		lastBidIdByAuction[r.AuctionId] = r.BidId
+	}
+
 	for _, b := range gs.Bids {
 		if err := b.Validate(); err != nil {
 			return err
 		}
+		if b.Id > lastBidIdByAuction[b.AuctionId] {
+			return fmt.Errorf("bid id %d is greater than the last bid id %d of auction %d", b.Id, lastBidIdByAuction[b.AuctionId], b.AuctionId)
+		}
 	}
 
 	for _, q := range gs.VestingQueues {
@@ -55,6 +78,57 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, s := range gs.AuctionSettlements {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range gs.BidderSettlements {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range gs.AuctionFailures {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, q := range gs.BidderVestingQueues {
+		if err := q.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range gs.LinearVestings {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range gs.AllocationClaims {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range gs.SettlementCursors {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range gs.BidCommitments {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if c.Id > lastBidIdByAuction[c.AuctionId] {
+			return fmt.Errorf("bid commitment id %d is greater than the last bid id %d of auction %d", c.Id, lastBidIdByAuction[c.AuctionId], c.AuctionId)
+		}
+	}
+
 	return nil
 }
 
@@ -66,6 +140,14 @@ func (r AllowedBidderRecord) Validate() error {
 	return r.AllowedBidder.Validate()
 }
 
+// Validate validates LastBidIdRecord.
+func (r LastBidIdRecord) Validate() error {
+	if r.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	return nil
+}
+
 // Validate validates Bid.
 func (b Bid) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(b.Bidder); err != nil {
@@ -93,3 +175,50 @@ func (q VestingQueue) Validate() error {
 	}
 	return nil
 }
+
+// Validate validates BidderVestingQueue.
+func (q BidderVestingQueue) Validate() error {
+	if q.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(q.Bidder); err != nil {
+		return err
+	}
+	if err := q.ReleaseCoins.Validate(); err != nil {
+		return fmt.Errorf("release coins are invalid: %v", err)
+	}
+	return nil
+}
+
+// Validate validates LinearVesting.
+func (v LinearVesting) Validate() error {
+	if v.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Auctioneer); err != nil {
+		return err
+	}
+	if err := v.TotalCoins.Validate(); err != nil {
+		return fmt.Errorf("total coins are invalid: %v", err)
+	}
+	if err := v.ClaimedCoins.Validate(); err != nil {
+		return fmt.Errorf("claimed coins are invalid: %v", err)
+	}
+	if !v.TotalCoins.IsAllGTE(v.ClaimedCoins) {
+		return fmt.Errorf("claimed coins %s must not exceed total coins %s", v.ClaimedCoins, v.TotalCoins)
+	}
+	return nil
+}
+
+// Validate validates AuctionFailure.
+func (f AuctionFailure) Validate() error {
+	if f.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	switch f.FailedStatus {
+	case AuctionStatusStandBy, AuctionStatusStarted, AuctionStatusSettling, AuctionStatusVesting, AuctionStatusFinished:
+	default:
+		return fmt.Errorf("invalid failed status: %s", f.FailedStatus)
+	}
+	return nil
+}
@@ -39,6 +39,30 @@ type GenesisState struct {
 	// vesting_queues define the vesting queue records used for genesis
 	// state
 	VestingQueues []VestingQueue `protobuf:"bytes,5,rep,name=vesting_queues,json=vestingQueues,proto3" json:"vesting_queues"`
+	// auction_settlements define the settlement records of the closed auctions
+	AuctionSettlements []AuctionSettlement `protobuf:"bytes,6,rep,name=auction_settlements,json=auctionSettlements,proto3" json:"auction_settlements"`
+	// bidder_settlements define the settlement records of the bidders for the
+	// closed auctions
+	BidderSettlements []BidderSettlement `protobuf:"bytes,7,rep,name=bidder_settlements,json=bidderSettlements,proto3" json:"bidder_settlements"`
+	// auction_failures define the failure records of the failed auctions
+	AuctionFailures []AuctionFailure `protobuf:"bytes,8,rep,name=auction_failures,json=auctionFailures,proto3" json:"auction_failures"`
+	// bidder_vesting_queues define the vesting queue records of the bidders used
+	// for genesis state
+	BidderVestingQueues []BidderVestingQueue `protobuf:"bytes,9,rep,name=bidder_vesting_queues,json=bidderVestingQueues,proto3" json:"bidder_vesting_queues"`
+	// linear_vestings define the linear vesting records of the auctions used for
+	// genesis state
+	LinearVestings []LinearVesting `protobuf:"bytes,10,rep,name=linear_vestings,json=linearVestings,proto3" json:"linear_vestings"`
+	// allocation_claims define the allocation claim records of the bidders used
+	// for genesis state
+	AllocationClaims []AllocationClaim `protobuf:"bytes,11,rep,name=allocation_claims,json=allocationClaims,proto3" json:"allocation_claims"`
+	// settlement_cursors define the progress of the auctions that are settling
+	// used for genesis state
+	SettlementCursors []SettlementCursor `protobuf:"bytes,12,rep,name=settlement_cursors,json=settlementCursors,proto3" json:"settlement_cursors"`
+	// bid_commitments define the sealed bids that are not revealed yet
+	BidCommitments []BidCommitment `protobuf:"bytes,13,rep,name=bid_commitments,json=bidCommitments,proto3" json:"bid_commitments"`
+	// last_bid_id_records define the last bid ids of the auctions, which can be
+	// greater than the ids of the remaining bids since canceled bids are deleted
+	LastBidIdRecords []LastBidIdRecord `protobuf:"bytes,14,rep,name=last_bid_id_records,json=lastBidIdRecords,proto3" json:"last_bid_id_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,43 +152,114 @@ func (m *AllowedBidderRecord) GetAllowedBidder() AllowedBidder {
 	return AllowedBidder{}
 }
 
+type LastBidIdRecord struct {
+	// auction_id specifies index of the auction
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bid_id specifies the last bid id of the auction
+	BidId uint64 `protobuf:"varint,2,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
+}
+
+func (m *LastBidIdRecord) Reset()         { *m = LastBidIdRecord{} }
+func (m *LastBidIdRecord) String() string { return proto.CompactTextString(m) }
+func (*LastBidIdRecord) ProtoMessage()    {}
+func (*LastBidIdRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a35424efc9855161, []int{2}
+}
+func (m *LastBidIdRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastBidIdRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastBidIdRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastBidIdRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastBidIdRecord.Merge(m, src)
+}
+func (m *LastBidIdRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastBidIdRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastBidIdRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastBidIdRecord proto.InternalMessageInfo
+
+func (m *LastBidIdRecord) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *LastBidIdRecord) GetBidId() uint64 {
+	if m != nil {
+		return m.BidId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tendermint.fundraising.GenesisState")
 	proto.RegisterType((*AllowedBidderRecord)(nil), "tendermint.fundraising.AllowedBidderRecord")
+	proto.RegisterType((*LastBidIdRecord)(nil), "tendermint.fundraising.LastBidIdRecord")
 }
 
 func init() { proto.RegisterFile("fundraising/genesis.proto", fileDescriptor_a35424efc9855161) }
 
 var fileDescriptor_a35424efc9855161 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0x4e, 0xd6, 0x30, 0x15, 0x6f, 0xec, 0x60, 0xaa, 0x29, 0x1d, 0x5a, 0x3a, 0x4d, 0x20, 0x4d,
-	0x42, 0x38, 0xd2, 0xd0, 0x2e, 0x08, 0x21, 0x35, 0x17, 0xb4, 0x13, 0x2c, 0x48, 0x1c, 0xb8, 0x44,
-	0x4e, 0xec, 0x19, 0x4b, 0x8d, 0x5d, 0x62, 0xa7, 0xd0, 0x37, 0x28, 0x37, 0x1e, 0xa1, 0x0f, 0xc1,
-	0x43, 0x54, 0x9c, 0x7a, 0xe4, 0x84, 0x50, 0x7b, 0xe1, 0x31, 0x50, 0x6d, 0x17, 0x52, 0xd1, 0x4a,
-	0xdc, 0xfc, 0xff, 0xff, 0xf7, 0x7d, 0xff, 0x97, 0xef, 0x0f, 0xe8, 0xde, 0xd6, 0x82, 0x54, 0x98,
-	0x2b, 0x2e, 0x58, 0xcc, 0xa8, 0xa0, 0x8a, 0x2b, 0x34, 0xac, 0xa4, 0x96, 0xf0, 0x58, 0x53, 0x41,
-	0x68, 0x55, 0x72, 0xa1, 0x51, 0x03, 0x75, 0xd2, 0x2d, 0xa4, 0x2a, 0xa5, 0xca, 0x0c, 0x2a, 0xb6,
-	0x85, 0xa5, 0x9c, 0x74, 0x98, 0x64, 0xd2, 0xf6, 0x57, 0x2f, 0xd7, 0xed, 0x32, 0x29, 0xd9, 0x80,
-	0xc6, 0xa6, 0xca, 0xeb, 0xdb, 0x18, 0x8b, 0xb1, 0x1b, 0x9d, 0x36, 0xd7, 0x37, 0xde, 0x6e, 0x1c,
-	0x36, 0xc7, 0x43, 0x5c, 0xe1, 0xd2, 0x6d, 0x3a, 0xff, 0xdc, 0x02, 0x87, 0x2f, 0xad, 0xdd, 0x37,
-	0x1a, 0x6b, 0x0a, 0x9f, 0x83, 0x7d, 0x0b, 0x08, 0xfd, 0x33, 0xff, 0xe2, 0xe0, 0x32, 0x42, 0xdb,
-	0xed, 0xa3, 0xd7, 0x06, 0x95, 0x04, 0xb3, 0x1f, 0x3d, 0x2f, 0x75, 0x1c, 0xf8, 0x02, 0xb4, 0x71,
-	0x5d, 0x68, 0x2e, 0x85, 0x0a, 0xf7, 0xce, 0x5a, 0x17, 0x07, 0x97, 0x1d, 0x64, 0x5d, 0xa3, 0xb5,
-	0x6b, 0xd4, 0x17, 0xe3, 0xe4, 0xf0, 0xdb, 0xd7, 0x27, 0xed, 0xbe, 0x45, 0x5e, 0xa7, 0x7f, 0x38,
-	0x90, 0x81, 0x63, 0x3c, 0x18, 0xc8, 0x8f, 0x94, 0x64, 0x39, 0x27, 0x84, 0x56, 0x59, 0x45, 0x0b,
-	0x59, 0x11, 0x15, 0xb6, 0x8c, 0xda, 0xe3, 0x5d, 0x6e, 0xfa, 0x96, 0x95, 0x18, 0x52, 0x6a, 0x38,
-	0xce, 0x5a, 0x07, 0xff, 0x3b, 0x52, 0xf0, 0x0a, 0x04, 0x39, 0x27, 0x2a, 0x0c, 0x8c, 0xec, 0x83,
-	0x5d, 0xb2, 0x09, 0x5f, 0xcb, 0x18, 0x38, 0xbc, 0x01, 0x47, 0x23, 0xaa, 0x34, 0x17, 0x2c, 0xfb,
-	0x50, 0xd3, 0x9a, 0xaa, 0xf0, 0x8e, 0x11, 0x78, 0xb8, 0x4b, 0xe0, 0xad, 0x45, 0xdf, 0xac, 0xc0,
-	0x4e, 0xe9, 0xde, 0xa8, 0xd1, 0x53, 0xcf, 0xda, 0x93, 0x69, 0xcf, 0xfb, 0x35, 0xed, 0x79, 0xe7,
-	0x13, 0x1f, 0xdc, 0xdf, 0xf2, 0x1d, 0xf0, 0x14, 0x00, 0x17, 0x50, 0xc6, 0x89, 0x39, 0x4b, 0x90,
-	0xde, 0x75, 0x9d, 0x6b, 0x02, 0x53, 0x70, 0xb4, 0x99, 0x59, 0xb8, 0x67, 0x2e, 0xf7, 0xe8, 0xbf,
-	0xb2, 0x5a, 0x9b, 0xda, 0x48, 0x29, 0x79, 0x35, 0x5b, 0x44, 0xfe, 0x7c, 0x11, 0xf9, 0x3f, 0x17,
-	0x91, 0xff, 0x65, 0x19, 0x79, 0xf3, 0x65, 0xe4, 0x7d, 0x5f, 0x46, 0xde, 0xbb, 0x2b, 0xc6, 0xf5,
-	0xfb, 0x3a, 0x47, 0x85, 0x2c, 0xe3, 0xbf, 0xfa, 0xcd, 0x7f, 0x2e, 0xfe, 0xb4, 0x51, 0xe9, 0xf1,
-	0x90, 0xaa, 0x7c, 0xdf, 0x9c, 0xff, 0xe9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xa2, 0xf3,
-	0x06, 0x28, 0x03, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x4e, 0x13, 0x41,
+	0x18, 0x6d, 0xa1, 0xd4, 0x32, 0x40, 0x81, 0x29, 0x90, 0x05, 0x43, 0x21, 0xc4, 0x1f, 0xd4, 0xb8,
+	0x4d, 0x30, 0xdc, 0x18, 0x63, 0x42, 0x49, 0x24, 0x24, 0x24, 0x4a, 0x31, 0x9a, 0x10, 0xcd, 0x3a,
+	0xbb, 0x33, 0xac, 0x93, 0xec, 0xee, 0xe0, 0x7e, 0xb3, 0x28, 0x6f, 0xc0, 0xa5, 0x8f, 0xc0, 0x43,
+	0xf8, 0x10, 0xc4, 0x0b, 0xc3, 0xa5, 0x57, 0xc6, 0xc0, 0x8d, 0x8f, 0x61, 0x3a, 0x33, 0x5b, 0x76,
+	0x0b, 0x5d, 0xee, 0xba, 0xe7, 0x3b, 0xe7, 0x7c, 0x67, 0xbe, 0xf9, 0x29, 0x9a, 0x3f, 0x48, 0x22,
+	0x1a, 0x13, 0x0e, 0x3c, 0xf2, 0x5b, 0x3e, 0x8b, 0x18, 0x70, 0xb0, 0x0f, 0x63, 0x21, 0x05, 0x9e,
+	0x93, 0x2c, 0xa2, 0x2c, 0x0e, 0x79, 0x24, 0xed, 0x0c, 0x6b, 0x61, 0xde, 0x13, 0x10, 0x0a, 0x70,
+	0x14, 0xab, 0xa5, 0x3f, 0xb4, 0x64, 0x61, 0xc6, 0x17, 0xbe, 0xd0, 0x78, 0xf7, 0x97, 0x41, 0xe7,
+	0x7d, 0x21, 0xfc, 0x80, 0xb5, 0xd4, 0x97, 0x9b, 0x1c, 0xb4, 0x48, 0x74, 0x6c, 0x4a, 0x8b, 0xd9,
+	0xf6, 0x99, 0xdf, 0xa6, 0x6c, 0x65, 0xcb, 0x87, 0x24, 0x26, 0xa1, 0xe9, 0xb4, 0xf2, 0x6b, 0x14,
+	0x8d, 0x6f, 0xe9, 0xb8, 0x7b, 0x92, 0x48, 0x86, 0x5f, 0xa0, 0xaa, 0x26, 0x58, 0xe5, 0xe5, 0xf2,
+	0xea, 0xd8, 0x5a, 0xd3, 0xbe, 0x39, 0xbe, 0xfd, 0x46, 0xb1, 0xda, 0x95, 0xb3, 0x3f, 0x4b, 0xa5,
+	0x8e, 0xd1, 0xe0, 0x97, 0xa8, 0x46, 0x12, 0x4f, 0x72, 0x11, 0x81, 0x35, 0xb4, 0x3c, 0xbc, 0x3a,
+	0xb6, 0x36, 0x63, 0xeb, 0xd4, 0x76, 0x9a, 0xda, 0xde, 0x88, 0x8e, 0xdb, 0xe3, 0x3f, 0x7f, 0x3c,
+	0xad, 0x6d, 0x68, 0xe6, 0x76, 0xa7, 0xa7, 0xc1, 0x3e, 0x9a, 0x23, 0x41, 0x20, 0xbe, 0x32, 0xea,
+	0xb8, 0x9c, 0x52, 0x16, 0x3b, 0x31, 0xf3, 0x44, 0x4c, 0xc1, 0x1a, 0x56, 0x6e, 0x4f, 0x06, 0xa5,
+	0xd9, 0xd0, 0xaa, 0xb6, 0x12, 0x75, 0x94, 0xc6, 0x44, 0x9b, 0x21, 0xd7, 0x4b, 0x80, 0xd7, 0x51,
+	0xc5, 0xe5, 0x14, 0xac, 0x8a, 0xb2, 0xbd, 0x3b, 0xc8, 0xb6, 0xcd, 0x53, 0x1b, 0x45, 0xc7, 0xbb,
+	0xa8, 0x7e, 0xc4, 0x40, 0xf2, 0xc8, 0x77, 0xbe, 0x24, 0x2c, 0x61, 0x60, 0x8d, 0x28, 0x83, 0x7b,
+	0x83, 0x0c, 0xde, 0x69, 0xf6, 0x6e, 0x97, 0x6c, 0x9c, 0x26, 0x8e, 0x32, 0x18, 0xe0, 0x4f, 0xa8,
+	0x61, 0x96, 0xef, 0x00, 0x93, 0x32, 0x60, 0x21, 0x8b, 0x24, 0x58, 0x55, 0xe5, 0xfb, 0x68, 0xe0,
+	0x7a, 0xb5, 0x64, 0xaf, 0xa7, 0x30, 0xe6, 0x98, 0xf4, 0x17, 0x00, 0x7f, 0x44, 0xd8, 0x0c, 0x33,
+	0xdb, 0xe0, 0x8e, 0x6a, 0xb0, 0x5a, 0xb0, 0x72, 0xca, 0xe2, 0x6b, 0xfe, 0xd3, 0x6e, 0x1f, 0x0e,
+	0xf8, 0x3d, 0x9a, 0x4a, 0x17, 0x70, 0x40, 0x78, 0x90, 0xc4, 0x0c, 0xac, 0x9a, 0x32, 0x7f, 0x70,
+	0x4b, 0xfa, 0x57, 0x9a, 0x6e, 0xac, 0x27, 0x49, 0x0e, 0x05, 0x4c, 0xd1, 0xac, 0xc9, 0xdd, 0x37,
+	0xf3, 0x51, 0xe5, 0xfe, 0xb8, 0x38, 0xfa, 0x0d, 0x93, 0x6f, 0xb8, 0xd7, 0x2a, 0x80, 0xdf, 0xa2,
+	0xc9, 0x80, 0x47, 0x8c, 0xf4, 0xba, 0x80, 0x85, 0x94, 0xff, 0xfd, 0x41, 0xfe, 0x3b, 0x8a, 0x6e,
+	0x5c, 0x8c, 0x75, 0x3d, 0xc8, 0x82, 0x80, 0xf7, 0xd1, 0x74, 0xf7, 0xdc, 0x79, 0x44, 0xcd, 0xc5,
+	0x0b, 0x08, 0x0f, 0xc1, 0x1a, 0x53, 0xbe, 0x0f, 0x8b, 0xce, 0xb0, 0x16, 0x6c, 0x76, 0xf9, 0xc6,
+	0x79, 0x8a, 0xe4, 0x61, 0xb5, 0x9f, 0x57, 0x1b, 0xe9, 0x78, 0x49, 0x0c, 0x22, 0x06, 0x6b, 0xbc,
+	0x78, 0x3f, 0xaf, 0x76, 0x6c, 0x53, 0x09, 0xd2, 0xfd, 0x84, 0x3e, 0x5c, 0x0d, 0xc4, 0xe5, 0xd4,
+	0xf1, 0x44, 0x18, 0x72, 0xa9, 0xcf, 0xca, 0x44, 0xf1, 0x40, 0xda, 0x9c, 0x6e, 0xf6, 0xd8, 0xe9,
+	0x40, 0xdc, 0x2c, 0x08, 0xf8, 0x03, 0x6a, 0x04, 0x04, 0x64, 0xf7, 0x5a, 0x3b, 0x9c, 0xf6, 0xae,
+	0x75, 0xbd, 0x78, 0x24, 0x3b, 0x04, 0x64, 0x9b, 0xd3, 0x6d, 0x9a, 0xbb, 0xd2, 0x53, 0x41, 0x1e,
+	0x86, 0xe7, 0xb5, 0x93, 0xd3, 0xa5, 0xd2, 0xbf, 0xd3, 0xa5, 0xd2, 0xca, 0x49, 0x19, 0x35, 0x6e,
+	0x78, 0x0c, 0xf0, 0x22, 0x42, 0xe9, 0x29, 0xe5, 0x54, 0xbd, 0x6d, 0x95, 0xce, 0xa8, 0x41, 0xb6,
+	0x29, 0xee, 0xa0, 0x7a, 0xfe, 0xe1, 0xb1, 0x86, 0x96, 0xcb, 0x45, 0x6b, 0xce, 0xf5, 0x48, 0x6f,
+	0x76, 0xee, 0xa9, 0x59, 0xd9, 0x42, 0x93, 0x7d, 0xf9, 0x6f, 0x4b, 0x31, 0x8b, 0xaa, 0x7a, 0x3e,
+	0xaa, 0x7b, 0xa5, 0x33, 0xe2, 0x76, 0xb5, 0xed, 0xd7, 0x67, 0x17, 0xcd, 0xf2, 0xf9, 0x45, 0xb3,
+	0xfc, 0xf7, 0xa2, 0x59, 0xfe, 0x7e, 0xd9, 0x2c, 0x9d, 0x5f, 0x36, 0x4b, 0xbf, 0x2f, 0x9b, 0xa5,
+	0xfd, 0x75, 0x9f, 0xcb, 0xcf, 0x89, 0x6b, 0x7b, 0x22, 0x6c, 0x5d, 0x05, 0xcd, 0xfe, 0x03, 0xb4,
+	0xbe, 0xe5, 0xbe, 0xe4, 0xf1, 0x21, 0x03, 0xb7, 0xaa, 0x1e, 0xe3, 0x67, 0xff, 0x07, 0x00, 0x70,
+	0x56, 0x13, 0x4e, 0xb6, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -187,6 +282,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastBidIdRecords) > 0 {
+		for iNdEx := len(m.LastBidIdRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastBidIdRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.BidCommitments) > 0 {
+		for iNdEx := len(m.BidCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SettlementCursors) > 0 {
+		for iNdEx := len(m.SettlementCursors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementCursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AllocationClaims) > 0 {
+		for iNdEx := len(m.AllocationClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.LinearVestings) > 0 {
+		for iNdEx := len(m.LinearVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinearVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BidderVestingQueues) > 0 {
+		for iNdEx := len(m.BidderVestingQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderVestingQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AuctionFailures) > 0 {
+		for iNdEx := len(m.AuctionFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BidderSettlements) > 0 {
+		for iNdEx := len(m.BidderSettlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderSettlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AuctionSettlements) > 0 {
+		for iNdEx := len(m.AuctionSettlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionSettlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.VestingQueues) > 0 {
 		for iNdEx := len(m.VestingQueues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -294,6 +515,39 @@ func (m *AllowedBidderRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LastBidIdRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastBidIdRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastBidIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -337,6 +591,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AuctionSettlements) > 0 {
+		for _, e := range m.AuctionSettlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidderSettlements) > 0 {
+		for _, e := range m.BidderSettlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionFailures) > 0 {
+		for _, e := range m.AuctionFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidderVestingQueues) > 0 {
+		for _, e := range m.BidderVestingQueues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LinearVestings) > 0 {
+		for _, e := range m.LinearVestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllocationClaims) > 0 {
+		for _, e := range m.AllocationClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementCursors) > 0 {
+		for _, e := range m.SettlementCursors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidCommitments) > 0 {
+		for _, e := range m.BidCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastBidIdRecords) > 0 {
+		for _, e := range m.LastBidIdRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -354,6 +662,21 @@ func (m *AllowedBidderRecord) Size() (n int) {
 	return n
 }
 
+func (m *LastBidIdRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionId))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovGenesis(uint64(m.BidId))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -558,61 +881,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowedBidderRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedBidderRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedBidderRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSettlements", wireType)
 			}
-			m.AuctionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -622,7 +895,363 @@ func (m *AllowedBidderRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionSettlements = append(m.AuctionSettlements, AuctionSettlement{})
+			if err := m.AuctionSettlements[len(m.AuctionSettlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderSettlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderSettlements = append(m.BidderSettlements, BidderSettlement{})
+			if err := m.BidderSettlements[len(m.BidderSettlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionFailures = append(m.AuctionFailures, AuctionFailure{})
+			if err := m.AuctionFailures[len(m.AuctionFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderVestingQueues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderVestingQueues = append(m.BidderVestingQueues, BidderVestingQueue{})
+			if err := m.BidderVestingQueues[len(m.BidderVestingQueues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinearVestings = append(m.LinearVestings, LinearVesting{})
+			if err := m.LinearVestings[len(m.LinearVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationClaims = append(m.AllocationClaims, AllocationClaim{})
+			if err := m.AllocationClaims[len(m.AllocationClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementCursors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementCursors = append(m.SettlementCursors, SettlementCursor{})
+			if err := m.SettlementCursors[len(m.SettlementCursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidCommitments = append(m.BidCommitments, BidCommitment{})
+			if err := m.BidCommitments[len(m.BidCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBidIdRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastBidIdRecords = append(m.LastBidIdRecords, LastBidIdRecord{})
+			if err := m.LastBidIdRecords[len(m.LastBidIdRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedBidderRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedBidderRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedBidderRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -681,6 +1310,94 @@ func (m *AllowedBidderRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LastBidIdRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastBidIdRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastBidIdRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			}
+			m.BidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
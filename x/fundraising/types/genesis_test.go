@@ -64,6 +64,25 @@ func TestGenesisState_Validate(t *testing.T) {
 		Released:    false,
 	}
 
+	validAuctionSettlement := types.AuctionSettlement{
+		AuctionId:           1,
+		MatchedPrice:        sdk.MustNewDecFromStr("0.5"),
+		TotalSoldAmount:     sdk.NewInt(100_000_000),
+		TotalRaisedAmount:   sdk.NewInt(50_000_000),
+		TotalRefundedAmount: sdk.ZeroInt(),
+		WinnersCount:        1,
+		CloseHeight:         10,
+		CloseTime:           types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+	}
+
+	validBidderSettlement := types.BidderSettlement{
+		AuctionId:       1,
+		Bidder:          validAddr.String(),
+		AllocatedAmount: sdk.NewInt(100_000_000),
+		PaidAmount:      sdk.NewInt(50_000_000),
+		RefundedAmount:  sdk.ZeroInt(),
+	}
+
 	for _, tc := range []struct {
 		desc      string
 		configure func(*types.GenesisState)
@@ -93,9 +112,39 @@ func TestGenesisState_Validate(t *testing.T) {
 				}
 				genState.Bids = []types.Bid{validBid}
 				genState.VestingQueues = []types.VestingQueue{validVestingQueue}
+				genState.LastBidIdRecords = []types.LastBidIdRecord{{AuctionId: 1, BidId: 1}}
+			},
+			valid: true,
+		},
+		{
+			desc: "valid last bid id record - greater than bid ids",
+			configure: func(genState *types.GenesisState) {
+				genState.Bids = []types.Bid{validBid}
+				genState.LastBidIdRecords = []types.LastBidIdRecord{{AuctionId: 1, BidId: 3}}
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid last bid id record - invalid auction id",
+			configure: func(genState *types.GenesisState) {
+				genState.LastBidIdRecords = []types.LastBidIdRecord{{AuctionId: 0, BidId: 1}}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid last bid id record - duplicate auction id",
+			configure: func(genState *types.GenesisState) {
+				genState.LastBidIdRecords = []types.LastBidIdRecord{{AuctionId: 1, BidId: 1}, {AuctionId: 1, BidId: 2}}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bid - bid id greater than last bid id",
+			configure: func(genState *types.GenesisState) {
+				genState.Bids = []types.Bid{validBid}
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid auction - unsupported auction type",
 			configure: func(genState *types.GenesisState) {
@@ -166,6 +215,79 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid auction - selling basket",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.SellingBasket = sdk.NewCoins(sdk.NewInt64Coin("denom3", 1_000_000))
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid auction - selling basket with paying coin denom",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.SellingBasket = sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000_000))
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction - selling basket for batch auction",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.Type = types.AuctionTypeBatch
+				baseAuction.SellingBasket = sdk.NewCoins(sdk.NewInt64Coin("denom3", 1_000_000))
+				auctionAny, _ := types.PackAuction(types.NewBatchAuction(
+					&baseAuction,
+					sdk.MustNewDecFromStr("0.1"),
+					sdk.ZeroDec(),
+					0,
+					sdk.MustNewDecFromStr("0.2"),
+				))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid auction - paying coin rates",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.PayingCoinRates = sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.5")))
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid auction - paying coin rates with selling coin denom",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.PayingCoinRates = sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseAuction.SellingCoin.Denom, sdk.OneDec()))
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction - negative min raise amount",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.MinRaiseAmount = sdk.NewInt(-1)
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid auction - invalid sum of vesting schedule weights",
 			configure: func(genState *types.GenesisState) {
@@ -281,6 +403,239 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid settlements",
+			configure: func(genState *types.GenesisState) {
+				genState.AuctionSettlements = []types.AuctionSettlement{validAuctionSettlement}
+				genState.BidderSettlements = []types.BidderSettlement{validBidderSettlement}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid auction settlement - negative total raised amount",
+			configure: func(genState *types.GenesisState) {
+				settlement := validAuctionSettlement
+				settlement.TotalRaisedAmount = sdk.NewInt(-1)
+				genState.AuctionSettlements = []types.AuctionSettlement{settlement}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid auction failure",
+			configure: func(genState *types.GenesisState) {
+				genState.AuctionFailures = []types.AuctionFailure{
+					{
+						AuctionId:    1,
+						FailedStatus: types.AuctionStatusStarted,
+						Reason:       "insufficient funds",
+						FailHeight:   10,
+						FailTime:     types.MustParseRFC3339("2022-12-01T00:00:00Z"),
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid auction failure - invalid failed status",
+			configure: func(genState *types.GenesisState) {
+				genState.AuctionFailures = []types.AuctionFailure{
+					{
+						AuctionId:    1,
+						FailedStatus: types.AuctionStatusCancelled,
+						Reason:       "insufficient funds",
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction - invalid bidder vesting schedules",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.BidderVestingSchedules = []types.VestingSchedule{
+					{
+						ReleaseTime: baseAuction.EndTimes[0].AddDate(0, 1, 0),
+						Weight:      sdk.MustNewDecFromStr("0.5"),
+					},
+				}
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid bidder vesting queue",
+			configure: func(genState *types.GenesisState) {
+				genState.BidderVestingQueues = []types.BidderVestingQueue{
+					types.NewBidderVestingQueue(1, validAddr, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)), types.MustParseRFC3339("2023-01-01T00:00:00Z"), false),
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid bidder vesting queue - invalid bidder address",
+			configure: func(genState *types.GenesisState) {
+				genState.BidderVestingQueues = []types.BidderVestingQueue{
+					{
+						AuctionId:    1,
+						Bidder:       "invalid",
+						ReleaseCoins: sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid linear vesting",
+			configure: func(genState *types.GenesisState) {
+				genState.LinearVestings = []types.LinearVesting{
+					types.NewLinearVesting(1, validAddr, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)), sdk.NewCoins(sdk.NewInt64Coin("denom1", 50))),
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid linear vesting - claimed coins exceed total coins",
+			configure: func(genState *types.GenesisState) {
+				genState.LinearVestings = []types.LinearVesting{
+					types.NewLinearVesting(1, validAddr, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)), sdk.NewCoins(sdk.NewInt64Coin("denom1", 150))),
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid allocation claim",
+			configure: func(genState *types.GenesisState) {
+				genState.AllocationClaims = []types.AllocationClaim{
+					types.NewAllocationClaim(1, validAddr, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)), sdk.NewCoins(sdk.NewInt64Coin("denom2", 50))),
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid allocation claim - invalid auction id",
+			configure: func(genState *types.GenesisState) {
+				genState.AllocationClaims = []types.AllocationClaim{
+					types.NewAllocationClaim(0, validAddr, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)), sdk.Coins{}),
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allocation claim - invalid refund coins",
+			configure: func(genState *types.GenesisState) {
+				claim := types.NewAllocationClaim(1, validAddr, sdk.Coins{}, sdk.Coins{})
+				claim.RefundCoins = sdk.Coins{sdk.Coin{Denom: "denom2", Amount: sdk.NewInt(-1)}}
+				genState.AllocationClaims = []types.AllocationClaim{claim}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid settlement cursor",
+			configure: func(genState *types.GenesisState) {
+				genState.SettlementCursors = []types.SettlementCursor{
+					{AuctionId: 1},
+					{AuctionId: 2, LastBidder: validAddr.String(), SettledBidders: 1},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid settlement cursor - invalid auction id",
+			configure: func(genState *types.GenesisState) {
+				genState.SettlementCursors = []types.SettlementCursor{{AuctionId: 0}}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid settlement cursor - invalid last bidder",
+			configure: func(genState *types.GenesisState) {
+				genState.SettlementCursors = []types.SettlementCursor{{AuctionId: 1, LastBidder: "invalid", SettledBidders: 1}}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid bid commitment",
+			configure: func(genState *types.GenesisState) {
+				genState.BidCommitments = []types.BidCommitment{
+					types.NewBidCommitment(1, 1, validAddr, make([]byte, types.BidCommitmentLength), sdk.NewInt64Coin("denom2", 100)),
+				}
+				genState.LastBidIdRecords = []types.LastBidIdRecord{{AuctionId: 1, BidId: 1}}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid bid commitment - commitment id greater than last bid id",
+			configure: func(genState *types.GenesisState) {
+				genState.BidCommitments = []types.BidCommitment{
+					types.NewBidCommitment(1, 2, validAddr, make([]byte, types.BidCommitmentLength), sdk.NewInt64Coin("denom2", 100)),
+				}
+				genState.LastBidIdRecords = []types.LastBidIdRecord{{AuctionId: 1, BidId: 1}}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bid commitment - invalid commitment",
+			configure: func(genState *types.GenesisState) {
+				genState.BidCommitments = []types.BidCommitment{
+					types.NewBidCommitment(1, 1, validAddr, []byte("commitment"), sdk.NewInt64Coin("denom2", 100)),
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bid commitment - zero deposit",
+			configure: func(genState *types.GenesisState) {
+				genState.BidCommitments = []types.BidCommitment{
+					types.NewBidCommitment(1, 1, validAddr, make([]byte, types.BidCommitmentLength), sdk.NewInt64Coin("denom2", 0)),
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction - sealed bid auction with extended rounds",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.Type = types.AuctionTypeBatch
+				baseAuction.VestingSchedules = []types.VestingSchedule{}
+				auction := types.NewBatchAuction(&baseAuction, sdk.MustNewDecFromStr("0.1"), sdk.ZeroDec(), 2, sdk.MustNewDecFromStr("0.1"))
+				auction.SealedBidConfig = &types.SealedBidConfig{RevealPeriod: 24 * time.Hour, UnrevealedPenaltyRate: sdk.MustNewDecFromStr("0.1")}
+				auctionAny, _ := types.PackAuction(auction)
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction - linear vesting schedule with vesting schedules",
+			configure: func(genState *types.GenesisState) {
+				baseAuction := *validAuction.BaseAuction
+				baseAuction.VestingSchedules = []types.VestingSchedule{
+					{
+						ReleaseTime: baseAuction.EndTimes[0].AddDate(0, 1, 0),
+						Weight:      sdk.OneDec(),
+					},
+				}
+				baseAuction.LinearVestingSchedule = &types.LinearVestingSchedule{
+					StartTime: baseAuction.EndTimes[0],
+					EndTime:   baseAuction.EndTimes[0].AddDate(1, 0, 0),
+				}
+				auctionAny, _ := types.PackAuction(types.NewFixedPriceAuction(&baseAuction, validAuction.RemainingSellingCoin))
+
+				genState.Auctions = []*codectypes.Any{auctionAny}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bidder settlement - invalid bidder address",
+			configure: func(genState *types.GenesisState) {
+				settlement := validBidderSettlement
+				settlement.Bidder = "invalid"
+				genState.BidderSettlements = []types.BidderSettlement{settlement}
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			genState := types.DefaultGenesisState()
@@ -128,6 +128,68 @@ func (h MultiFundraisingHooks) AfterBatchAuctionCreated(
 	}
 }
 
+func (h MultiFundraisingHooks) BeforeDutchAuctionCreated(
+	ctx sdk.Context,
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+) {
+	for i := range h {
+		h[i].BeforeDutchAuctionCreated(
+			ctx,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			priceDecayStep,
+			priceDecayPeriod,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			startTime,
+			endTime,
+		)
+	}
+}
+
+func (h MultiFundraisingHooks) AfterDutchAuctionCreated(
+	ctx sdk.Context,
+	auctionId uint64,
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+) {
+	for i := range h {
+		h[i].AfterDutchAuctionCreated(
+			ctx,
+			auctionId,
+			auctioneer,
+			startPrice,
+			floorPrice,
+			priceDecayStep,
+			priceDecayPeriod,
+			sellingCoin,
+			payingCoinDenom,
+			vestingSchedules,
+			startTime,
+			endTime,
+		)
+	}
+}
+
 func (h MultiFundraisingHooks) BeforeAuctionCanceled(
 	ctx sdk.Context,
 	auctionId uint64,
@@ -166,6 +228,17 @@ func (h MultiFundraisingHooks) BeforeBidModified(
 	}
 }
 
+func (h MultiFundraisingHooks) BeforeBidCanceled(
+	ctx sdk.Context,
+	auctionId uint64,
+	bidId uint64,
+	bidder string,
+) {
+	for i := range h {
+		h[i].BeforeBidCanceled(ctx, auctionId, bidId, bidder)
+	}
+}
+
 func (h MultiFundraisingHooks) BeforeAllowedBiddersAdded(
 	ctx sdk.Context,
 	allowedBidders []AllowedBidder,
@@ -186,6 +259,16 @@ func (h MultiFundraisingHooks) BeforeAllowedBidderUpdated(
 	}
 }
 
+func (h MultiFundraisingHooks) BeforeAllowedBidderRemoved(
+	ctx sdk.Context,
+	auctionId uint64,
+	bidder sdk.AccAddress,
+) {
+	for i := range h {
+		h[i].BeforeAllowedBidderRemoved(ctx, auctionId, bidder)
+	}
+}
+
 func (h MultiFundraisingHooks) BeforeSellingCoinsAllocated(
 	ctx sdk.Context,
 	auctionId uint64,
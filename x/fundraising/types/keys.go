@@ -23,20 +23,45 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_fundraising"
+
+	// PriceBytesLen is the length of the price bytes in the bid price index key and the price level key,
+	// which is enough to hold the largest sdk.Dec.
+	PriceBytesLen = 40
 )
 
 var (
+	ParamsKey = []byte{0x01} // key to retrieve the module parameters
+
 	LastAuctionIdKey   = []byte{0x11} // key to retrieve the latest auction id
 	LastBidIdKeyPrefix = []byte{0x12}
 
-	AuctionKeyPrefix       = []byte{0x21}
-	AllowedBidderKeyPrefix = []byte{0x22}
+	AuctionKeyPrefix               = []byte{0x21}
+	AllowedBidderKeyPrefix         = []byte{0x22}
+	AuctionStatusIndexKeyPrefix    = []byte{0x23}
+	AuctionStartTimeQueueKeyPrefix = []byte{0x24}
+	AuctionEndTimeQueueKeyPrefix   = []byte{0x25}
+	AuctionFailureKeyPrefix        = []byte{0x26}
+
+	BidKeyPrefix             = []byte{0x31}
+	BidIndexKeyPrefix        = []byte{0x32}
+	MatchedBidsLenPrefix     = []byte{0x33}
+	BidCommitmentKeyPrefix   = []byte{0x34}
+	BidPriceIndexKeyPrefix   = []byte{0x35}
+	PriceLevelKeyPrefix      = []byte{0x36}
+	BidderAggregateKeyPrefix = []byte{0x37}
 
-	BidKeyPrefix         = []byte{0x31}
-	BidIndexKeyPrefix    = []byte{0x32}
-	MatchedBidsLenPrefix = []byte{0x33}
+	VestingQueueKeyPrefix                 = []byte{0x41}
+	VestingQueueReleaseTimeIndexKeyPrefix = []byte{0x42}
 
-	VestingQueueKeyPrefix = []byte{0x41}
+	BidderVestingQueueKeyPrefix                 = []byte{0x43}
+	BidderVestingQueueIndexKeyPrefix            = []byte{0x44}
+	BidderVestingQueueReleaseTimeIndexKeyPrefix = []byte{0x45}
+	LinearVestingKeyPrefix                      = []byte{0x46}
+	AllocationClaimKeyPrefix                    = []byte{0x47}
+
+	AuctionSettlementKeyPrefix = []byte{0x51}
+	BidderSettlementKeyPrefix  = []byte{0x52}
+	SettlementCursorKeyPrefix  = []byte{0x53}
 )
 
 // GetLastBidIdKey returns the store key to retrieve the latest bid id.
@@ -49,6 +74,31 @@ func GetAuctionKey(auctionId uint64) []byte {
 	return append(AuctionKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
 }
 
+// GetAuctionStatusIndexKey returns the index key to retrieve the auction id by the auction status.
+func GetAuctionStatusIndexKey(status AuctionStatus, auctionId uint64) []byte {
+	return append(GetAuctionStatusIndexPrefix(status), sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetAuctionStatusIndexPrefix returns the prefix to iterate all auction ids by the auction status.
+func GetAuctionStatusIndexPrefix(status AuctionStatus) []byte {
+	return append(AuctionStatusIndexKeyPrefix, byte(status))
+}
+
+// GetAuctionStartTimeQueueKey returns the queue key to retrieve the stand by auction id by its start time.
+func GetAuctionStartTimeQueueKey(startTime time.Time, auctionId uint64) []byte {
+	return append(append(AuctionStartTimeQueueKeyPrefix, sdk.FormatTimeBytes(startTime)...), sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetAuctionEndTimeQueueKey returns the queue key to retrieve the started auction id by its last end time.
+func GetAuctionEndTimeQueueKey(endTime time.Time, auctionId uint64) []byte {
+	return append(append(AuctionEndTimeQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...), sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetAuctionFailureKey returns the store key to retrieve the failure object of the auction.
+func GetAuctionFailureKey(auctionId uint64) []byte {
+	return append(AuctionFailureKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
 // GetAllowedBidderKey returns the store key to retrieve the auction's allowed bidder object.
 func GetAllowedBidderKey(auctionId uint64, bidder sdk.AccAddress) []byte {
 	return append(append(AllowedBidderKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), address.MustLengthPrefix(bidder)...)
@@ -79,9 +129,25 @@ func GetBidIndexByBidderPrefix(bidder sdk.AccAddress) []byte {
 	return append(BidIndexKeyPrefix, address.MustLengthPrefix(bidder)...)
 }
 
+// GetBidIndexByBidderAndAuctionIdPrefix returns the prefix to iterate all bids of a bidder by the auction id.
+func GetBidIndexByBidderAndAuctionIdPrefix(bidder sdk.AccAddress, auctionId uint64) []byte {
+	return append(GetBidIndexByBidderPrefix(bidder), sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetBidCommitmentKey returns the store key to retrieve the bid commitment object.
+func GetBidCommitmentKey(auctionId uint64, commitmentId uint64) []byte {
+	return append(GetBidCommitmentsByAuctionPrefix(auctionId), sdk.Uint64ToBigEndian(commitmentId)...)
+}
+
+// GetBidCommitmentsByAuctionPrefix returns the prefix to iterate all bid commitments by the auction id.
+func GetBidCommitmentsByAuctionPrefix(auctionId uint64) []byte {
+	return append(BidCommitmentKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
 // GetVestingQueueKey returns the store key to retrieve the vesting queue from the index fields.
-func GetVestingQueueKey(auctionId uint64, releaseTime time.Time) []byte {
-	return append(append(VestingQueueKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), sdk.FormatTimeBytes(releaseTime)...)
+// The paying coin denom comes last since the auction has a vesting queue for each paying coin denom per release time.
+func GetVestingQueueKey(auctionId uint64, releaseTime time.Time, denom string) []byte {
+	return append(append(append(VestingQueueKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), sdk.FormatTimeBytes(releaseTime)...), []byte(denom)...)
 }
 
 // GetVestingQueueByAuctionIdPrefix returns a key prefix used to iterate vesting queues by an auction id.
@@ -89,6 +155,127 @@ func GetVestingQueueByAuctionIdPrefix(auctionId uint64) []byte {
 	return append(VestingQueueKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
 }
 
+// GetVestingQueueReleaseTimeIndexKey returns the index key to retrieve the auction id by the release time of
+// the vesting queue that is not released yet.
+// The paying coin denom is included since the auction has a vesting queue for each paying coin denom per release time.
+func GetVestingQueueReleaseTimeIndexKey(releaseTime time.Time, denom string, auctionId uint64) []byte {
+	return append(append(append(VestingQueueReleaseTimeIndexKeyPrefix, sdk.FormatTimeBytes(releaseTime)...), address.MustLengthPrefix([]byte(denom))...), sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetBidPriceIndexKey returns the index key to retrieve the bid id of the batch auction by the bid price.
+// The bids are ordered by the bid price in descending order and then by the bid id.
+func GetBidPriceIndexKey(auctionId uint64, price sdk.Dec, bidId uint64) []byte {
+	return append(GetBidPriceIndexByPricePrefix(auctionId, price), sdk.Uint64ToBigEndian(bidId)...)
+}
+
+// GetBidPriceIndexByAuctionIdPrefix returns the prefix to iterate all bids of the batch auction by the bid price.
+func GetBidPriceIndexByAuctionIdPrefix(auctionId uint64) []byte {
+	return append(BidPriceIndexKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetBidPriceIndexByPricePrefix returns the prefix to iterate all bids of the batch auction at the bid price.
+func GetBidPriceIndexByPricePrefix(auctionId uint64, price sdk.Dec) []byte {
+	return append(GetBidPriceIndexByAuctionIdPrefix(auctionId), FormatDescendingPriceBytes(price)...)
+}
+
+// GetPriceLevelKey returns the store key to retrieve the price level object.
+func GetPriceLevelKey(auctionId uint64, price sdk.Dec) []byte {
+	return append(GetPriceLevelsByAuctionIdPrefix(auctionId), FormatDescendingPriceBytes(price)...)
+}
+
+// GetPriceLevelsByAuctionIdPrefix returns the prefix to iterate all price levels of the batch auction
+// in descending order of the price.
+func GetPriceLevelsByAuctionIdPrefix(auctionId uint64) []byte {
+	return append(PriceLevelKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetBidderAggregateKey returns the store key to retrieve the bidder aggregate object.
+func GetBidderAggregateKey(auctionId uint64, bidder sdk.AccAddress) []byte {
+	return append(GetBidderAggregatesByAuctionIdPrefix(auctionId), address.MustLengthPrefix(bidder)...)
+}
+
+// GetBidderAggregatesByAuctionIdPrefix returns the prefix to iterate all bidder aggregates by the auction id.
+func GetBidderAggregatesByAuctionIdPrefix(auctionId uint64) []byte {
+	return append(BidderAggregateKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// FormatDescendingPriceBytes returns the fixed length bytes of the price, which sort in descending order of the price.
+// The price must not be negative.
+func FormatDescendingPriceBytes(price sdk.Dec) []byte {
+	bz := price.BigInt().FillBytes(make([]byte, PriceBytesLen))
+	for i := range bz {
+		bz[i] = ^bz[i]
+	}
+	return bz
+}
+
+// GetBidderVestingQueueKey returns the store key to retrieve the bidder vesting queue from the index fields.
+func GetBidderVestingQueueKey(auctionId uint64, bidder sdk.AccAddress, releaseTime time.Time) []byte {
+	return append(append(GetBidderVestingQueueByAuctionIdPrefix(auctionId), address.MustLengthPrefix(bidder)...), sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// GetBidderVestingQueueByAuctionIdPrefix returns a key prefix used to iterate bidder vesting queues by an auction id.
+func GetBidderVestingQueueByAuctionIdPrefix(auctionId uint64) []byte {
+	return append(BidderVestingQueueKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetBidderVestingQueueIndexKey returns the index key to retrieve the bidder vesting queue by the bidder.
+func GetBidderVestingQueueIndexKey(bidder sdk.AccAddress, auctionId uint64, releaseTime time.Time) []byte {
+	return append(append(GetBidderVestingQueueIndexByBidderPrefix(bidder), sdk.Uint64ToBigEndian(auctionId)...), sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// GetBidderVestingQueueIndexByBidderPrefix returns a key prefix used to iterate bidder vesting queues by a bidder.
+func GetBidderVestingQueueIndexByBidderPrefix(bidder sdk.AccAddress) []byte {
+	return append(BidderVestingQueueIndexKeyPrefix, address.MustLengthPrefix(bidder)...)
+}
+
+// GetBidderVestingQueueReleaseTimeIndexKey returns the index key to retrieve the auction id by the release time of
+// the bidder vesting queue that is not released yet.
+func GetBidderVestingQueueReleaseTimeIndexKey(releaseTime time.Time, bidder sdk.AccAddress, auctionId uint64) []byte {
+	return append(append(append(BidderVestingQueueReleaseTimeIndexKeyPrefix, sdk.FormatTimeBytes(releaseTime)...), address.MustLengthPrefix(bidder)...), sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetLinearVestingKey returns the store key to retrieve the linear vesting object.
+func GetLinearVestingKey(auctionId uint64) []byte {
+	return append(LinearVestingKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetAllocationClaimKey returns the store key to retrieve the allocation claim object.
+func GetAllocationClaimKey(auctionId uint64, bidder sdk.AccAddress) []byte {
+	return append(GetAllocationClaimsByAuctionPrefix(auctionId), address.MustLengthPrefix(bidder)...)
+}
+
+// GetAllocationClaimsByAuctionPrefix returns the prefix to iterate all allocation claims by the auction id.
+func GetAllocationClaimsByAuctionPrefix(auctionId uint64) []byte {
+	return append(AllocationClaimKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetAuctionSettlementKey returns the store key to retrieve the auction settlement object.
+func GetAuctionSettlementKey(auctionId uint64) []byte {
+	return append(AuctionSettlementKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetBidderSettlementKey returns the store key to retrieve the bidder settlement object.
+func GetBidderSettlementKey(auctionId uint64, bidder sdk.AccAddress) []byte {
+	return append(append(BidderSettlementKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), address.MustLengthPrefix(bidder)...)
+}
+
+// GetBidderSettlementsByAuctionPrefix returns the prefix to iterate all bidder settlements by the auction id.
+func GetBidderSettlementsByAuctionPrefix(auctionId uint64) []byte {
+	return append(BidderSettlementKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetSettlementCursorKey returns the store key to retrieve the settlement cursor object.
+func GetSettlementCursorKey(auctionId uint64) []byte {
+	return append(SettlementCursorKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...)
+}
+
+// GetTimeQueueEndKey returns the end key to iterate the time queue with the given prefix
+// until the given time, inclusively.
+func GetTimeQueueEndKey(prefix []byte, t time.Time) []byte {
+	return sdk.PrefixEndBytes(append(append([]byte{}, prefix...), sdk.FormatTimeBytes(t)...))
+}
+
 func GetLastMatchedBidsLenKey(auctionId uint64) []byte {
 	return append(MatchedBidsLenPrefix, sdk.Uint64ToBigEndian(auctionId)...)
 }
@@ -106,6 +293,33 @@ func ParseBidIndexKey(key []byte) (auctionId, bidId uint64) {
 	return
 }
 
+// ParseBidPriceIndexKey parses the auction id and the bid id from the bid price index key.
+func ParseBidPriceIndexKey(key []byte) (auctionId, bidId uint64) {
+	if !bytes.HasPrefix(key, BidPriceIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	auctionId = sdk.BigEndianToUint64(key[1:])
+	bidId = sdk.BigEndianToUint64(key[1+8+PriceBytesLen:])
+	return
+}
+
+// ParseBidderVestingQueueIndexKey parses the auction id and the release time from the bidder vesting queue index key
+// without the bidder prefix.
+func ParseBidderVestingQueueIndexKey(key []byte) (auctionId uint64, releaseTime time.Time) {
+	auctionId = sdk.BigEndianToUint64(key[:8])
+	releaseTime, err := sdk.ParseTimeBytes(key[8:])
+	if err != nil {
+		panic(err)
+	}
+	return
+}
+
+// ParseAuctionIdFromIndexKey parses the auction id from the index or queue key that ends with the auction id.
+func ParseAuctionIdFromIndexKey(key []byte) (auctionId uint64) {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
 // SplitAuctionIdBidIdKey splits the auction id and bid id.
 func SplitAuctionIdBidIdKey(key []byte) (auctionId, bidId uint64) {
 	bytesLen := 8
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 	time "time"
 
@@ -38,6 +39,35 @@ func (s *keysTestSuite) TestGetAllowedBiddersByAuctionKeyPrefix() {
 	s.Require().Equal([]byte{0x22, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetAllowedBiddersByAuctionKeyPrefix(10))
 }
 
+func (s *keysTestSuite) TestGetAuctionStatusIndexKey() {
+	s.Require().Equal([]byte{0x23, 0x1}, types.GetAuctionStatusIndexPrefix(types.AuctionStatusStandBy))
+	s.Require().Equal([]byte{0x23, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9}, types.GetAuctionStatusIndexKey(types.AuctionStatusStarted, 9))
+	s.Require().Equal([]byte{0x23, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetAuctionStatusIndexKey(types.AuctionStatusFinished, 10))
+}
+
+func (s *keysTestSuite) TestAuctionTimeQueueKeys() {
+	t := types.MustParseRFC3339("2022-01-01T00:00:00Z")
+
+	startKey := types.GetAuctionStartTimeQueueKey(t, 10)
+	s.Require().Equal(types.AuctionStartTimeQueueKeyPrefix, startKey[:1])
+	s.Require().Equal(sdk.FormatTimeBytes(t), startKey[1:len(startKey)-8])
+	s.Require().Equal(uint64(10), types.ParseAuctionIdFromIndexKey(startKey))
+
+	endKey := types.GetAuctionEndTimeQueueKey(t, 5)
+	s.Require().Equal(types.AuctionEndTimeQueueKeyPrefix, endKey[:1])
+	s.Require().Equal(uint64(5), types.ParseAuctionIdFromIndexKey(endKey))
+
+	releaseKey := types.GetVestingQueueReleaseTimeIndexKey(t, "denom2", 3)
+	s.Require().Equal(types.VestingQueueReleaseTimeIndexKeyPrefix, releaseKey[:1])
+	s.Require().Equal(uint64(3), types.ParseAuctionIdFromIndexKey(releaseKey))
+	s.Require().NotEqual(releaseKey, types.GetVestingQueueReleaseTimeIndexKey(t, "denom3", 3))
+
+	// The end key must include the keys of the same time and exclude the keys of later times
+	queueEndKey := types.GetTimeQueueEndKey(types.AuctionEndTimeQueueKeyPrefix, t)
+	s.Require().Equal(-1, bytes.Compare(endKey, queueEndKey))
+	s.Require().Equal(1, bytes.Compare(types.GetAuctionEndTimeQueueKey(t.Add(time.Nanosecond), 1), queueEndKey))
+}
+
 func (s *keysTestSuite) TestGetBidByAuctionIdPrefix() {
 	s.Require().Equal([]byte{0x31, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, types.GetBidByAuctionIdPrefix(0))
 	s.Require().Equal([]byte{0x31, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9}, types.GetBidByAuctionIdPrefix(9))
@@ -60,6 +90,10 @@ func (s *keysTestSuite) TestGetBidIndexByBidderPrefix() {
 		0x88, 0x2e, 0xf6, 0x7a, 0x5, 0x31, 0xb3, 0x46, 0xdd, 0x22, 0xb3, 0x62, 0x1e}, types.GetBidIndexByBidderPrefix(bidder2))
 	s.Require().Equal([]byte{0x32, 0x14, 0xe, 0x99, 0x7b, 0x9b, 0x5c, 0xef, 0x81,
 		0x2f, 0xc6, 0x3f, 0xb6, 0x8b, 0x27, 0x42, 0x8a, 0xab, 0x7a, 0x58, 0xbc, 0x5e}, types.GetBidIndexByBidderPrefix(bidder3))
+
+	prefix := types.GetBidIndexByBidderAndAuctionIdPrefix(bidder1, 10)
+	s.Require().Equal(types.GetBidIndexByBidderPrefix(bidder1), prefix[:22])
+	s.Require().Equal([]byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, prefix[22:])
 }
 
 func (s *keysTestSuite) TestGetVestingQueueByAuctionIdPrefix() {
@@ -68,6 +102,28 @@ func (s *keysTestSuite) TestGetVestingQueueByAuctionIdPrefix() {
 	s.Require().Equal([]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetVestingQueueByAuctionIdPrefix(10))
 }
 
+func (s *keysTestSuite) TestGetAuctionFailureKey() {
+	s.Require().Equal([]byte{0x26, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9}, types.GetAuctionFailureKey(9))
+}
+
+func (s *keysTestSuite) TestSettlementKeys() {
+	s.Require().Equal([]byte{0x51, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9}, types.GetAuctionSettlementKey(9))
+	s.Require().Equal([]byte{0x52, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetBidderSettlementsByAuctionPrefix(10))
+
+	bidderAddr := sdk.AccAddress(crypto.AddressHash([]byte("bidder1")))
+	key := types.GetBidderSettlementKey(1, bidderAddr)
+	s.Require().Equal(types.GetBidderSettlementsByAuctionPrefix(1), key[:9])
+	s.Require().Equal(byte(len(bidderAddr)), key[9])
+	s.Require().Equal([]byte(bidderAddr), key[10:])
+
+	s.Require().Equal([]byte{0x53, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9}, types.GetSettlementCursorKey(9))
+}
+
+func (s *keysTestSuite) TestBidCommitmentKeys() {
+	s.Require().Equal([]byte{0x34, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9}, types.GetBidCommitmentsByAuctionPrefix(9))
+	s.Require().Equal([]byte{0x34, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x9, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetBidCommitmentKey(9, 10))
+}
+
 func (s *keysTestSuite) TestGetAllowedBidderKey() {
 	testCases := []struct {
 		auctionId  uint64
@@ -179,33 +235,100 @@ func (s *keysTestSuite) TestVestingQueueKey() {
 	testCases := []struct {
 		auctionId uint64
 		timestamp time.Time
+		denom     string
 		expected  []byte
 	}{
 		{
 			uint64(1),
 			types.MustParseRFC3339("2021-12-01T00:00:00Z"),
+			"denom1",
 			[]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x32, 0x30, 0x32, 0x31,
 				0x2d, 0x31, 0x32, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30,
-				0x3a, 0x30, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30},
+				0x3a, 0x30, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+				0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x31},
 		},
 		{
 			uint64(5),
 			types.MustParseRFC3339("2022-01-05T00:00:00Z"),
+			"denom2",
 			[]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x5, 0x32, 0x30, 0x32, 0x32,
 				0x2d, 0x30, 0x31, 0x2d, 0x30, 0x35, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30,
-				0x3a, 0x30, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30},
+				0x3a, 0x30, 0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+				0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x32},
 		},
 		{
 			uint64(11),
 			types.MustParseRFC3339("2022-07-11T00:00:00Z"),
+			"denom3",
 			[]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xb, 0x32, 0x30, 0x32, 0x32, 0x2d,
 				0x30, 0x37, 0x2d, 0x31, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30,
-				0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30},
+				0x30, 0x2e, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+				0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x33},
 		},
 	}
 
 	for _, tc := range testCases {
-		key := types.GetVestingQueueKey(tc.auctionId, tc.timestamp)
+		key := types.GetVestingQueueKey(tc.auctionId, tc.timestamp, tc.denom)
 		s.Require().Equal(tc.expected, key)
 	}
 }
+
+func (s *keysTestSuite) TestBidderVestingQueueKeys() {
+	t := types.MustParseRFC3339("2022-01-01T00:00:00Z")
+	bidderAddr := sdk.AccAddress(crypto.AddressHash([]byte("bidder1")))
+
+	key := types.GetBidderVestingQueueKey(3, bidderAddr, t)
+	s.Require().Equal(types.GetBidderVestingQueueByAuctionIdPrefix(3), key[:9])
+	s.Require().Equal(byte(len(bidderAddr)), key[9])
+	s.Require().Equal([]byte(bidderAddr), key[10:10+len(bidderAddr)])
+	s.Require().Equal(sdk.FormatTimeBytes(t), key[10+len(bidderAddr):])
+
+	indexKey := types.GetBidderVestingQueueIndexKey(bidderAddr, 3, t)
+	prefix := types.GetBidderVestingQueueIndexByBidderPrefix(bidderAddr)
+	s.Require().Equal(prefix, indexKey[:len(prefix)])
+	auctionId, releaseTime := types.ParseBidderVestingQueueIndexKey(indexKey[len(prefix):])
+	s.Require().Equal(uint64(3), auctionId)
+	s.Require().True(t.Equal(releaseTime))
+
+	releaseKey := types.GetBidderVestingQueueReleaseTimeIndexKey(t, bidderAddr, 3)
+	s.Require().Equal(types.BidderVestingQueueReleaseTimeIndexKeyPrefix, releaseKey[:1])
+	s.Require().Equal(uint64(3), types.ParseAuctionIdFromIndexKey(releaseKey))
+
+	queueEndKey := types.GetTimeQueueEndKey(types.BidderVestingQueueReleaseTimeIndexKeyPrefix, t)
+	s.Require().Equal(-1, bytes.Compare(releaseKey, queueEndKey))
+}
+
+func (s *keysTestSuite) TestBidPriceIndexKeys() {
+	key := types.GetBidPriceIndexKey(3, sdk.MustNewDecFromStr("0.5"), 7)
+	s.Require().Equal(types.GetBidPriceIndexByPricePrefix(3, sdk.MustNewDecFromStr("0.5")), key[:1+8+types.PriceBytesLen])
+	s.Require().Equal(types.GetBidPriceIndexByAuctionIdPrefix(3), key[:9])
+	auctionId, bidId := types.ParseBidPriceIndexKey(key)
+	s.Require().Equal(uint64(3), auctionId)
+	s.Require().Equal(uint64(7), bidId)
+
+	// Higher prices come first, and then lower bid ids at the same price
+	keys := [][]byte{
+		types.GetBidPriceIndexKey(3, sdk.MustNewDecFromStr("100000000000000000000"), 9),
+		types.GetBidPriceIndexKey(3, sdk.MustNewDecFromStr("1.5"), 2),
+		types.GetBidPriceIndexKey(3, sdk.MustNewDecFromStr("0.5"), 1),
+		types.GetBidPriceIndexKey(3, sdk.MustNewDecFromStr("0.5"), 7),
+		types.GetBidPriceIndexKey(3, sdk.MustNewDecFromStr("0.000000000000000001"), 1),
+	}
+	for i := 1; i < len(keys); i++ {
+		s.Require().Equal(-1, bytes.Compare(keys[i-1], keys[i]))
+	}
+
+	levelKey := types.GetPriceLevelKey(3, sdk.MustNewDecFromStr("0.5"))
+	s.Require().Equal(types.GetPriceLevelsByAuctionIdPrefix(3), levelKey[:9])
+	s.Require().Equal(types.FormatDescendingPriceBytes(sdk.MustNewDecFromStr("0.5")), levelKey[9:])
+	s.Require().Equal(-1, bytes.Compare(types.GetPriceLevelKey(3, sdk.MustNewDecFromStr("0.6")), levelKey))
+}
+
+func (s *keysTestSuite) TestBidderAggregateKeys() {
+	bidderAddr := sdk.AccAddress(crypto.AddressHash([]byte("bidder1")))
+	key := types.GetBidderAggregateKey(3, bidderAddr)
+	s.Require().Equal(types.BidderAggregateKeyPrefix, key[:1])
+	s.Require().Equal(types.GetBidderAggregatesByAuctionIdPrefix(3), key[:9])
+	s.Require().Equal(byte(len(bidderAddr)), key[9])
+	s.Require().Equal([]byte(bidderAddr), key[10:])
+}
@@ -1,6 +1,12 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 type MatchResult struct {
 	MatchPrice          sdk.Dec
@@ -15,7 +21,14 @@ type BidderMatchResult struct {
 }
 
 // Match returns the match result for all bids that correspond with the auction.
-func Match(matchPrice sdk.Dec, prices []sdk.Dec, bidsByPrice map[string][]Bid, sellingAmt sdk.Int, allowedBidders []AllowedBidder) (res *MatchResult, matched bool) {
+// The default maximum bid amount is applied to the bidder who is not in the allowed bidders;
+// it is zero unless the auction is open for bidding.
+// The worth of a bid in one of the paying coin rates denoms is converted to the paying coin denom by its rate.
+// Unless the partial fill mode is PartialFillModeNil, the bids at the match price are filled with the selling amount
+// remaining after the bids above the price are filled fully, so that they don't have to fit in the selling amount.
+// For PricingRulePayAsBid, each bid is filled at its own bid price instead of the match price.
+// The paying amount of a bid is capped at the paying amount reserved for the bid.
+func Match(matchPrice sdk.Dec, prices []sdk.Dec, bidsByPrice map[string][]Bid, sellingAmt sdk.Int, allowedBidders []AllowedBidder, defaultMaxBidAmt sdk.Int, payingCoinDenom string, payingCoinRates sdk.DecCoins, partialFillMode PartialFillMode, pricingRule PricingRule) (res *MatchResult, matched bool) {
 	res = &MatchResult{
 		MatchPrice:          matchPrice,
 		MatchedAmount:       sdk.ZeroInt(),
@@ -27,48 +40,134 @@ func Match(matchPrice sdk.Dec, prices []sdk.Dec, bidsByPrice map[string][]Bid, s
 		biddableAmtByBidder[allowedBidder.Bidder] = allowedBidder.MaxBidAmount
 	}
 
+	fillPrice := func(bid Bid) sdk.Dec {
+		if pricingRule == PricingRulePayAsBid {
+			return bid.Price
+		}
+		return matchPrice
+	}
+
+	fill := func(bid Bid, matchAmt sdk.Int) {
+		// The paying amount is ceiled, but the bid never pays more than the amount reserved for it
+		payingAmt := sdk.MinInt(
+			fillPrice(bid).MulInt(matchAmt).Ceil().TruncateInt(),
+			bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates),
+		)
+
+		bidderRes, ok := res.MatchResultByBidder[bid.Bidder]
+		if !ok {
+			bidderRes = &BidderMatchResult{
+				PayingAmount:  sdk.ZeroInt(),
+				MatchedAmount: sdk.ZeroInt(),
+			}
+			res.MatchResultByBidder[bid.Bidder] = bidderRes
+		}
+		bidderRes.MatchedAmount = bidderRes.MatchedAmount.Add(matchAmt)
+		bidderRes.PayingAmount = bidderRes.PayingAmount.Add(payingAmt)
+
+		if matchAmt.IsPositive() {
+			res.MatchedBids = append(res.MatchedBids, bid)
+			res.MatchedAmount = res.MatchedAmount.Add(matchAmt)
+			matched = true
+		}
+	}
+
+	var marginalBids []Bid
+	var marginalAmts []sdk.Int
+
 	for _, price := range prices {
 		if price.LT(matchPrice) {
 			break
 		}
+		partial := partialFillMode != PartialFillModeNil && price.Equal(matchPrice)
 
 		for _, bid := range bidsByPrice[price.String()] {
-			var bidAmt sdk.Int
-			switch bid.Type {
-			case BidTypeBatchWorth:
-				bidAmt = sdk.NewDecFromInt(bid.Coin.Amount).QuoTruncate(matchPrice).TruncateInt()
-			case BidTypeBatchMany:
-				bidAmt = bid.Coin.Amount
+			bidAmt := bid.ConvertToMatchAmount(payingCoinDenom, payingCoinRates, fillPrice(bid))
+			biddableAmt, ok := biddableAmtByBidder[bid.Bidder]
+			if !ok {
+				biddableAmt = defaultMaxBidAmt
+			}
+			matchAmt := sdk.MinInt(bidAmt, biddableAmt)
+			biddableAmtByBidder[bid.Bidder] = biddableAmt.Sub(matchAmt)
+
+			if partial {
+				// The bids at the match price are filled after all the bids above the price are filled.
+				marginalBids = append(marginalBids, bid)
+				marginalAmts = append(marginalAmts, matchAmt)
+				continue
 			}
-			biddableAmt := biddableAmtByBidder[bid.Bidder]
-			matchAmt := sdk.MinInt(bidAmt, biddableAmtByBidder[bid.Bidder])
 
 			if res.MatchedAmount.Add(matchAmt).GT(sellingAmt) {
 				// Including this bid will exceed the auction's selling amount.
 				return nil, false
 			}
 
-			payingAmt := matchPrice.MulInt(matchAmt).Ceil().TruncateInt()
+			fill(bid, matchAmt)
+		}
+	}
 
-			bidderRes, ok := res.MatchResultByBidder[bid.Bidder]
-			if !ok {
-				bidderRes = &BidderMatchResult{
-					PayingAmount:  sdk.ZeroInt(),
-					MatchedAmount: sdk.ZeroInt(),
-				}
-				res.MatchResultByBidder[bid.Bidder] = bidderRes
-			}
-			bidderRes.MatchedAmount = bidderRes.MatchedAmount.Add(matchAmt)
-			bidderRes.PayingAmount = bidderRes.PayingAmount.Add(payingAmt)
-
-			if matchAmt.IsPositive() {
-				biddableAmtByBidder[bid.Bidder] = biddableAmt.Sub(matchAmt)
-				res.MatchedBids = append(res.MatchedBids, bid)
-				res.MatchedAmount = res.MatchedAmount.Add(matchAmt)
-				matched = true
-			}
+	if len(marginalBids) > 0 {
+		fillAmts := FillMarginalBids(partialFillMode, marginalBids, marginalAmts, sellingAmt.Sub(res.MatchedAmount))
+		for i, bid := range marginalBids {
+			fill(bid, fillAmts[i])
 		}
 	}
 
 	return res, matched
 }
+
+// FillMarginalBids returns the amounts of the selling coin filled for the bids at the match price
+// out of the remaining selling amount, where bidAmts are the amounts that the bids would be filled fully.
+// The bids are filled fully if the remaining selling amount is enough.
+// Otherwise, they are filled in proportion to their amounts for PartialFillModeProRata,
+// where the fractions are truncated, or in the order of the bid ids for PartialFillModeTimePriority.
+func FillMarginalBids(partialFillMode PartialFillMode, bids []Bid, bidAmts []sdk.Int, remainingAmt sdk.Int) []sdk.Int {
+	totalAmt := sdk.ZeroInt()
+	for _, bidAmt := range bidAmts {
+		totalAmt = totalAmt.Add(bidAmt)
+	}
+	if totalAmt.LTE(remainingAmt) {
+		return bidAmts
+	}
+
+	fillAmts := make([]sdk.Int, len(bids))
+	switch partialFillMode {
+	case PartialFillModeProRata:
+		for i, bidAmt := range bidAmts {
+			fillAmts[i] = bidAmt.Mul(remainingAmt).Quo(totalAmt)
+		}
+	case PartialFillModeTimePriority:
+		indices := make([]int, len(bids))
+		for i := range indices {
+			indices[i] = i
+		}
+		sort.SliceStable(indices, func(i, j int) bool {
+			return bids[indices[i]].Id < bids[indices[j]].Id
+		})
+		for _, i := range indices {
+			fillAmts[i] = sdk.MinInt(bidAmts[i], remainingAmt)
+			remainingAmt = remainingAmt.Sub(fillAmts[i])
+		}
+	default:
+		panic(fmt.Sprintf("invalid partial fill mode: %s", partialFillMode))
+	}
+	return fillAmts
+}
+
+// ValidatePartialFillMode validates the partial fill mode of the batch auction.
+func ValidatePartialFillMode(partialFillMode PartialFillMode) error {
+	switch partialFillMode {
+	case PartialFillModeNil, PartialFillModeProRata, PartialFillModeTimePriority:
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid partial fill mode: %s", partialFillMode)
+}
+
+// ValidatePricingRule validates the pricing rule of the batch auction.
+func ValidatePricingRule(pricingRule PricingRule) error {
+	switch pricingRule {
+	case PricingRuleUniform, PricingRulePayAsBid:
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pricing rule: %s", pricingRule)
+}
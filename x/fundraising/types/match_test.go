@@ -28,8 +28,11 @@ func TestMatch(t *testing.T) {
 	const (
 		payingCoinDenom  = "paying"
 		sellingCoinDenom = "selling"
+		otherCoinDenom   = "other"
 	)
 
+	payingCoinRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec(otherCoinDenom, parseDec("0.5")))
+
 	newBid := func(id uint64, typ types.BidType, bidder string, price sdk.Dec, bidAmt sdk.Int) types.Bid {
 		var coin sdk.Coin
 		switch typ {
@@ -57,6 +60,7 @@ func TestMatch(t *testing.T) {
 	for _, tc := range []struct {
 		name                string
 		allowedBidders      map[string]sdk.Int
+		defaultMaxBidAmt    sdk.Int
 		sellingCoinAmt      sdk.Int
 		bids                []types.Bid
 		matchPrice          sdk.Dec
@@ -70,6 +74,7 @@ func TestMatch(t *testing.T) {
 			map[string]sdk.Int{
 				bidders[0]: sdk.NewInt(100_000000),
 			},
+			sdk.ZeroInt(),
 			sdk.NewInt(100_000000),
 			[]types.Bid{
 				newBid(1, types.BidTypeBatchWorth, bidders[0], parseDec("1.0"), sdk.NewInt(100_000000)),
@@ -90,6 +95,7 @@ func TestMatch(t *testing.T) {
 			map[string]sdk.Int{
 				bidders[0]: sdk.NewInt(50_000000),
 			},
+			sdk.ZeroInt(),
 			sdk.NewInt(100_000000),
 			[]types.Bid{
 				newBid(1, types.BidTypeBatchWorth, bidders[0], parseDec("1.0"), sdk.NewInt(100_000000)),
@@ -110,6 +116,7 @@ func TestMatch(t *testing.T) {
 			map[string]sdk.Int{
 				bidders[0]: sdk.NewInt(100_000000),
 			},
+			sdk.ZeroInt(),
 			sdk.NewInt(100_000000),
 			[]types.Bid{
 				newBid(1, types.BidTypeBatchWorth, bidders[0], parseDec("1.0"), sdk.NewInt(100_000000)),
@@ -117,6 +124,82 @@ func TestMatch(t *testing.T) {
 			parseDec("1.1"),
 			false, sdk.Int{}, nil, nil,
 		},
+		{
+			"open bidding with the default maximum bid amount",
+			map[string]sdk.Int{
+				bidders[0]: sdk.NewInt(80_000000),
+			},
+			sdk.NewInt(30_000000),
+			sdk.NewInt(100_000000),
+			[]types.Bid{
+				newBid(1, types.BidTypeBatchWorth, bidders[0], parseDec("1.0"), sdk.NewInt(50_000000)),
+				newBid(2, types.BidTypeBatchMany, bidders[1], parseDec("1.0"), sdk.NewInt(50_000000)),
+			},
+			parseDec("1.0"),
+			true,
+			sdk.NewInt(80_000000),
+			[]uint64{1, 2},
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {
+					PayingAmount:  sdk.NewInt(50_000000),
+					MatchedAmount: sdk.NewInt(50_000000),
+				},
+				bidders[1]: {
+					PayingAmount:  sdk.NewInt(30_000000),
+					MatchedAmount: sdk.NewInt(30_000000),
+				},
+			},
+		},
+		{
+			"bid in the other paying coin denom",
+			nil,
+			sdk.NewInt(100_000000),
+			sdk.NewInt(100_000000),
+			[]types.Bid{
+				{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchWorth, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin(otherCoinDenom, 100_000000)},
+				newBid(2, types.BidTypeBatchWorth, bidders[1], parseDec("1.0"), sdk.NewInt(30_000000)),
+			},
+			parseDec("1.0"),
+			true,
+			sdk.NewInt(80_000000),
+			[]uint64{1, 2},
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {
+					PayingAmount:  sdk.NewInt(50_000000),
+					MatchedAmount: sdk.NewInt(50_000000),
+				},
+				bidders[1]: {
+					PayingAmount:  sdk.NewInt(30_000000),
+					MatchedAmount: sdk.NewInt(30_000000),
+				},
+			},
+		},
+		{
+			"bidder who is not allowed",
+			map[string]sdk.Int{
+				bidders[0]: sdk.NewInt(100_000000),
+			},
+			sdk.ZeroInt(),
+			sdk.NewInt(100_000000),
+			[]types.Bid{
+				newBid(1, types.BidTypeBatchWorth, bidders[0], parseDec("1.0"), sdk.NewInt(50_000000)),
+				newBid(2, types.BidTypeBatchMany, bidders[1], parseDec("1.0"), sdk.NewInt(50_000000)),
+			},
+			parseDec("1.0"),
+			true,
+			sdk.NewInt(50_000000),
+			[]uint64{1},
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {
+					PayingAmount:  sdk.NewInt(50_000000),
+					MatchedAmount: sdk.NewInt(50_000000),
+				},
+				bidders[1]: {
+					PayingAmount:  sdk.ZeroInt(),
+					MatchedAmount: sdk.ZeroInt(),
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			var allowedBidders []types.AllowedBidder
@@ -127,7 +210,7 @@ func TestMatch(t *testing.T) {
 				})
 			}
 			prices, bidsByPrice := types.BidsByPrice(tc.bids)
-			matchRes, matched := types.Match(tc.matchPrice, prices, bidsByPrice, tc.sellingCoinAmt, allowedBidders)
+			matchRes, matched := types.Match(tc.matchPrice, prices, bidsByPrice, tc.sellingCoinAmt, allowedBidders, tc.defaultMaxBidAmt, payingCoinDenom, payingCoinRates, types.PartialFillModeNil, types.PricingRuleUniform)
 			require.Equal(t, tc.matched, matched)
 			if matched {
 				require.True(sdk.IntEq(t, tc.matchedAmt, matchRes.MatchedAmount))
@@ -141,3 +224,186 @@ func TestMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestMatch_PartialFill(t *testing.T) {
+	const (
+		payingCoinDenom  = "paying"
+		sellingCoinDenom = "selling"
+	)
+
+	var bidders []string
+	for i := 0; i < 3; i++ {
+		bidders = append(bidders, testAddr(i).String())
+	}
+
+	bids := []types.Bid{
+		{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchMany, Price: parseDec("2.0"), Coin: sdk.NewInt64Coin(sellingCoinDenom, 40_000000)},
+		{Id: 3, Bidder: bidders[2], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin(sellingCoinDenom, 40_000000)},
+		{Id: 2, Bidder: bidders[1], Type: types.BidTypeBatchWorth, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin(payingCoinDenom, 60_000000)},
+	}
+
+	for _, tc := range []struct {
+		name                string
+		partialFillMode     types.PartialFillMode
+		matched             bool
+		matchedAmt          sdk.Int
+		matchedBidIds       []uint64
+		matchResultByBidder map[string]*types.BidderMatchResult
+	}{
+		{
+			"no partial fill",
+			types.PartialFillModeNil,
+			false,
+			sdk.Int{},
+			nil,
+			nil,
+		},
+		{
+			"pro rata",
+			types.PartialFillModeProRata,
+			true,
+			sdk.NewInt(100_000000),
+			[]uint64{1, 2, 3},
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {PayingAmount: sdk.NewInt(40_000000), MatchedAmount: sdk.NewInt(40_000000)},
+				bidders[1]: {PayingAmount: sdk.NewInt(36_000000), MatchedAmount: sdk.NewInt(36_000000)},
+				bidders[2]: {PayingAmount: sdk.NewInt(24_000000), MatchedAmount: sdk.NewInt(24_000000)},
+			},
+		},
+		{
+			"time priority",
+			types.PartialFillModeTimePriority,
+			true,
+			sdk.NewInt(100_000000),
+			[]uint64{1, 2},
+			map[string]*types.BidderMatchResult{
+				bidders[0]: {PayingAmount: sdk.NewInt(40_000000), MatchedAmount: sdk.NewInt(40_000000)},
+				bidders[1]: {PayingAmount: sdk.NewInt(60_000000), MatchedAmount: sdk.NewInt(60_000000)},
+				bidders[2]: {PayingAmount: sdk.ZeroInt(), MatchedAmount: sdk.ZeroInt()},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allowedBidders := []types.AllowedBidder{
+				{Bidder: bidders[0], MaxBidAmount: sdk.NewInt(100_000000)},
+				{Bidder: bidders[1], MaxBidAmount: sdk.NewInt(100_000000)},
+				{Bidder: bidders[2], MaxBidAmount: sdk.NewInt(100_000000)},
+			}
+			prices, bidsByPrice := types.BidsByPrice(bids)
+			matchRes, matched := types.Match(parseDec("1.0"), prices, bidsByPrice, sdk.NewInt(100_000000), allowedBidders, sdk.ZeroInt(), payingCoinDenom, nil, tc.partialFillMode, types.PricingRuleUniform)
+			require.Equal(t, tc.matched, matched)
+			if matched {
+				require.True(sdk.IntEq(t, tc.matchedAmt, matchRes.MatchedAmount))
+				var matchedBidIds []uint64
+				for _, bid := range matchRes.MatchedBids {
+					matchedBidIds = append(matchedBidIds, bid.Id)
+				}
+				require.Equal(t, tc.matchedBidIds, matchedBidIds)
+				require.Equal(t, tc.matchResultByBidder, matchRes.MatchResultByBidder)
+			}
+		})
+	}
+}
+
+func TestFillMarginalBids(t *testing.T) {
+	bids := []types.Bid{{Id: 3}, {Id: 1}, {Id: 2}}
+	bidAmts := []sdk.Int{sdk.NewInt(30), sdk.NewInt(20), sdk.NewInt(50)}
+
+	for _, tc := range []struct {
+		name            string
+		partialFillMode types.PartialFillMode
+		remainingAmt    sdk.Int
+		fillAmts        []sdk.Int
+	}{
+		{"pro rata; enough", types.PartialFillModeProRata, sdk.NewInt(100), bidAmts},
+		{"time priority; enough", types.PartialFillModeTimePriority, sdk.NewInt(150), bidAmts},
+		{"pro rata", types.PartialFillModeProRata, sdk.NewInt(50), []sdk.Int{sdk.NewInt(15), sdk.NewInt(10), sdk.NewInt(25)}},
+		{"pro rata; truncated", types.PartialFillModeProRata, sdk.NewInt(33), []sdk.Int{sdk.NewInt(9), sdk.NewInt(6), sdk.NewInt(16)}},
+		{"time priority", types.PartialFillModeTimePriority, sdk.NewInt(50), []sdk.Int{sdk.ZeroInt(), sdk.NewInt(20), sdk.NewInt(30)}},
+		{"time priority; nothing remains", types.PartialFillModeTimePriority, sdk.ZeroInt(), []sdk.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.fillAmts, types.FillMarginalBids(tc.partialFillMode, bids, bidAmts, tc.remainingAmt))
+		})
+	}
+}
+
+func TestMatch_PayAsBid(t *testing.T) {
+	const (
+		payingCoinDenom  = "paying"
+		sellingCoinDenom = "selling"
+	)
+
+	var bidders []string
+	for i := 0; i < 3; i++ {
+		bidders = append(bidders, testAddr(i).String())
+	}
+
+	bids := []types.Bid{
+		{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchMany, Price: parseDec("2.0"), Coin: sdk.NewInt64Coin(sellingCoinDenom, 40_000000)},
+		{Id: 2, Bidder: bidders[1], Type: types.BidTypeBatchWorth, Price: parseDec("1.5"), Coin: sdk.NewInt64Coin(payingCoinDenom, 60_000000)},
+		{Id: 3, Bidder: bidders[2], Type: types.BidTypeBatchMany, Price: parseDec("1.0"), Coin: sdk.NewInt64Coin(sellingCoinDenom, 20_000000)},
+	}
+	allowedBidders := []types.AllowedBidder{
+		{Bidder: bidders[0], MaxBidAmount: sdk.NewInt(100_000000)},
+		{Bidder: bidders[1], MaxBidAmount: sdk.NewInt(100_000000)},
+		{Bidder: bidders[2], MaxBidAmount: sdk.NewInt(100_000000)},
+	}
+	prices, bidsByPrice := types.BidsByPrice(bids)
+
+	// The worth bid takes 60 selling coins at the match price, which exceeds the selling amount
+	_, matched := types.Match(parseDec("1.0"), prices, bidsByPrice, sdk.NewInt(100_000000), allowedBidders, sdk.ZeroInt(), payingCoinDenom, nil, types.PartialFillModeNil, types.PricingRuleUniform)
+	require.False(t, matched)
+
+	// The worth bid takes only 40 selling coins at its own bid price
+	matchRes, matched := types.Match(parseDec("1.0"), prices, bidsByPrice, sdk.NewInt(100_000000), allowedBidders, sdk.ZeroInt(), payingCoinDenom, nil, types.PartialFillModeNil, types.PricingRulePayAsBid)
+	require.True(t, matched)
+	require.True(sdk.IntEq(t, sdk.NewInt(100_000000), matchRes.MatchedAmount))
+	require.Equal(t, map[string]*types.BidderMatchResult{
+		bidders[0]: {PayingAmount: sdk.NewInt(80_000000), MatchedAmount: sdk.NewInt(40_000000)},
+		bidders[1]: {PayingAmount: sdk.NewInt(60_000000), MatchedAmount: sdk.NewInt(40_000000)},
+		bidders[2]: {PayingAmount: sdk.NewInt(20_000000), MatchedAmount: sdk.NewInt(20_000000)},
+	}, matchRes.MatchResultByBidder)
+}
+
+func TestMatch_PayAsBidProRata(t *testing.T) {
+	const (
+		payingCoinDenom  = "paying"
+		sellingCoinDenom = "selling"
+		rateCoinDenom    = "rate"
+	)
+	payingCoinRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec(rateCoinDenom, parseDec("0.3")))
+
+	var bidders []string
+	for i := 0; i < 3; i++ {
+		bidders = append(bidders, testAddr(i).String())
+	}
+
+	// The reserved amounts of the bids at the match price are not multiples of the price
+	bids := []types.Bid{
+		{Id: 1, Bidder: bidders[0], Type: types.BidTypeBatchMany, Price: parseDec("1.3"), Coin: sdk.NewInt64Coin(sellingCoinDenom, 700)},
+		{Id: 2, Bidder: bidders[1], Type: types.BidTypeBatchWorth, Price: parseDec("0.7"), Coin: sdk.NewInt64Coin(rateCoinDenom, 1001)},
+		{Id: 3, Bidder: bidders[2], Type: types.BidTypeBatchWorth, Price: parseDec("0.7"), Coin: sdk.NewInt64Coin(payingCoinDenom, 333)},
+	}
+	allowedBidders := []types.AllowedBidder{
+		{Bidder: bidders[0], MaxBidAmount: sdk.NewInt(1_000)},
+		{Bidder: bidders[1], MaxBidAmount: sdk.NewInt(1_000)},
+		{Bidder: bidders[2], MaxBidAmount: sdk.NewInt(1_000)},
+	}
+	prices, bidsByPrice := types.BidsByPrice(bids)
+
+	matchRes, matched := types.Match(parseDec("0.7"), prices, bidsByPrice, sdk.NewInt(1_000), allowedBidders, sdk.ZeroInt(), payingCoinDenom, payingCoinRates, types.PartialFillModeProRata, types.PricingRulePayAsBid)
+	require.True(t, matched)
+	require.True(sdk.IntEq(t, sdk.NewInt(999), matchRes.MatchedAmount))
+	require.Equal(t, map[string]*types.BidderMatchResult{
+		bidders[0]: {PayingAmount: sdk.NewInt(910), MatchedAmount: sdk.NewInt(700)},
+		bidders[1]: {PayingAmount: sdk.NewInt(100), MatchedAmount: sdk.NewInt(142)},
+		bidders[2]: {PayingAmount: sdk.NewInt(110), MatchedAmount: sdk.NewInt(157)},
+	}, matchRes.MatchResultByBidder)
+
+	// No bidder pays more than the reserved amount, so that the refund amount is never negative
+	for _, bid := range bids {
+		reservedAmt := bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates)
+		require.True(t, matchRes.MatchResultByBidder[bid.Bidder].PayingAmount.LTE(reservedAmt), bid.Id)
+	}
+}
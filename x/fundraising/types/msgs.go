@@ -11,20 +11,42 @@ import (
 var (
 	_ sdk.Msg = (*MsgCreateFixedPriceAuction)(nil)
 	_ sdk.Msg = (*MsgCreateBatchAuction)(nil)
+	_ sdk.Msg = (*MsgCreateDutchAuction)(nil)
 	_ sdk.Msg = (*MsgCancelAuction)(nil)
 	_ sdk.Msg = (*MsgPlaceBid)(nil)
 	_ sdk.Msg = (*MsgModifyBid)(nil)
+	_ sdk.Msg = (*MsgCancelBid)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgResolveFailedAuction)(nil)
+	_ sdk.Msg = (*MsgAddAllowedBidders)(nil)
+	_ sdk.Msg = (*MsgUpdateAllowedBidder)(nil)
+	_ sdk.Msg = (*MsgRemoveAllowedBidder)(nil)
 	_ sdk.Msg = (*MsgAddAllowedBidder)(nil)
+	_ sdk.Msg = (*MsgClaimVested)(nil)
+	_ sdk.Msg = (*MsgClaimAllocation)(nil)
+	_ sdk.Msg = (*MsgCommitBid)(nil)
+	_ sdk.Msg = (*MsgRevealBid)(nil)
 )
 
 // Message types for the fundraising module.
 const (
 	TypeMsgCreateFixedPriceAuction = "create_fixed_price_auction"
 	TypeMsgCreateBatchAuction      = "create_batch_auction"
+	TypeMsgCreateDutchAuction      = "create_dutch_auction"
 	TypeMsgCancelAuction           = "cancel_auction"
 	TypeMsgPlaceBid                = "place_bid"
 	TypeMsgModifyBid               = "modify_bid"
+	TypeMsgCancelBid               = "cancel_bid"
+	TypeMsgUpdateParams            = "update_params"
+	TypeMsgResolveFailedAuction    = "resolve_failed_auction"
+	TypeMsgAddAllowedBidders       = "add_allowed_bidders"
+	TypeMsgUpdateAllowedBidder     = "update_allowed_bidder"
+	TypeMsgRemoveAllowedBidder     = "remove_allowed_bidder"
 	TypeMsgAddAllowedBidder        = "add_allowed_bidder"
+	TypeMsgClaimVested             = "claim_vested"
+	TypeMsgClaimAllocation         = "claim_allocation"
+	TypeMsgCommitBid               = "commit_bid"
+	TypeMsgRevealBid               = "reveal_bid"
 )
 
 // NewMsgCreateFixedPriceAuction creates a new MsgCreateFixedPriceAuction.
@@ -36,15 +58,35 @@ func NewMsgCreateFixedPriceAuction(
 	vestingSchedules []VestingSchedule,
 	startTime time.Time,
 	endTime time.Time,
+	auctioneerManagedAllowlist bool,
+	openBidding bool,
+	defaultMaxBidAmount sdk.Int,
+	sellingBasket sdk.Coins,
+	payingCoinRates sdk.DecCoins,
+	minRaiseAmount sdk.Int,
+	closeWhenSoldOut bool,
+	bidderVestingSchedules []VestingSchedule,
+	linearVestingSchedule *LinearVestingSchedule,
+	claimMode bool,
 ) *MsgCreateFixedPriceAuction {
 	return &MsgCreateFixedPriceAuction{
-		Auctioneer:       auctioneer,
-		StartPrice:       startPrice,
-		SellingCoin:      sellingCoin,
-		PayingCoinDenom:  payingCoinDenom,
-		VestingSchedules: vestingSchedules,
-		StartTime:        startTime,
-		EndTime:          endTime,
+		Auctioneer:                 auctioneer,
+		StartPrice:                 startPrice,
+		SellingCoin:                sellingCoin,
+		PayingCoinDenom:            payingCoinDenom,
+		VestingSchedules:           vestingSchedules,
+		StartTime:                  startTime,
+		EndTime:                    endTime,
+		AuctioneerManagedAllowlist: auctioneerManagedAllowlist,
+		OpenBidding:                openBidding,
+		DefaultMaxBidAmount:        defaultMaxBidAmount,
+		SellingBasket:              sellingBasket,
+		PayingCoinRates:            payingCoinRates,
+		MinRaiseAmount:             minRaiseAmount,
+		CloseWhenSoldOut:           closeWhenSoldOut,
+		BidderVestingSchedules:     bidderVestingSchedules,
+		LinearVestingSchedule:      linearVestingSchedule,
+		ClaimMode:                  claimMode,
 	}
 }
 
@@ -77,6 +119,33 @@ func (msg MsgCreateFixedPriceAuction) ValidateBasic() error {
 	if err := ValidateVestingSchedules(msg.VestingSchedules, msg.EndTime); err != nil {
 		return err
 	}
+	if err := ValidateVestingSchedules(msg.BidderVestingSchedules, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(err, "invalid bidder vesting schedules")
+	}
+	if err := ValidateClaimMode(msg.ClaimMode, msg.BidderVestingSchedules); err != nil {
+		return err
+	}
+	if err := ValidateLinearVestingSchedule(msg.LinearVestingSchedule, msg.VestingSchedules, msg.EndTime); err != nil {
+		return err
+	}
+	if err := ValidateOpenBidding(msg.OpenBidding, msg.DefaultMaxBidAmount, msg.SellingCoin); err != nil {
+		return err
+	}
+	if err := ValidateSellingBasket(msg.SellingBasket, msg.SellingCoin, msg.PayingCoinDenom); err != nil {
+		return err
+	}
+	if err := ValidatePayingCoinRates(msg.PayingCoinRates, msg.SellingCoin, msg.PayingCoinDenom, msg.SellingBasket); err != nil {
+		return err
+	}
+	if err := ValidateMinRaiseAmount(msg.MinRaiseAmount); err != nil {
+		return err
+	}
+	if !msg.MinRaiseAmount.IsNil() {
+		maxRaiseAmt := msg.StartPrice.MulInt(msg.SellingCoin.Amount).TruncateInt()
+		if msg.MinRaiseAmount.GT(maxRaiseAmt) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min raise amount %s must not be greater than the amount %s that the auction can raise", msg.MinRaiseAmount, maxRaiseAmt)
+		}
+	}
 	return nil
 }
 
@@ -112,18 +181,40 @@ func NewMsgCreateBatchAuction(
 	extendedRoundRate sdk.Dec,
 	startTime time.Time,
 	endTime time.Time,
+	auctioneerManagedAllowlist bool,
+	openBidding bool,
+	defaultMaxBidAmount sdk.Int,
+	payingCoinRates sdk.DecCoins,
+	minRaiseAmount sdk.Int,
+	bidderVestingSchedules []VestingSchedule,
+	linearVestingSchedule *LinearVestingSchedule,
+	claimMode bool,
+	sealedBidConfig *SealedBidConfig,
+	partialFillMode PartialFillMode,
+	pricingRule PricingRule,
 ) *MsgCreateBatchAuction {
 	return &MsgCreateBatchAuction{
-		Auctioneer:        auctioneer,
-		StartPrice:        startPrice,
-		MinBidPrice:       minBidPrice,
-		SellingCoin:       sellingCoin,
-		PayingCoinDenom:   payingCoinDenom,
-		VestingSchedules:  vestingSchedules,
-		MaxExtendedRound:  maxExtendedRound,
-		ExtendedRoundRate: extendedRoundRate,
-		StartTime:         startTime,
-		EndTime:           endTime,
+		Auctioneer:                 auctioneer,
+		StartPrice:                 startPrice,
+		MinBidPrice:                minBidPrice,
+		SellingCoin:                sellingCoin,
+		PayingCoinDenom:            payingCoinDenom,
+		VestingSchedules:           vestingSchedules,
+		MaxExtendedRound:           maxExtendedRound,
+		ExtendedRoundRate:          extendedRoundRate,
+		StartTime:                  startTime,
+		EndTime:                    endTime,
+		AuctioneerManagedAllowlist: auctioneerManagedAllowlist,
+		OpenBidding:                openBidding,
+		DefaultMaxBidAmount:        defaultMaxBidAmount,
+		PayingCoinRates:            payingCoinRates,
+		MinRaiseAmount:             minRaiseAmount,
+		BidderVestingSchedules:     bidderVestingSchedules,
+		LinearVestingSchedule:      linearVestingSchedule,
+		ClaimMode:                  claimMode,
+		SealedBidConfig:            sealedBidConfig,
+		PartialFillMode:            partialFillMode,
+		PricingRule:                pricingRule,
 	}
 }
 
@@ -159,9 +250,36 @@ func (msg MsgCreateBatchAuction) ValidateBasic() error {
 	if err := ValidateVestingSchedules(msg.VestingSchedules, msg.EndTime); err != nil {
 		return err
 	}
+	if err := ValidateVestingSchedules(msg.BidderVestingSchedules, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(err, "invalid bidder vesting schedules")
+	}
+	if err := ValidateClaimMode(msg.ClaimMode, msg.BidderVestingSchedules); err != nil {
+		return err
+	}
+	if err := ValidateLinearVestingSchedule(msg.LinearVestingSchedule, msg.VestingSchedules, msg.EndTime); err != nil {
+		return err
+	}
 	if !msg.ExtendedRoundRate.IsPositive() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "extend rate must be positive")
 	}
+	if err := ValidateOpenBidding(msg.OpenBidding, msg.DefaultMaxBidAmount, msg.SellingCoin); err != nil {
+		return err
+	}
+	if err := ValidatePayingCoinRates(msg.PayingCoinRates, msg.SellingCoin, msg.PayingCoinDenom, nil); err != nil {
+		return err
+	}
+	if err := ValidateMinRaiseAmount(msg.MinRaiseAmount); err != nil {
+		return err
+	}
+	if err := ValidateSealedBidConfig(msg.SealedBidConfig, msg.MaxExtendedRound, msg.StartTime, msg.EndTime); err != nil {
+		return err
+	}
+	if err := ValidatePartialFillMode(msg.PartialFillMode); err != nil {
+		return err
+	}
+	if err := ValidatePricingRule(msg.PricingRule); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -185,6 +303,126 @@ func (msg MsgCreateBatchAuction) GetAuctioneer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCreateDutchAuction creates a new MsgCreateDutchAuction.
+func NewMsgCreateDutchAuction(
+	auctioneer string,
+	startPrice sdk.Dec,
+	floorPrice sdk.Dec,
+	priceDecayStep sdk.Dec,
+	priceDecayPeriod time.Duration,
+	sellingCoin sdk.Coin,
+	payingCoinDenom string,
+	vestingSchedules []VestingSchedule,
+	startTime time.Time,
+	endTime time.Time,
+	auctioneerManagedAllowlist bool,
+	openBidding bool,
+	defaultMaxBidAmount sdk.Int,
+	payingCoinRates sdk.DecCoins,
+	bidderVestingSchedules []VestingSchedule,
+	linearVestingSchedule *LinearVestingSchedule,
+	claimMode bool,
+) *MsgCreateDutchAuction {
+	return &MsgCreateDutchAuction{
+		Auctioneer:                 auctioneer,
+		StartPrice:                 startPrice,
+		FloorPrice:                 floorPrice,
+		PriceDecayStep:             priceDecayStep,
+		PriceDecayPeriod:           priceDecayPeriod,
+		SellingCoin:                sellingCoin,
+		PayingCoinDenom:            payingCoinDenom,
+		VestingSchedules:           vestingSchedules,
+		StartTime:                  startTime,
+		EndTime:                    endTime,
+		AuctioneerManagedAllowlist: auctioneerManagedAllowlist,
+		OpenBidding:                openBidding,
+		DefaultMaxBidAmount:        defaultMaxBidAmount,
+		PayingCoinRates:            payingCoinRates,
+		BidderVestingSchedules:     bidderVestingSchedules,
+		LinearVestingSchedule:      linearVestingSchedule,
+		ClaimMode:                  claimMode,
+	}
+}
+
+func (msg MsgCreateDutchAuction) Route() string { return RouterKey }
+
+func (msg MsgCreateDutchAuction) Type() string { return TypeMsgCreateDutchAuction }
+
+func (msg MsgCreateDutchAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if !msg.StartPrice.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "start price must be positive")
+	}
+	if !msg.FloorPrice.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "floor price must be positive")
+	}
+	if msg.FloorPrice.GT(msg.StartPrice) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "floor price must not be greater than start price")
+	}
+	if !msg.PriceDecayStep.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price decay step must be positive")
+	}
+	if msg.PriceDecayPeriod <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price decay period must be positive")
+	}
+	if err := msg.SellingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid selling coin: %v", err)
+	}
+	if !msg.SellingCoin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "selling coin amount must be positive")
+	}
+	if msg.SellingCoin.Denom == msg.PayingCoinDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "selling coin denom must not be the same as paying coin denom")
+	}
+	if err := sdk.ValidateDenom(msg.PayingCoinDenom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid paying coin denom: %v", err)
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "end time must be set after start time")
+	}
+	if err := ValidateVestingSchedules(msg.VestingSchedules, msg.EndTime); err != nil {
+		return err
+	}
+	if err := ValidateVestingSchedules(msg.BidderVestingSchedules, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(err, "invalid bidder vesting schedules")
+	}
+	if err := ValidateClaimMode(msg.ClaimMode, msg.BidderVestingSchedules); err != nil {
+		return err
+	}
+	if err := ValidateLinearVestingSchedule(msg.LinearVestingSchedule, msg.VestingSchedules, msg.EndTime); err != nil {
+		return err
+	}
+	if err := ValidateOpenBidding(msg.OpenBidding, msg.DefaultMaxBidAmount, msg.SellingCoin); err != nil {
+		return err
+	}
+	if err := ValidatePayingCoinRates(msg.PayingCoinRates, msg.SellingCoin, msg.PayingCoinDenom, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgCreateDutchAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateDutchAuction) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateDutchAuction) GetAuctioneer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgCancelAuction creates a new MsgCancelAuction.
 func NewMsgCancelAuction(
 	auctioneer string,
@@ -262,7 +500,7 @@ func (msg MsgPlaceBid) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid coin amount: %s", msg.Coin.Amount.String())
 	}
 	if msg.BidType != BidTypeFixedPrice && msg.BidType != BidTypeBatchWorth &&
-		msg.BidType != BidTypeBatchMany {
+		msg.BidType != BidTypeBatchMany && msg.BidType != BidTypeDutch {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bid type: %T", msg.BidType.String())
 	}
 	return nil
@@ -345,6 +583,401 @@ func (msg MsgModifyBid) GetBidder() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCancelBid creates a new MsgCancelBid.
+func NewMsgCancelBid(
+	auctionId uint64,
+	bidder string,
+	bidId uint64,
+) *MsgCancelBid {
+	return &MsgCancelBid{
+		AuctionId: auctionId,
+		Bidder:    bidder,
+		BidId:     bidId,
+	}
+}
+
+func (msg MsgCancelBid) Route() string { return RouterKey }
+
+func (msg MsgCancelBid) Type() string { return TypeMsgCancelBid }
+
+func (msg MsgCancelBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgCancelBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelBid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelBid) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCommitBid creates a new MsgCommitBid.
+func NewMsgCommitBid(
+	auctionId uint64,
+	bidder string,
+	commitment []byte,
+	deposit sdk.Coin,
+) *MsgCommitBid {
+	return &MsgCommitBid{
+		AuctionId:  auctionId,
+		Bidder:     bidder,
+		Commitment: commitment,
+		Deposit:    deposit,
+	}
+}
+
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+func (msg MsgCommitBid) Type() string { return TypeMsgCommitBid }
+
+func (msg MsgCommitBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if len(msg.Commitment) != BidCommitmentLength {
+		return sdkerrors.Wrapf(ErrInvalidBidCommitment, "commitment must be %d bytes long", BidCommitmentLength)
+	}
+	if err := msg.Deposit.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deposit coin: %v", err)
+	}
+	if !msg.Deposit.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deposit amount: %s", msg.Deposit.Amount.String())
+	}
+	return nil
+}
+
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCommitBid) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRevealBid creates a new MsgRevealBid.
+func NewMsgRevealBid(
+	auctionId uint64,
+	bidder string,
+	commitmentId uint64,
+	bidType BidType,
+	price sdk.Dec,
+	coin sdk.Coin,
+	salt string,
+) *MsgRevealBid {
+	return &MsgRevealBid{
+		AuctionId:    auctionId,
+		Bidder:       bidder,
+		CommitmentId: commitmentId,
+		BidType:      bidType,
+		Price:        price,
+		Coin:         coin,
+		Salt:         salt,
+	}
+}
+
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+func (msg MsgRevealBid) Type() string { return TypeMsgRevealBid }
+
+func (msg MsgRevealBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if !msg.Price.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bid price must be positive value")
+	}
+	if err := msg.Coin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bid coin: %v", err)
+	}
+	if !msg.Coin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid coin amount: %s", msg.Coin.Amount.String())
+	}
+	if msg.BidType != BidTypeBatchWorth && msg.BidType != BidTypeBatchMany {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bid type: %s", msg.BidType.String())
+	}
+	return nil
+}
+
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRevealBid) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams.
+func NewMsgUpdateParams(
+	authority string,
+	params Params,
+) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgResolveFailedAuction creates a new MsgResolveFailedAuction.
+func NewMsgResolveFailedAuction(
+	authority string,
+	auctionId uint64,
+	forceRefund bool,
+) *MsgResolveFailedAuction {
+	return &MsgResolveFailedAuction{
+		Authority:   authority,
+		AuctionId:   auctionId,
+		ForceRefund: forceRefund,
+	}
+}
+
+func (msg MsgResolveFailedAuction) Route() string { return RouterKey }
+
+func (msg MsgResolveFailedAuction) Type() string { return TypeMsgResolveFailedAuction }
+
+func (msg MsgResolveFailedAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	if msg.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	return nil
+}
+
+func (msg MsgResolveFailedAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgResolveFailedAuction) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddAllowedBidders creates a new MsgAddAllowedBidders.
+func NewMsgAddAllowedBidders(
+	auctionId uint64,
+	auctioneer string,
+	allowedBidders []AllowedBidder,
+) *MsgAddAllowedBidders {
+	return &MsgAddAllowedBidders{
+		AuctionId:      auctionId,
+		Auctioneer:     auctioneer,
+		AllowedBidders: allowedBidders,
+	}
+}
+
+func (msg MsgAddAllowedBidders) Route() string { return RouterKey }
+
+func (msg MsgAddAllowedBidders) Type() string { return TypeMsgAddAllowedBidders }
+
+func (msg MsgAddAllowedBidders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if len(msg.AllowedBidders) == 0 {
+		return ErrEmptyAllowedBidders
+	}
+	bidderMap := map[string]struct{}{}
+	for _, ab := range msg.AllowedBidders {
+		if err := ab.Validate(); err != nil {
+			return err
+		}
+		if _, ok := bidderMap[ab.Bidder]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate bidder %s", ab.Bidder)
+		}
+		bidderMap[ab.Bidder] = struct{}{}
+	}
+	return nil
+}
+
+func (msg MsgAddAllowedBidders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddAllowedBidders) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgAddAllowedBidders) GetAuctioneer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUpdateAllowedBidder creates a new MsgUpdateAllowedBidder.
+func NewMsgUpdateAllowedBidder(
+	auctionId uint64,
+	auctioneer string,
+	bidder string,
+	maxBidAmount sdk.Int,
+) *MsgUpdateAllowedBidder {
+	return &MsgUpdateAllowedBidder{
+		AuctionId:    auctionId,
+		Auctioneer:   auctioneer,
+		Bidder:       bidder,
+		MaxBidAmount: maxBidAmount,
+	}
+}
+
+func (msg MsgUpdateAllowedBidder) Route() string { return RouterKey }
+
+func (msg MsgUpdateAllowedBidder) Type() string { return TypeMsgUpdateAllowedBidder }
+
+func (msg MsgUpdateAllowedBidder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if msg.MaxBidAmount.IsNil() || !msg.MaxBidAmount.IsPositive() {
+		return ErrInvalidMaxBidAmount
+	}
+	return nil
+}
+
+func (msg MsgUpdateAllowedBidder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateAllowedBidder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgUpdateAllowedBidder) GetAuctioneer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRemoveAllowedBidder creates a new MsgRemoveAllowedBidder.
+func NewMsgRemoveAllowedBidder(
+	auctionId uint64,
+	auctioneer string,
+	bidder string,
+) *MsgRemoveAllowedBidder {
+	return &MsgRemoveAllowedBidder{
+		AuctionId:  auctionId,
+		Auctioneer: auctioneer,
+		Bidder:     bidder,
+	}
+}
+
+func (msg MsgRemoveAllowedBidder) Route() string { return RouterKey }
+
+func (msg MsgRemoveAllowedBidder) Type() string { return TypeMsgRemoveAllowedBidder }
+
+func (msg MsgRemoveAllowedBidder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid auctioneer address: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgRemoveAllowedBidder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveAllowedBidder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRemoveAllowedBidder) GetAuctioneer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgAddAllowedBidder creates a new MsgAddAllowedBidder.
 func NewMsgAddAllowedBidder(
 	auctionId uint64,
@@ -378,3 +1011,92 @@ func (msg MsgAddAllowedBidder) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgClaimVested creates a new MsgClaimVested.
+func NewMsgClaimVested(
+	auctioneer string,
+	auctionId uint64,
+) *MsgClaimVested {
+	return &MsgClaimVested{
+		Auctioneer: auctioneer,
+		AuctionId:  auctionId,
+	}
+}
+
+func (msg MsgClaimVested) Route() string { return RouterKey }
+
+func (msg MsgClaimVested) Type() string { return TypeMsgClaimVested }
+
+func (msg MsgClaimVested) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Auctioneer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid auctioneer address %q: %v", msg.Auctioneer, err)
+	}
+	return nil
+}
+
+func (msg MsgClaimVested) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimVested) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaimVested) GetAuctioneer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Auctioneer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClaimAllocation creates a new MsgClaimAllocation.
+func NewMsgClaimAllocation(
+	sender string,
+	auctionId uint64,
+	bidder string,
+) *MsgClaimAllocation {
+	return &MsgClaimAllocation{
+		Sender:    sender,
+		AuctionId: auctionId,
+		Bidder:    bidder,
+	}
+}
+
+func (msg MsgClaimAllocation) Route() string { return RouterKey }
+
+func (msg MsgClaimAllocation) Type() string { return TypeMsgClaimAllocation }
+
+func (msg MsgClaimAllocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %q: %v", msg.Sender, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %q: %v", msg.Bidder, err)
+	}
+	return nil
+}
+
+func (msg MsgClaimAllocation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimAllocation) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaimAllocation) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
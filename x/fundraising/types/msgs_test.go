@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -28,6 +29,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -40,6 +51,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -52,6 +73,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -64,6 +95,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -76,6 +117,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(-1, 0, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -93,6 +144,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -110,6 +171,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -127,6 +198,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(1, 0, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -148,6 +229,16 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
@@ -169,229 +260,1161 @@ func TestMsgCreateFixedPriceAuction(t *testing.T) {
 				},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
-	}
-
-	for _, tc := range testCases {
-		require.IsType(t, &types.MsgCreateFixedPriceAuction{}, tc.msg)
-		require.Equal(t, types.TypeMsgCreateFixedPriceAuction, tc.msg.Type())
-		require.Equal(t, types.RouterKey, tc.msg.Route())
-		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
-
-		err := tc.msg.ValidateBasic()
-		if tc.expectedErr == "" {
-			require.Nil(t, err)
-			signers := tc.msg.GetSigners()
-			require.Len(t, signers, 1)
-			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
-		} else {
-			require.EqualError(t, err, tc.expectedErr)
-		}
-	}
-}
-
-func TestMsgCreateBatchAuction(t *testing.T) {
-	testCases := []struct {
-		expectedErr string
-		msg         *types.MsgCreateBatchAuction
-	}{
 		{
-			"", // empty means no error expected
-			types.NewMsgCreateBatchAuction(
+			"", // empty means no error expected,
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
 				[]types.VestingSchedule{},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				true,
+				sdk.NewInt(1_000_000_000),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"start price must be positive: invalid request",
-			types.NewMsgCreateBatchAuction(
+			"default maximum bid amount must be positive: invalid maximum bid amount",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
-				sdk.MustNewDecFromStr("0"),
-				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.5"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
 				[]types.VestingSchedule{},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				true,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"selling coin amount must be positive: invalid request",
-			types.NewMsgCreateBatchAuction(
+			"default maximum bid amount 10000000000001 must not be greater than the selling amount 10000000000000: invalid maximum bid amount",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
-				sdk.NewInt64Coin("denom2", 0),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
 				[]types.VestingSchedule{},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				true,
+				sdk.NewInt(10_000_000_000_001),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"selling coin denom must not be the same as paying coin denom: invalid request",
-			types.NewMsgCreateBatchAuction(
+			"default maximum bid amount must not be set unless the auction is open: invalid maximum bid amount",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
-				"denom2",
+				"denom1",
 				[]types.VestingSchedule{},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.NewInt(1_000_000_000),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"end time must be set after start time: invalid request",
-			types.NewMsgCreateBatchAuction(
+			"", // empty means no error expected,
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
 				[]types.VestingSchedule{},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
 				time.Now(),
-				time.Now().AddDate(-1, 0, 0),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.NewCoins(sdk.NewInt64Coin("denom3", 1_000_000), sdk.NewInt64Coin("denom4", 500_000)),
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"vesting weight must be positive: invalid vesting schedules",
-			types.NewMsgCreateBatchAuction(
+			"selling basket must not contain the selling coin denom: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
-				[]types.VestingSchedule{
-					{
-						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
-						sdk.ZeroDec(),
-					},
-				},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
+				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000_000)),
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"vesting weight must not be greater than 1: invalid vesting schedules",
-			types.NewMsgCreateBatchAuction(
+			"selling basket must not contain the paying coin denom: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
-				[]types.VestingSchedule{
-					{
-						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
-						sdk.MustNewDecFromStr("1.1"),
-					},
-				},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
+				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000)),
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
 			),
 		},
 		{
-			"release time must be set after the end time: invalid vesting schedules",
-			types.NewMsgCreateBatchAuction(
+			"invalid selling basket: coin 0denom3 amount is not positive: invalid coins",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.Coins{sdk.NewInt64Coin("denom3", 0)},
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"", // empty means no error expected,
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom3", sdk.MustNewDecFromStr("0.5")), sdk.NewDecCoinFromDec("denom4", sdk.NewDec(2))),
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"paying coin rates must not contain the paying coin denom: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.DecCoins{sdk.NewDecCoinFromDec("denom1", sdk.OneDec())},
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"paying coin rates must not contain the selling coin denom: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.DecCoins{sdk.NewDecCoinFromDec("denom2", sdk.OneDec())},
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"paying coin rates must not contain the selling basket denom denom3: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.NewCoins(sdk.NewInt64Coin("denom3", 1_000_000)),
+				sdk.DecCoins{sdk.NewDecCoinFromDec("denom3", sdk.OneDec())},
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"invalid paying coin rates: coin 0.000000000000000000denom3 amount is not positive: invalid coins",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.DecCoins{sdk.NewDecCoinFromDec("denom3", sdk.ZeroDec())},
+				sdk.ZeroInt(),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.NewInt(5_000_000_000_000),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"min raise amount must not be negative: -1: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.NewInt(-1),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"min raise amount 5000000000001 must not be greater than the amount 5000000000000 that the auction can raise: invalid request",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.NewInt(5_000_000_000_001),
+				false,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
 				[]types.VestingSchedule{
 					{
-						time.Now(),
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
 						sdk.MustNewDecFromStr("1.0"),
 					},
 				},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
-				time.Now(),
-				time.Now().AddDate(1, 0, 0),
+				nil,
+				false,
 			),
 		},
 		{
-			"release time must be chronological: invalid vesting schedules",
-			types.NewMsgCreateBatchAuction(
+			"invalid bidder vesting schedules: total vesting weight must be equal to 1: invalid vesting schedules",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
 				[]types.VestingSchedule{
 					{
 						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
 						sdk.MustNewDecFromStr("0.5"),
 					},
+				},
+				nil,
+				false,
+			),
+		},
+		{
+			"claim mode cannot be used with bidder vesting schedules: invalid vesting schedules",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				[]types.VestingSchedule{
 					{
-						time.Now().AddDate(0, 1, 0).AddDate(0, 3, 0),
-						sdk.MustNewDecFromStr("0.5"),
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("1.0"),
 					},
 				},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
+				nil,
+				true,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateFixedPriceAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				&types.LinearVestingSchedule{
+					StartTime: time.Now().AddDate(0, 1, 0),
+					EndTime:   time.Now().AddDate(1, 1, 0),
+					CliffTime: time.Now().AddDate(0, 4, 0),
+				},
+				false,
 			),
 		},
 		{
-			"total vesting weight must be equal to 1: invalid vesting schedules",
-			types.NewMsgCreateBatchAuction(
+			"linear vesting schedule cannot be used with vesting schedules: invalid vesting schedules",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
 				[]types.VestingSchedule{
 					{
 						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
-						sdk.MustNewDecFromStr("0.5"),
-					},
-					{
-						time.Now().AddDate(0, 1, 0).AddDate(1, 0, 0),
-						sdk.MustNewDecFromStr("0.3"),
+						sdk.OneDec(),
 					},
 				},
-				uint32(2),
-				sdk.MustNewDecFromStr("0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				&types.LinearVestingSchedule{
+					StartTime: time.Now().AddDate(0, 1, 0),
+					EndTime:   time.Now().AddDate(1, 1, 0),
+				},
+				false,
 			),
 		},
 		{
-			"extend rate must be positive: invalid request",
-			types.NewMsgCreateBatchAuction(
+			"linear vesting cliff time must be between the start time and the end time: invalid vesting schedules",
+			types.NewMsgCreateFixedPriceAuction(
 				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
 				sdk.MustNewDecFromStr("0.5"),
-				sdk.MustNewDecFromStr("0.1"),
 				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
 				"denom1",
 				[]types.VestingSchedule{},
-				uint32(2),
-				sdk.MustNewDecFromStr("-0.05"),
 				time.Now(),
 				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				sdk.ZeroInt(),
+				false,
+				nil,
+				&types.LinearVestingSchedule{
+					StartTime: time.Now().AddDate(0, 1, 0),
+					EndTime:   time.Now().AddDate(1, 1, 0),
+					CliffTime: time.Now().AddDate(2, 0, 0),
+				},
+				false,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateFixedPriceAuction{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateFixedPriceAuction, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCreateBatchAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCreateBatchAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"start price must be positive: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"selling coin amount must be positive: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 0),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"selling coin denom must not be the same as paying coin denom: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom2",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"end time must be set after start time: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(-1, 0, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"vesting weight must be positive: invalid vesting schedules",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.ZeroDec(),
+					},
+				},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"vesting weight must not be greater than 1: invalid vesting schedules",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("1.1"),
+					},
+				},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"release time must be set after the end time: invalid vesting schedules",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{
+					{
+						time.Now(),
+						sdk.MustNewDecFromStr("1.0"),
+					},
+				},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(1, 0, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"release time must be chronological: invalid vesting schedules",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("0.5"),
+					},
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 3, 0),
+						sdk.MustNewDecFromStr("0.5"),
+					},
+				},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"total vesting weight must be equal to 1: invalid vesting schedules",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("0.5"),
+					},
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(1, 0, 0),
+						sdk.MustNewDecFromStr("0.3"),
+					},
+				},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"extend rate must be positive: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("-0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.NewInt(100_000_000_000_000),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"min raise amount must not be negative: -1: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.NewInt(-1),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(0),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				&types.SealedBidConfig{RevealPeriod: 24 * time.Hour, UnrevealedPenaltyRate: sdk.MustNewDecFromStr("0.1")},
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"reveal period must be positive: 0s: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(0),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				&types.SealedBidConfig{RevealPeriod: 0, UnrevealedPenaltyRate: sdk.MustNewDecFromStr("0.1")},
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"unrevealed penalty rate must be between 0 and 1: 1.100000000000000000: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(0),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				&types.SealedBidConfig{RevealPeriod: 24 * time.Hour, UnrevealedPenaltyRate: sdk.MustNewDecFromStr("1.1")},
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"sealed bid auction cannot have extended rounds: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				&types.SealedBidConfig{RevealPeriod: 24 * time.Hour, UnrevealedPenaltyRate: sdk.MustNewDecFromStr("0.1")},
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"reveal period 1440h0m0s must be shorter than the auction period: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(0),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				&types.SealedBidConfig{RevealPeriod: 60 * 24 * time.Hour, UnrevealedPenaltyRate: sdk.MustNewDecFromStr("0.1")},
+				types.PartialFillModeNil,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeTimePriority,
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"invalid partial fill mode: 3: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillMode(3),
+				types.PricingRuleUniform,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeProRata,
+				types.PricingRulePayAsBid,
+			),
+		},
+		{
+			"invalid pricing rule: 2: invalid request",
+			types.NewMsgCreateBatchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				uint32(2),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				false,
+				nil,
+				types.PartialFillModeNil,
+				types.PricingRule(2),
 			),
 		},
 	}
@@ -407,30 +1430,623 @@ func TestMsgCreateBatchAuction(t *testing.T) {
 			require.Nil(t, err)
 			signers := tc.msg.GetSigners()
 			require.Len(t, signers, 1)
-			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCreateDutchAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCreateDutchAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"start price must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0"),
+				sdk.MustNewDecFromStr("0"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"floor price must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"floor price must not be greater than start price: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.6"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"price decay step must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"price decay period must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				0,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"selling coin amount must be positive: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 0),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"selling coin denom must not be the same as paying coin denom: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom2",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"end time must be set after start time: invalid request",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(-1, 0, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				nil,
+				false,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("1.0"),
+					},
+				},
+				nil,
+				false,
+			),
+		},
+		{
+			"invalid bidder vesting schedules: total vesting weight must be equal to 1: invalid vesting schedules",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("0.5"),
+					},
+				},
+				nil,
+				false,
+			),
+		},
+		{
+			"claim mode cannot be used with bidder vesting schedules: invalid vesting schedules",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				[]types.VestingSchedule{
+					{
+						time.Now().AddDate(0, 1, 0).AddDate(0, 6, 0),
+						sdk.MustNewDecFromStr("1.0"),
+					},
+				},
+				nil,
+				true,
+			),
+		},
+		{
+			"linear vesting end time must be set after the start time: invalid vesting schedules",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				&types.LinearVestingSchedule{
+					StartTime: time.Now().AddDate(0, 6, 0),
+					EndTime:   time.Now().AddDate(0, 3, 0),
+				},
+				false,
+			),
+		},
+		{
+			"linear vesting start time must not be before the end time: invalid vesting schedules",
+			types.NewMsgCreateDutchAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				sdk.MustNewDecFromStr("0.5"),
+				sdk.MustNewDecFromStr("0.1"),
+				sdk.MustNewDecFromStr("0.05"),
+				time.Hour,
+				sdk.NewInt64Coin("denom2", 10_000_000_000_000),
+				"denom1",
+				[]types.VestingSchedule{},
+				time.Now(),
+				time.Now().AddDate(0, 1, 0),
+				false,
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				&types.LinearVestingSchedule{
+					StartTime: time.Now(),
+					EndTime:   time.Now().AddDate(1, 0, 0),
+				},
+				false,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateDutchAuction{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateDutchAuction, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCancelAuction(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCancelAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCancelAuction(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCancelAuction{}, tc.msg)
+		require.Equal(t, types.TypeMsgCancelAuction, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimVested(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimVested
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimVested(
+				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid auctioneer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaimVested(
+				"",
+				uint64(1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimVested{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaimVested, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimAllocation(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimAllocation
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimAllocation(
+				sdk.AccAddress(crypto.AddressHash([]byte("Sender"))).String(),
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+			),
+		},
+		{
+			"invalid sender address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaimAllocation(
+				"",
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+			),
+		},
+		{
+			"invalid bidder address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaimAllocation(
+				sdk.AccAddress(crypto.AddressHash([]byte("Sender"))).String(),
+				uint64(1),
+				"",
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimAllocation{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaimAllocation, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, sdk.AccAddress(crypto.AddressHash([]byte("Sender"))), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgPlaceBid(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgPlaceBid
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgPlaceBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				types.BidTypeBatchWorth,
+				sdk.OneDec(),
+				sdk.NewInt64Coin("denom2", 1000000),
+			),
+		},
+		{
+			"bid price must be positive value: invalid request",
+			types.NewMsgPlaceBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				types.BidTypeBatchWorth,
+				sdk.ZeroDec(),
+				sdk.NewInt64Coin("denom2", 1000000),
+			),
+		},
+		{
+			"invalid coin amount: 0: invalid request",
+			types.NewMsgPlaceBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				types.BidTypeBatchWorth,
+				sdk.OneDec(),
+				sdk.NewInt64Coin("denom2", 0),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgPlaceBid{}, tc.msg)
+		require.Equal(t, types.TypeMsgPlaceBid, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetBidder(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgModifyBid(t *testing.T) {
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgModifyBid
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgModifyBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				uint64(0),
+				sdk.OneDec(),
+				sdk.NewInt64Coin("denom2", 1000000),
+			),
+		},
+		{
+			"bid price must be positive value: invalid request",
+			types.NewMsgModifyBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				uint64(0),
+				sdk.ZeroDec(),
+				sdk.NewInt64Coin("denom2", 1000000),
+			),
+		},
+		{
+			"invalid coin amount: 0: invalid request",
+			types.NewMsgModifyBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				uint64(0),
+				sdk.OneDec(),
+				sdk.NewInt64Coin("denom2", 0),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgModifyBid{}, tc.msg)
+		require.Equal(t, types.TypeMsgModifyBid, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetBidder(), signers[0])
 		} else {
 			require.EqualError(t, err, tc.expectedErr)
 		}
 	}
 }
 
-func TestMsgCancelAuction(t *testing.T) {
+func TestMsgCancelBid(t *testing.T) {
 	testCases := []struct {
 		expectedErr string
-		msg         *types.MsgCancelAuction
+		msg         *types.MsgCancelBid
 	}{
 		{
 			"", // empty means no error expected
-			types.NewMsgCancelAuction(
-				sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String(),
+			types.NewMsgCancelBid(
+				uint64(1),
+				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
+				uint64(1),
+			),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgCancelBid(
+				uint64(1),
+				"",
 				uint64(1),
 			),
 		},
 	}
 
 	for _, tc := range testCases {
-		require.IsType(t, &types.MsgCancelAuction{}, tc.msg)
-		require.Equal(t, types.TypeMsgCancelAuction, tc.msg.Type())
+		require.IsType(t, &types.MsgCancelBid{}, tc.msg)
+		require.Equal(t, types.TypeMsgCancelBid, tc.msg.Type())
 		require.Equal(t, types.RouterKey, tc.msg.Route())
 		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
 
@@ -439,53 +2055,62 @@ func TestMsgCancelAuction(t *testing.T) {
 			require.Nil(t, err)
 			signers := tc.msg.GetSigners()
 			require.Len(t, signers, 1)
-			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+			require.Equal(t, tc.msg.GetBidder(), signers[0])
 		} else {
 			require.EqualError(t, err, tc.expectedErr)
 		}
 	}
 }
 
-func TestMsgPlaceBid(t *testing.T) {
+func TestMsgCommitBid(t *testing.T) {
+	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder")))
+	commitment := types.BidCommitmentHash(1, bidder, types.BidTypeBatchWorth, sdk.OneDec(), sdk.NewInt64Coin("denom2", 100), "salt")
+
 	testCases := []struct {
 		expectedErr string
-		msg         *types.MsgPlaceBid
+		msg         *types.MsgCommitBid
 	}{
 		{
 			"", // empty means no error expected
-			types.NewMsgPlaceBid(
+			types.NewMsgCommitBid(
 				uint64(1),
-				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
-				types.BidTypeBatchWorth,
-				sdk.OneDec(),
-				sdk.NewInt64Coin("denom2", 1000000),
+				bidder.String(),
+				commitment,
+				sdk.NewInt64Coin("denom2", 100),
 			),
 		},
 		{
-			"bid price must be positive value: invalid request",
-			types.NewMsgPlaceBid(
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgCommitBid(
 				uint64(1),
-				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
-				types.BidTypeBatchWorth,
-				sdk.ZeroDec(),
-				sdk.NewInt64Coin("denom2", 1000000),
+				"",
+				commitment,
+				sdk.NewInt64Coin("denom2", 100),
 			),
 		},
 		{
-			"invalid coin amount: 0: invalid request",
-			types.NewMsgPlaceBid(
+			"commitment must be 32 bytes long: invalid bid commitment",
+			types.NewMsgCommitBid(
 				uint64(1),
-				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
-				types.BidTypeBatchWorth,
-				sdk.OneDec(),
+				bidder.String(),
+				commitment[:31],
+				sdk.NewInt64Coin("denom2", 100),
+			),
+		},
+		{
+			"invalid deposit amount: 0: invalid request",
+			types.NewMsgCommitBid(
+				uint64(1),
+				bidder.String(),
+				commitment,
 				sdk.NewInt64Coin("denom2", 0),
 			),
 		},
 	}
 
 	for _, tc := range testCases {
-		require.IsType(t, &types.MsgPlaceBid{}, tc.msg)
-		require.Equal(t, types.TypeMsgPlaceBid, tc.msg.Type())
+		require.IsType(t, &types.MsgCommitBid{}, tc.msg)
+		require.Equal(t, types.TypeMsgCommitBid, tc.msg.Type())
 		require.Equal(t, types.RouterKey, tc.msg.Route())
 		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
 
@@ -501,46 +2126,64 @@ func TestMsgPlaceBid(t *testing.T) {
 	}
 }
 
-func TestMsgModifyBid(t *testing.T) {
+func TestMsgRevealBid(t *testing.T) {
 	testCases := []struct {
 		expectedErr string
-		msg         *types.MsgModifyBid
+		msg         *types.MsgRevealBid
 	}{
 		{
 			"", // empty means no error expected
-			types.NewMsgModifyBid(
+			types.NewMsgRevealBid(
 				uint64(1),
 				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
-				uint64(0),
+				uint64(1),
+				types.BidTypeBatchWorth,
 				sdk.OneDec(),
-				sdk.NewInt64Coin("denom2", 1000000),
+				sdk.NewInt64Coin("denom2", 100),
+				"salt",
+			),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgRevealBid(
+				uint64(1),
+				"",
+				uint64(1),
+				types.BidTypeBatchWorth,
+				sdk.OneDec(),
+				sdk.NewInt64Coin("denom2", 100),
+				"salt",
 			),
 		},
 		{
 			"bid price must be positive value: invalid request",
-			types.NewMsgModifyBid(
+			types.NewMsgRevealBid(
 				uint64(1),
 				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
-				uint64(0),
+				uint64(1),
+				types.BidTypeBatchWorth,
 				sdk.ZeroDec(),
-				sdk.NewInt64Coin("denom2", 1000000),
+				sdk.NewInt64Coin("denom2", 100),
+				"salt",
 			),
 		},
 		{
-			"invalid coin amount: 0: invalid request",
-			types.NewMsgModifyBid(
+			"invalid bid type: BID_TYPE_FIXED_PRICE: invalid request",
+			types.NewMsgRevealBid(
 				uint64(1),
 				sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String(),
-				uint64(0),
+				uint64(1),
+				types.BidTypeFixedPrice,
 				sdk.OneDec(),
-				sdk.NewInt64Coin("denom2", 0),
+				sdk.NewInt64Coin("denom2", 100),
+				"salt",
 			),
 		},
 	}
 
 	for _, tc := range testCases {
-		require.IsType(t, &types.MsgModifyBid{}, tc.msg)
-		require.Equal(t, types.TypeMsgModifyBid, tc.msg.Type())
+		require.IsType(t, &types.MsgRevealBid{}, tc.msg)
+		require.Equal(t, types.TypeMsgRevealBid, tc.msg.Type())
 		require.Equal(t, types.RouterKey, tc.msg.Route())
 		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
 
@@ -556,6 +2199,253 @@ func TestMsgModifyBid(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateParams(t *testing.T) {
+	invalidParams := types.DefaultParams()
+	invalidParams.BidCancellationCutoff = -time.Hour
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdateParams
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdateParams(
+				sdk.AccAddress(crypto.AddressHash([]byte("Authority"))).String(),
+				types.DefaultParams(),
+			),
+		},
+		{
+			"invalid authority address: empty address string is not allowed: invalid address",
+			types.NewMsgUpdateParams(
+				"",
+				types.DefaultParams(),
+			),
+		},
+		{
+			"invalid params: bid cancellation cutoff must not be negative: -1h0m0s: invalid request",
+			types.NewMsgUpdateParams(
+				sdk.AccAddress(crypto.AddressHash([]byte("Authority"))).String(),
+				invalidParams,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdateParams{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdateParams, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.Authority, signers[0].String())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgResolveFailedAuction(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("Authority"))).String()
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgResolveFailedAuction
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgResolveFailedAuction(authority, 1, false),
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgResolveFailedAuction(authority, 1, true),
+		},
+		{
+			"invalid authority address: empty address string is not allowed: invalid address",
+			types.NewMsgResolveFailedAuction("", 1, false),
+		},
+		{
+			"auction id cannot be 0: invalid request",
+			types.NewMsgResolveFailedAuction(authority, 0, false),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgResolveFailedAuction{}, tc.msg)
+		require.Equal(t, types.TypeMsgResolveFailedAuction, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.Authority, signers[0].String())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgAddAllowedBidders(t *testing.T) {
+	auctioneer := sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String()
+	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String()
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgAddAllowedBidders
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgAddAllowedBidders(
+				1,
+				auctioneer,
+				[]types.AllowedBidder{{Bidder: bidder, MaxBidAmount: sdk.NewInt(100_000_000)}},
+			),
+		},
+		{
+			"invalid auctioneer address: empty address string is not allowed: invalid address",
+			types.NewMsgAddAllowedBidders(
+				1,
+				"",
+				[]types.AllowedBidder{{Bidder: bidder, MaxBidAmount: sdk.NewInt(100_000_000)}},
+			),
+		},
+		{
+			types.ErrEmptyAllowedBidders.Error(),
+			types.NewMsgAddAllowedBidders(
+				1,
+				auctioneer,
+				[]types.AllowedBidder{},
+			),
+		},
+		{
+			types.ErrInvalidMaxBidAmount.Error(),
+			types.NewMsgAddAllowedBidders(
+				1,
+				auctioneer,
+				[]types.AllowedBidder{{Bidder: bidder, MaxBidAmount: sdk.ZeroInt()}},
+			),
+		},
+		{
+			fmt.Sprintf("duplicate bidder %s: invalid request", bidder),
+			types.NewMsgAddAllowedBidders(
+				1,
+				auctioneer,
+				[]types.AllowedBidder{
+					{Bidder: bidder, MaxBidAmount: sdk.NewInt(100_000_000)},
+					{Bidder: bidder, MaxBidAmount: sdk.NewInt(200_000_000)},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgAddAllowedBidders{}, tc.msg)
+		require.Equal(t, types.TypeMsgAddAllowedBidders, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdateAllowedBidder(t *testing.T) {
+	auctioneer := sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String()
+	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String()
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUpdateAllowedBidder
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUpdateAllowedBidder(1, auctioneer, bidder, sdk.NewInt(100_000_000)),
+		},
+		{
+			"invalid auctioneer address: empty address string is not allowed: invalid address",
+			types.NewMsgUpdateAllowedBidder(1, "", bidder, sdk.NewInt(100_000_000)),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgUpdateAllowedBidder(1, auctioneer, "", sdk.NewInt(100_000_000)),
+		},
+		{
+			types.ErrInvalidMaxBidAmount.Error(),
+			types.NewMsgUpdateAllowedBidder(1, auctioneer, bidder, sdk.NewInt(-1)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUpdateAllowedBidder{}, tc.msg)
+		require.Equal(t, types.TypeMsgUpdateAllowedBidder, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgRemoveAllowedBidder(t *testing.T) {
+	auctioneer := sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))).String()
+	bidder := sdk.AccAddress(crypto.AddressHash([]byte("Bidder"))).String()
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgRemoveAllowedBidder
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgRemoveAllowedBidder(1, auctioneer, bidder),
+		},
+		{
+			"invalid auctioneer address: empty address string is not allowed: invalid address",
+			types.NewMsgRemoveAllowedBidder(1, "", bidder),
+		},
+		{
+			"invalid bidder address: empty address string is not allowed: invalid address",
+			types.NewMsgRemoveAllowedBidder(1, auctioneer, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgRemoveAllowedBidder{}, tc.msg)
+		require.Equal(t, types.TypeMsgRemoveAllowedBidder, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetAuctioneer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestAddAllowedBidder(t *testing.T) {
 	testCases := []struct {
 		expectedErr string
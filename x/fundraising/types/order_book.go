@@ -0,0 +1,56 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// OrderBook aggregates the bids that are grouped by price into price levels in descending order of the price.
+// If the tick size is positive, each bid price is rounded down to a multiple of the tick size.
+// Like Match, BidTypeBatchWorth bids are converted into the paying coin amount in the paying coin denom
+// by the paying coin rates, and then into the selling coin amount at the price of each level.
+func OrderBook(prices []sdk.Dec, bidsByPrice map[string][]Bid, tickSize sdk.Dec, payingCoinDenom string, payingCoinRates sdk.DecCoins) []OrderBookPriceLevel {
+	bucketing := !tickSize.IsNil() && tickSize.IsPositive()
+
+	levels := []OrderBookPriceLevel{}
+	cumWorthAmt, cumManyAmt := sdk.ZeroInt(), sdk.ZeroInt()
+
+	for _, price := range prices {
+		levelPrice := price
+		if bucketing {
+			levelPrice = price.Quo(tickSize).TruncateDec().Mul(tickSize)
+		}
+
+		if len(levels) == 0 || !levels[len(levels)-1].Price.Equal(levelPrice) {
+			levels = append(levels, OrderBookPriceLevel{
+				Price:       levelPrice,
+				WorthAmount: sdk.ZeroInt(),
+				ManyAmount:  sdk.ZeroInt(),
+			})
+		}
+		level := &levels[len(levels)-1]
+
+		for _, bid := range bidsByPrice[price.String()] {
+			switch bid.Type {
+			case BidTypeBatchWorth:
+				payingAmt := bid.ConvertToPayingAmount(payingCoinDenom, payingCoinRates)
+				level.WorthAmount = level.WorthAmount.Add(payingAmt)
+				cumWorthAmt = cumWorthAmt.Add(payingAmt)
+			case BidTypeBatchMany:
+				level.ManyAmount = level.ManyAmount.Add(bid.Coin.Amount)
+				cumManyAmt = cumManyAmt.Add(bid.Coin.Amount)
+			}
+			level.BidsCount++
+		}
+
+		level.DemandAmount = level.ManyAmount.Add(worthToSellingAmount(level.WorthAmount, levelPrice))
+		level.CumulativeDemandAmount = cumManyAmt.Add(worthToSellingAmount(cumWorthAmt, levelPrice))
+	}
+
+	return levels
+}
+
+// worthToSellingAmount converts the paying coin amount into the selling coin amount at the price.
+func worthToSellingAmount(payingAmt sdk.Int, price sdk.Dec) sdk.Int {
+	if !price.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return sdk.NewDecFromInt(payingAmt).QuoTruncate(price).TruncateInt()
+}
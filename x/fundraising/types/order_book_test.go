@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/fundraising/x/fundraising/types"
+)
+
+func TestOrderBook(t *testing.T) {
+	bidder := testAddr(0).String()
+	newBid := func(typ types.BidType, price sdk.Dec, coin sdk.Coin) types.Bid {
+		return types.Bid{Bidder: bidder, Type: typ, Price: price, Coin: coin}
+	}
+
+	bids := []types.Bid{
+		newBid(types.BidTypeBatchWorth, parseDec("1.0"), sdk.NewInt64Coin("paying", 100_000000)),
+		newBid(types.BidTypeBatchMany, parseDec("1.0"), sdk.NewInt64Coin("selling", 50_000000)),
+		newBid(types.BidTypeBatchMany, parseDec("0.55"), sdk.NewInt64Coin("selling", 30_000000)),
+		newBid(types.BidTypeBatchWorth, parseDec("0.5"), sdk.NewInt64Coin("paying", 50_000000)),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		tickSize sdk.Dec
+		levels   []types.OrderBookPriceLevel
+	}{
+		{
+			"without tick size",
+			sdk.Dec{},
+			[]types.OrderBookPriceLevel{
+				{
+					Price:                  parseDec("1.0"),
+					BidsCount:              2,
+					WorthAmount:            sdk.NewInt(100_000000),
+					ManyAmount:             sdk.NewInt(50_000000),
+					DemandAmount:           sdk.NewInt(150_000000),
+					CumulativeDemandAmount: sdk.NewInt(150_000000),
+				},
+				{
+					Price:                  parseDec("0.55"),
+					BidsCount:              1,
+					WorthAmount:            sdk.ZeroInt(),
+					ManyAmount:             sdk.NewInt(30_000000),
+					DemandAmount:           sdk.NewInt(30_000000),
+					CumulativeDemandAmount: sdk.NewInt(261_818181), // 80_000000 + 100_000000 / 0.55
+				},
+				{
+					Price:                  parseDec("0.5"),
+					BidsCount:              1,
+					WorthAmount:            sdk.NewInt(50_000000),
+					ManyAmount:             sdk.ZeroInt(),
+					DemandAmount:           sdk.NewInt(100_000000),
+					CumulativeDemandAmount: sdk.NewInt(380_000000),
+				},
+			},
+		},
+		{
+			"with tick size",
+			parseDec("0.1"),
+			[]types.OrderBookPriceLevel{
+				{
+					Price:                  parseDec("1.0"),
+					BidsCount:              2,
+					WorthAmount:            sdk.NewInt(100_000000),
+					ManyAmount:             sdk.NewInt(50_000000),
+					DemandAmount:           sdk.NewInt(150_000000),
+					CumulativeDemandAmount: sdk.NewInt(150_000000),
+				},
+				{
+					Price:                  parseDec("0.5"),
+					BidsCount:              2,
+					WorthAmount:            sdk.NewInt(50_000000),
+					ManyAmount:             sdk.NewInt(30_000000),
+					DemandAmount:           sdk.NewInt(130_000000),
+					CumulativeDemandAmount: sdk.NewInt(380_000000),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			prices, bidsByPrice := types.BidsByPrice(bids)
+			levels := types.OrderBook(prices, bidsByPrice, tc.tickSize, "paying", nil)
+			require.Len(t, levels, len(tc.levels))
+			for i, level := range levels {
+				require.True(t, tc.levels[i].Price.Equal(level.Price))
+				require.Equal(t, tc.levels[i].BidsCount, level.BidsCount)
+				require.True(sdk.IntEq(t, tc.levels[i].WorthAmount, level.WorthAmount))
+				require.True(sdk.IntEq(t, tc.levels[i].ManyAmount, level.ManyAmount))
+				require.True(sdk.IntEq(t, tc.levels[i].DemandAmount, level.DemandAmount))
+				require.True(sdk.IntEq(t, tc.levels[i].CumulativeDemandAmount, level.CumulativeDemandAmount))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -22,18 +23,23 @@ const (
 
 // Parameter store keys.
 var (
-	KeyAuctionCreationFee = []byte("AuctionCreationFee")
-	KeyPlaceBidFee        = []byte("PlaceBidFee")
-	KeyExtendedPeriod     = []byte("ExtendedPeriod")
-
-	DefaultAuctionCreationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
-	DefaultPlaceBidFee        = sdk.Coins{}
-	DefaultExtendedPeriod     = uint32(1)
+	KeyAuctionCreationFee    = []byte("AuctionCreationFee")
+	KeyPlaceBidFee           = []byte("PlaceBidFee")
+	KeyExtendedPeriod        = []byte("ExtendedPeriod")
+	KeyBidCancellationCutoff = []byte("BidCancellationCutoff")
+
+	DefaultAuctionCreationFee    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
+	DefaultPlaceBidFee           = sdk.Coins{}
+	DefaultExtendedPeriod        = uint32(1)
+	DefaultBidCancellationCutoff = 24 * time.Hour
+	DefaultMaxSettlementBidders  = uint32(1000)
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable returns the parameter key table.
+// It is only used to migrate the parameters from the legacy x/params subspace
+// since the parameters are stored in the module store.
 func ParamKeyTable() paramstypes.KeyTable {
 	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -41,18 +47,23 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default fundraising module parameters.
 func DefaultParams() Params {
 	return Params{
-		AuctionCreationFee: DefaultAuctionCreationFee,
-		PlaceBidFee:        DefaultPlaceBidFee,
-		ExtendedPeriod:     DefaultExtendedPeriod,
+		AuctionCreationFee:    DefaultAuctionCreationFee,
+		PlaceBidFee:           DefaultPlaceBidFee,
+		ExtendedPeriod:        DefaultExtendedPeriod,
+		BidCancellationCutoff: DefaultBidCancellationCutoff,
+		MaxSettlementBidders:  DefaultMaxSettlementBidders,
 	}
 }
 
 // ParamSetPairs implements paramstypes.ParamSet.
+// It doesn't include the parameters that are added after the parameters are moved to the module store,
+// since they don't exist in the legacy x/params subspace.
 func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	return paramstypes.ParamSetPairs{
 		paramstypes.NewParamSetPair(KeyAuctionCreationFee, &p.AuctionCreationFee, validateAuctionCreationFee),
 		paramstypes.NewParamSetPair(KeyPlaceBidFee, &p.PlaceBidFee, validatePlaceBidFee),
 		paramstypes.NewParamSetPair(KeyExtendedPeriod, &p.ExtendedPeriod, validateExtendedPeriod),
+		paramstypes.NewParamSetPair(KeyBidCancellationCutoff, &p.BidCancellationCutoff, validateBidCancellationCutoff),
 	}
 }
 
@@ -69,7 +80,10 @@ func (p Params) Validate() error {
 		validator func(interface{}) error
 	}{
 		{p.AuctionCreationFee, validateAuctionCreationFee},
+		{p.PlaceBidFee, validatePlaceBidFee},
 		{p.ExtendedPeriod, validateExtendedPeriod},
+		{p.BidCancellationCutoff, validateBidCancellationCutoff},
+		{p.MaxSettlementBidders, validateMaxSettlementBidders},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -112,3 +126,25 @@ func validateExtendedPeriod(i interface{}) error {
 
 	return nil
 }
+
+func validateBidCancellationCutoff(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("bid cancellation cutoff must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSettlementBidders(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
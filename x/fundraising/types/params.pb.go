@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +43,14 @@ type Params struct {
 	// extended_period specifies the extended period that determines how long
 	// the extended auction round lasts
 	ExtendedPeriod uint32 `protobuf:"varint,3,opt,name=extended_period,json=extendedPeriod,proto3" json:"extended_period,omitempty" yaml:"extended_period"`
+	// bid_cancellation_cutoff specifies the period of time before the end time of
+	// a batch auction after which bidders are no longer able to cancel or lower
+	// their bids
+	BidCancellationCutoff time.Duration `protobuf:"bytes,4,opt,name=bid_cancellation_cutoff,json=bidCancellationCutoff,proto3,stdduration" json:"bid_cancellation_cutoff" yaml:"bid_cancellation_cutoff"`
+	// max_settlement_bidders specifies the maximum number of bidders that a
+	// closed batch auction pays out per block; if the auction has more bidders,
+	// it is settled over multiple blocks. zero means no limit
+	MaxSettlementBidders uint32 `protobuf:"varint,5,opt,name=max_settlement_bidders,json=maxSettlementBidders,proto3" json:"max_settlement_bidders,omitempty" yaml:"max_settlement_bidders"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -80,30 +92,37 @@ func init() {
 func init() { proto.RegisterFile("fundraising/params.proto", fileDescriptor_b7601b7e90a0f804) }
 
 var fileDescriptor_b7601b7e90a0f804 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x49, 0x88, 0x29, 0x41, 0x93, 0x86, 0x10, 0xc0, 0xa4, 0x25, 0x9d, 0x58, 0xec,
-	0x05, 0x8d, 0x0b, 0x63, 0x49, 0x8c, 0x1b, 0x84, 0xd1, 0xa5, 0xb9, 0xf6, 0x8e, 0x7a, 0x91, 0xde,
-	0x35, 0xbd, 0xc3, 0xc0, 0xe6, 0xa6, 0xa3, 0xa3, 0x83, 0x03, 0xb3, 0x9f, 0x84, 0x91, 0xd1, 0x09,
-	0x0d, 0x7c, 0x03, 0x3e, 0x81, 0xe1, 0xee, 0x8c, 0x60, 0x4c, 0x8c, 0xd3, 0xdd, 0xfb, 0xdf, 0xbd,
-	0xff, 0xff, 0x97, 0xbc, 0x67, 0xd5, 0x86, 0x63, 0x8a, 0x72, 0x48, 0x38, 0xa1, 0x09, 0xc8, 0x60,
-	0x0e, 0x53, 0xee, 0x67, 0x39, 0x13, 0xcc, 0xae, 0x0a, 0x4c, 0x11, 0xce, 0x53, 0x42, 0x85, 0xbf,
-	0xf3, 0xa9, 0xe1, 0xc4, 0x8c, 0xa7, 0x8c, 0x83, 0x08, 0x72, 0x0c, 0xee, 0xda, 0x11, 0x16, 0xb0,
-	0x0d, 0x62, 0x46, 0xa8, 0xea, 0x6b, 0xd4, 0xd5, 0x7b, 0x28, 0x2b, 0xa0, 0x0a, 0xfd, 0x54, 0x49,
-	0x58, 0xc2, 0x94, 0xbe, 0xbd, 0x29, 0xd5, 0xbb, 0x2f, 0x58, 0xc5, 0xbe, 0x4c, 0xb6, 0x5f, 0x4c,
-	0xab, 0x02, 0xc7, 0xb1, 0x20, 0x8c, 0x86, 0x71, 0x8e, 0xa1, 0xbc, 0x0c, 0x31, 0xae, 0x99, 0xcd,
-	0x42, 0xab, 0x74, 0x56, 0xf7, 0xb5, 0xdd, 0x36, 0xdb, 0xd7, 0xd9, 0x7e, 0x97, 0x11, 0x1a, 0xf4,
-	0xe6, 0x4b, 0xd7, 0xd8, 0x2c, 0xdd, 0x93, 0x29, 0x4c, 0x47, 0x1d, 0xef, 0x37, 0x13, 0xef, 0xf5,
-	0xdd, 0x6d, 0x25, 0x44, 0xdc, 0x8c, 0x23, 0x3f, 0x66, 0xa9, 0x46, 0xd3, 0xc7, 0x29, 0x47, 0xb7,
-	0x40, 0x4c, 0x33, 0xcc, 0xa5, 0x1f, 0x1f, 0xd8, 0xda, 0xa2, 0xab, 0x1d, 0x2e, 0x31, 0xb6, 0x1f,
-	0x4c, 0xab, 0x9c, 0x8d, 0x60, 0x8c, 0xc3, 0x88, 0x20, 0xc9, 0x75, 0xf0, 0x17, 0xd7, 0x95, 0xe6,
-	0xaa, 0x28, 0xae, 0xbd, 0xee, 0xff, 0x01, 0x95, 0x64, 0x6f, 0x40, 0xd0, 0x96, 0xa4, 0x6b, 0x1d,
-	0xe3, 0x89, 0x1c, 0x10, 0x0a, 0x33, 0x9c, 0x13, 0x86, 0x6a, 0x85, 0xa6, 0xd9, 0x2a, 0x07, 0x8d,
-	0xcd, 0xd2, 0xad, 0xaa, 0xac, 0x1f, 0x1f, 0xbc, 0xc1, 0xd1, 0x97, 0xd2, 0x97, 0x42, 0xe7, 0xf0,
-	0x71, 0xe6, 0x1a, 0xcf, 0x33, 0xd7, 0x08, 0x7a, 0xf3, 0x95, 0x63, 0x2e, 0x56, 0x8e, 0xf9, 0xb1,
-	0x72, 0xcc, 0xa7, 0xb5, 0x63, 0x2c, 0xd6, 0x8e, 0xf1, 0xb6, 0x76, 0x8c, 0xeb, 0x8b, 0x1d, 0xbe,
-	0xef, 0x85, 0x00, 0xbb, 0x5b, 0x33, 0xd9, 0xab, 0x24, 0x72, 0x54, 0x94, 0xa3, 0x3d, 0xff, 0x0c,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0xfc, 0x47, 0xaf, 0x5f, 0x02, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0x13, 0x5a, 0x2a, 0x94, 0xaa, 0x20, 0x45, 0xc7, 0x91, 0x1e, 0x22, 0x39, 0x32, 0x9d,
+	0x90, 0x88, 0x55, 0x10, 0x4b, 0xc7, 0x04, 0x21, 0xb6, 0x56, 0xc7, 0x80, 0xc4, 0x12, 0x39, 0xf6,
+	0x4b, 0xb0, 0x48, 0xe2, 0x28, 0x76, 0xd0, 0x75, 0x60, 0x86, 0x91, 0xb1, 0x03, 0x43, 0x67, 0x3e,
+	0x49, 0xc7, 0x8e, 0x9d, 0xae, 0xe8, 0xee, 0x1b, 0xdc, 0x27, 0x40, 0xb1, 0x5d, 0xb8, 0xa2, 0x43,
+	0x88, 0x29, 0x79, 0xff, 0xf7, 0xfc, 0xf7, 0xef, 0xbd, 0x27, 0x3b, 0x5e, 0xde, 0xd5, 0xb4, 0xc5,
+	0x4c, 0xb0, 0xba, 0x40, 0x0d, 0x6e, 0x71, 0x25, 0xa2, 0xa6, 0xe5, 0x92, 0xbb, 0x43, 0x09, 0x35,
+	0x85, 0xb6, 0x62, 0xb5, 0x8c, 0xd6, 0x8a, 0x46, 0x3e, 0xe1, 0xa2, 0xe2, 0x02, 0x65, 0x58, 0x00,
+	0xfa, 0x78, 0x90, 0x81, 0xc4, 0x07, 0x88, 0x70, 0x56, 0xeb, 0x73, 0xa3, 0x7d, 0x9d, 0x4f, 0x55,
+	0x84, 0x74, 0x60, 0x52, 0x83, 0x82, 0x17, 0x5c, 0xeb, 0xfd, 0x9f, 0x51, 0xfd, 0x82, 0xf3, 0xa2,
+	0x04, 0xa4, 0xa2, 0xac, 0xcb, 0x11, 0xed, 0x5a, 0x2c, 0x19, 0x37, 0x86, 0xe1, 0xe5, 0xb6, 0xb3,
+	0x73, 0xac, 0xc8, 0xdc, 0x6f, 0xb6, 0x33, 0xc0, 0x1d, 0xe9, 0x93, 0x29, 0x69, 0x41, 0x55, 0xa5,
+	0x39, 0x80, 0x67, 0x8f, 0xb7, 0x26, 0xbb, 0xcf, 0xf6, 0x23, 0x73, 0x5d, 0xcf, 0x16, 0x19, 0xb6,
+	0x28, 0xe1, 0xac, 0x8e, 0x8f, 0xce, 0xe7, 0x81, 0xb5, 0x9a, 0x07, 0x0f, 0x4f, 0x70, 0x55, 0x1e,
+	0x86, 0x9b, 0x4c, 0xc2, 0xef, 0x57, 0xc1, 0xa4, 0x60, 0xf2, 0x7d, 0x97, 0x45, 0x84, 0x57, 0x06,
+	0xdd, 0x7c, 0x9e, 0x0a, 0xfa, 0x01, 0xc9, 0x93, 0x06, 0x84, 0xf2, 0x13, 0x53, 0xd7, 0x58, 0x24,
+	0xc6, 0xe1, 0x15, 0x80, 0xfb, 0xd9, 0x76, 0xf6, 0x9a, 0x12, 0x13, 0x48, 0x33, 0x46, 0x15, 0xd7,
+	0xad, 0x7f, 0x71, 0xbd, 0x36, 0x5c, 0x03, 0xcd, 0x75, 0xe3, 0xf4, 0xff, 0x01, 0xed, 0xaa, 0xb3,
+	0x31, 0xa3, 0x3d, 0x49, 0xe2, 0xdc, 0x83, 0x99, 0x5a, 0x20, 0x4d, 0x1b, 0x68, 0x19, 0xa7, 0xde,
+	0xd6, 0xd8, 0x9e, 0xec, 0xc5, 0xa3, 0xd5, 0x3c, 0x18, 0xea, 0xbb, 0xfe, 0x28, 0x08, 0xa7, 0x77,
+	0xaf, 0x95, 0x63, 0x25, 0xb8, 0x9f, 0x9c, 0x07, 0x3d, 0x09, 0xc1, 0x35, 0x81, 0xb2, 0xd4, 0x73,
+	0x22, 0x9d, 0xe4, 0x79, 0xee, 0x6d, 0x8f, 0x6d, 0xd5, 0x97, 0x5e, 0x5d, 0x74, 0xbd, 0xba, 0xe8,
+	0xa5, 0x59, 0x5d, 0xfc, 0xc4, 0xf4, 0xe5, 0xeb, 0xbb, 0xfe, 0xe2, 0x13, 0x9e, 0x5e, 0x05, 0xf6,
+	0xf4, 0x7e, 0xc6, 0x68, 0xb2, 0x96, 0x4c, 0x54, 0xce, 0x7d, 0xeb, 0x0c, 0x2b, 0x3c, 0x4b, 0x05,
+	0x48, 0x59, 0x42, 0x05, 0xb5, 0xec, 0xe7, 0x42, 0xa1, 0x15, 0xde, 0x6d, 0xd5, 0xca, 0xe3, 0xd5,
+	0x3c, 0x78, 0xa4, 0xed, 0x37, 0xd7, 0x85, 0xd3, 0x41, 0x85, 0x67, 0x6f, 0x7e, 0xe9, 0xb1, 0x96,
+	0x0f, 0xef, 0x7c, 0x39, 0x0b, 0xac, 0xd3, 0xb3, 0xc0, 0x8a, 0x8f, 0xce, 0x17, 0xbe, 0x7d, 0xb1,
+	0xf0, 0xed, 0x1f, 0x0b, 0xdf, 0xfe, 0xba, 0xf4, 0xad, 0x8b, 0xa5, 0x6f, 0x5d, 0x2e, 0x7d, 0xeb,
+	0xdd, 0x8b, 0xb5, 0xb9, 0xff, 0x7e, 0x08, 0x68, 0xfd, 0xb5, 0xcc, 0x6e, 0x44, 0x6a, 0x15, 0xd9,
+	0x8e, 0x9a, 0xc4, 0xf3, 0x9f, 0x03, 0x00, 0xe2, 0x09, 0x19, 0xaa, 0x57, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +145,19 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSettlementBidders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSettlementBidders))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BidCancellationCutoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BidCancellationCutoff):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
 	if m.ExtendedPeriod != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ExtendedPeriod))
 		i--
@@ -194,6 +226,11 @@ func (m *Params) Size() (n int) {
 	if m.ExtendedPeriod != 0 {
 		n += 1 + sovParams(uint64(m.ExtendedPeriod))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BidCancellationCutoff)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxSettlementBidders != 0 {
+		n += 1 + sovParams(uint64(m.MaxSettlementBidders))
+	}
 	return n
 }
 
@@ -319,6 +356,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCancellationCutoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BidCancellationCutoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlementBidders", wireType)
+			}
+			m.MaxSettlementBidders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSettlementBidders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
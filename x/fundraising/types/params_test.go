@@ -3,6 +3,7 @@ package types_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -22,6 +23,8 @@ func TestParams(t *testing.T) {
   amount: "100000000"
 place_bid_fee: []
 extended_period: 1
+bid_cancellation_cutoff: 24h0m0s
+max_settlement_bidders: 1000
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -48,6 +51,27 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"ZeroBidCancellationCutoff",
+			func(params *types.Params) {
+				params.BidCancellationCutoff = 0
+			},
+			"",
+		},
+		{
+			"NegativeBidCancellationCutoff",
+			func(params *types.Params) {
+				params.BidCancellationCutoff = -time.Hour
+			},
+			"bid cancellation cutoff must not be negative: -1h0m0s",
+		},
+		{
+			"ZeroMaxSettlementBidders",
+			func(params *types.Params) {
+				params.MaxSettlementBidders = 0
+			},
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceLevel returns a new PriceLevel without any bid.
+func NewPriceLevel(auctionId uint64, price sdk.Dec) PriceLevel {
+	return PriceLevel{
+		AuctionId:  auctionId,
+		Price:      price,
+		ManyAmount: sdk.ZeroInt(),
+		WorthCoins: sdk.Coins{},
+		BidsCount:  0,
+	}
+}
+
+// AddBid adds the bid to the cumulative demand of the price level.
+func (l *PriceLevel) AddBid(bid Bid) {
+	switch bid.Type {
+	case BidTypeBatchWorth:
+		l.WorthCoins = l.WorthCoins.Add(bid.Coin)
+	case BidTypeBatchMany:
+		l.ManyAmount = l.ManyAmount.Add(bid.Coin.Amount)
+	}
+	l.BidsCount++
+}
+
+// RemoveBid removes the bid from the cumulative demand of the price level.
+func (l *PriceLevel) RemoveBid(bid Bid) {
+	switch bid.Type {
+	case BidTypeBatchWorth:
+		l.WorthCoins = l.WorthCoins.Sub(bid.Coin)
+	case BidTypeBatchMany:
+		l.ManyAmount = l.ManyAmount.Sub(bid.Coin.Amount)
+	}
+	l.BidsCount--
+}
+
+// WorthPayingAmount returns the total bid coins of the how-much-worth bids at the price level
+// converted to the paying coin denom without truncation.
+// It is never less than the sum of the paying amounts converted for each bid,
+// so it can be used as the upper bound of the demand at the price level.
+func (l PriceLevel) WorthPayingAmount(payingCoinDenom string, payingCoinRates sdk.DecCoins) sdk.Dec {
+	amt := sdk.ZeroDec()
+	for _, coin := range l.WorthCoins {
+		rate := sdk.OneDec()
+		if coin.Denom != payingCoinDenom {
+			rate = payingCoinRates.AmountOf(coin.Denom)
+		}
+		amt = amt.Add(sdk.NewDecFromInt(coin.Amount).Mul(rate))
+	}
+	return amt
+}
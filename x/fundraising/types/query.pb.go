@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -802,6 +804,12 @@ func (m *QueryVestingsRequest) GetAuctionId() uint64 {
 type QueryVestingsResponse struct {
 	// vestings specifies the existing vestings
 	Vestings []VestingQueue `protobuf:"bytes,1,rep,name=vestings,proto3" json:"vestings"`
+	// linear_vesting specifies the linear vesting of the auction if the auction
+	// has the linear vesting schedule
+	LinearVesting *LinearVesting `protobuf:"bytes,2,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+	// claimable_coins specifies the paying coins that the auctioneer can claim
+	// at the current block time by the linear vesting schedule
+	ClaimableCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=claimable_coins,json=claimableCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable_coins"`
 }
 
 func (m *QueryVestingsResponse) Reset()         { *m = QueryVestingsResponse{} }
@@ -844,1308 +852,6089 @@ func (m *QueryVestingsResponse) GetVestings() []VestingQueue {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.fundraising.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.fundraising.QueryParamsResponse")
-	proto.RegisterType((*QueryAuctionsRequest)(nil), "tendermint.fundraising.QueryAuctionsRequest")
-	proto.RegisterType((*QueryAuctionsResponse)(nil), "tendermint.fundraising.QueryAuctionsResponse")
-	proto.RegisterType((*QueryAuctionRequest)(nil), "tendermint.fundraising.QueryAuctionRequest")
-	proto.RegisterType((*QueryAuctionResponse)(nil), "tendermint.fundraising.QueryAuctionResponse")
-	proto.RegisterType((*QueryAllowedBidderRequest)(nil), "tendermint.fundraising.QueryAllowedBidderRequest")
-	proto.RegisterType((*QueryAllowedBidderResponse)(nil), "tendermint.fundraising.QueryAllowedBidderResponse")
-	proto.RegisterType((*QueryAllowedBiddersRequest)(nil), "tendermint.fundraising.QueryAllowedBiddersRequest")
-	proto.RegisterType((*QueryAllowedBiddersResponse)(nil), "tendermint.fundraising.QueryAllowedBiddersResponse")
-	proto.RegisterType((*QueryBidsRequest)(nil), "tendermint.fundraising.QueryBidsRequest")
-	proto.RegisterType((*QueryBidsResponse)(nil), "tendermint.fundraising.QueryBidsResponse")
-	proto.RegisterType((*QueryBidRequest)(nil), "tendermint.fundraising.QueryBidRequest")
-	proto.RegisterType((*QueryBidResponse)(nil), "tendermint.fundraising.QueryBidResponse")
-	proto.RegisterType((*QueryVestingsRequest)(nil), "tendermint.fundraising.QueryVestingsRequest")
-	proto.RegisterType((*QueryVestingsResponse)(nil), "tendermint.fundraising.QueryVestingsResponse")
+func (m *QueryVestingsResponse) GetLinearVesting() *LinearVesting {
+	if m != nil {
+		return m.LinearVesting
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("fundraising/query.proto", fileDescriptor_c670d5b855fb1401) }
+func (m *QueryVestingsResponse) GetClaimableCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimableCoins
+	}
+	return nil
+}
 
-var fileDescriptor_c670d5b855fb1401 = []byte{
-	// 958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcd, 0x8f, 0xdb, 0x44,
-	0x14, 0xc0, 0x77, 0x76, 0xd3, 0xed, 0xee, 0x2b, 0xdd, 0xc2, 0xb0, 0x2d, 0xa9, 0x4b, 0x43, 0x65,
-	0x2d, 0x6d, 0xd8, 0x6e, 0x6d, 0x25, 0x61, 0x11, 0xa0, 0xaa, 0x28, 0x46, 0xda, 0x28, 0x08, 0x44,
-	0x6b, 0x2a, 0x0e, 0x5c, 0xa2, 0x71, 0x3c, 0x75, 0x47, 0xda, 0xd8, 0x69, 0x6c, 0x17, 0x56, 0x55,
-	0x2f, 0x70, 0x43, 0x42, 0x42, 0xaa, 0x38, 0x71, 0x00, 0xce, 0x5c, 0x38, 0x70, 0xe4, 0xc8, 0xa1,
-	0xea, 0xa9, 0x12, 0x42, 0xe2, 0x84, 0xd0, 0x2e, 0x7f, 0x08, 0xf2, 0xcc, 0x73, 0x62, 0x87, 0x7c,
-	0x38, 0xdb, 0xde, 0x3c, 0x6f, 0xde, 0xc7, 0xef, 0x7d, 0xe4, 0x4d, 0xe0, 0x95, 0x3b, 0xb1, 0xef,
-	0x0e, 0x98, 0x08, 0x85, 0xef, 0x99, 0xf7, 0x62, 0x3e, 0x38, 0x30, 0xfa, 0x83, 0x20, 0x0a, 0xe8,
-	0xb9, 0x88, 0xfb, 0x2e, 0x1f, 0xf4, 0x84, 0x1f, 0x19, 0x19, 0x1d, 0x6d, 0xbb, 0x1b, 0x84, 0xbd,
-	0x20, 0x34, 0x1d, 0x16, 0x72, 0x65, 0x60, 0xde, 0xaf, 0x39, 0x3c, 0x62, 0x35, 0xb3, 0xcf, 0x3c,
-	0xe1, 0xb3, 0x48, 0x04, 0xbe, 0xf2, 0xa1, 0x9d, 0x57, 0xba, 0x1d, 0x79, 0x32, 0xd5, 0x01, 0xaf,
-	0x36, 0xbd, 0xc0, 0x0b, 0x94, 0x3c, 0xf9, 0x4a, 0x0d, 0xbc, 0x20, 0xf0, 0xf6, 0xb9, 0x29, 0x4f,
-	0x4e, 0x7c, 0xc7, 0x64, 0x3e, 0xf2, 0x68, 0xaf, 0xe2, 0x15, 0xeb, 0x0b, 0x93, 0xf9, 0x7e, 0x10,
-	0xc9, 0x40, 0xa9, 0xbb, 0x8b, 0xd9, 0x34, 0x32, 0xdf, 0x78, 0x5d, 0xce, 0x5e, 0xf7, 0xd9, 0x80,
-	0xf5, 0xd0, 0x50, 0xdf, 0x04, 0x7a, 0x2b, 0x49, 0xe2, 0xa6, 0x14, 0xda, 0xfc, 0x5e, 0xcc, 0xc3,
-	0x48, 0xff, 0x04, 0x5e, 0xce, 0x49, 0xc3, 0x7e, 0xe0, 0x87, 0x9c, 0x5e, 0x87, 0x55, 0x65, 0x5c,
-	0x26, 0x97, 0x48, 0xf5, 0x54, 0xbd, 0x62, 0x4c, 0x2e, 0x92, 0xa1, 0xec, 0xac, 0xd2, 0xe3, 0xbf,
-	0x5f, 0x5b, 0xb2, 0xd1, 0x46, 0xff, 0x9a, 0xc0, 0xa6, 0xf4, 0xda, 0x8c, 0xbb, 0x92, 0x1d, 0xa3,
-	0xd1, 0x73, 0xb0, 0x1a, 0x46, 0x2c, 0x8a, 0x95, 0xdb, 0x75, 0x1b, 0x4f, 0x94, 0x42, 0x29, 0x3a,
-	0xe8, 0xf3, 0xf2, 0xb2, 0x94, 0xca, 0x6f, 0xba, 0x07, 0x30, 0x2a, 0x73, 0x79, 0x45, 0x62, 0x5c,
-	0x36, 0xb0, 0xb4, 0x49, 0x4f, 0x0c, 0xd5, 0x44, 0xec, 0x89, 0x71, 0x93, 0x79, 0x1c, 0xe3, 0xd8,
-	0x19, 0x4b, 0xfd, 0x47, 0x02, 0x67, 0xc7, 0x60, 0x30, 0xc9, 0x1b, 0xb0, 0xc6, 0x50, 0x56, 0x26,
-	0x97, 0x56, 0xaa, 0xa7, 0xea, 0x9b, 0x86, 0xaa, 0xbd, 0x91, 0xb6, 0xc5, 0x68, 0xfa, 0x07, 0xd6,
-	0x0b, 0x4f, 0x7e, 0xbd, 0xb6, 0x86, 0xd6, 0x6d, 0x7b, 0x68, 0x43, 0x5b, 0x39, 0xc2, 0x65, 0x49,
-	0x78, 0x65, 0x2e, 0xa1, 0x0a, 0x9e, 0x43, 0x7c, 0x13, 0x9b, 0x80, 0x31, 0xd2, 0x6a, 0x5d, 0x04,
-	0xc0, 0x58, 0x1d, 0xe1, 0xca, 0x8a, 0x95, 0xec, 0x75, 0x94, 0xb4, 0x5d, 0xfd, 0x76, 0xbe, 0xc8,
-	0x99, 0xde, 0x9d, 0x44, 0x25, 0x6c, 0x5e, 0x91, 0xac, 0x52, 0x13, 0xdd, 0x86, 0xf3, 0xca, 0xeb,
-	0xfe, 0x7e, 0xf0, 0x39, 0x77, 0x2d, 0xe1, 0xba, 0x7c, 0x50, 0x8c, 0x28, 0x69, 0xaf, 0x23, 0xf5,
-	0xb1, 0x91, 0x78, 0xd2, 0xfb, 0xa0, 0x4d, 0xf2, 0x89, 0xbc, 0x36, 0x6c, 0x30, 0x75, 0xd1, 0x41,
-	0x6b, 0x85, 0xfd, 0xfa, 0xb4, 0x99, 0xcb, 0xb9, 0xc1, 0xd1, 0x3b, 0xcd, 0xb2, 0x42, 0xfd, 0x2b,
-	0x32, 0x29, 0x64, 0x58, 0x30, 0x8f, 0xbd, 0x09, 0x8d, 0x3d, 0xce, 0xe8, 0xfd, 0x46, 0xe0, 0xc2,
-	0x44, 0x0a, 0xcc, 0xfc, 0x36, 0x9c, 0xc9, 0x67, 0x9e, 0xce, 0xe1, 0x42, 0xa9, 0x6f, 0xe4, 0x52,
-	0x7f, 0x8e, 0x63, 0xf9, 0x0b, 0x81, 0x17, 0x25, 0xbe, 0x25, 0xdc, 0xf0, 0xd9, 0x46, 0x20, 0x31,
-	0x13, 0x61, 0xa7, 0xc7, 0xa2, 0xee, 0x5d, 0xee, 0xca, 0x5f, 0xf3, 0xba, 0xbd, 0x2e, 0xc2, 0x8f,
-	0x94, 0x60, 0xac, 0xe2, 0xa5, 0x63, 0x57, 0xfc, 0x11, 0x81, 0x97, 0x32, 0xc8, 0x58, 0xe7, 0x5d,
-	0x28, 0x39, 0xc2, 0x4d, 0x8b, 0x7b, 0x61, 0x5a, 0x71, 0x2d, 0xe1, 0x62, 0x49, 0xa5, 0xfa, 0xf3,
-	0x2b, 0x64, 0x0b, 0xce, 0xa4, 0x50, 0x05, 0xcb, 0x78, 0x56, 0x96, 0x31, 0xb9, 0x5a, 0x96, 0x57,
-	0x27, 0x1c, 0xe1, 0xb6, 0x5d, 0xbd, 0x35, 0x6a, 0xc8, 0x30, 0xb9, 0x06, 0xac, 0x38, 0xe8, 0xa2,
-	0x50, 0x6e, 0x89, 0xb6, 0xbe, 0x8b, 0xbb, 0xe3, 0x53, 0x1e, 0x46, 0xc2, 0xf7, 0x0a, 0x76, 0x57,
-	0xef, 0xe0, 0x2a, 0x1d, 0x99, 0x21, 0xc4, 0x1e, 0xac, 0xdd, 0x47, 0x19, 0x56, 0x79, 0x6b, 0x1a,
-	0x09, 0xda, 0xde, 0x8a, 0x79, 0xcc, 0x11, 0x69, 0x68, 0x5b, 0xff, 0x13, 0xe0, 0x84, 0x8c, 0x40,
-	0xbf, 0x21, 0xb0, 0xaa, 0x1e, 0x17, 0xba, 0x3d, 0xcd, 0xd5, 0xff, 0xdf, 0x33, 0xed, 0x6a, 0x21,
-	0x5d, 0x45, 0xad, 0x6f, 0x7f, 0xf9, 0xc7, 0xbf, 0x8f, 0x96, 0xb7, 0xa8, 0x8e, 0x2f, 0x76, 0xf6,
-	0x3d, 0xcd, 0xbc, 0xf5, 0x12, 0xe2, 0x3b, 0x02, 0xe9, 0xb6, 0x0c, 0xe9, 0xce, 0xcc, 0x28, 0x63,
-	0xaf, 0x9e, 0x76, 0xad, 0xa0, 0x36, 0x52, 0xed, 0x48, 0xaa, 0xcb, 0x74, 0x6b, 0x16, 0xd5, 0xf0,
-	0x11, 0xfa, 0x81, 0xc0, 0x49, 0x74, 0x41, 0xaf, 0x16, 0x09, 0x94, 0x52, 0xed, 0x14, 0x53, 0x46,
-	0xa8, 0x77, 0x24, 0x54, 0x83, 0xd6, 0x8a, 0x40, 0x99, 0x0f, 0x46, 0x43, 0xf4, 0x90, 0x3e, 0x21,
-	0x70, 0x3a, 0xb7, 0xb7, 0x68, 0x6d, 0x76, 0xe8, 0x09, 0x2f, 0x8f, 0x56, 0x5f, 0xc4, 0x04, 0x99,
-	0x6d, 0xc9, 0xfc, 0x21, 0xfd, 0x60, 0x61, 0x66, 0x73, 0x6c, 0x2d, 0x9b, 0x0f, 0xd4, 0xc7, 0x43,
-	0xfa, 0x3b, 0x81, 0x8d, 0xfc, 0x36, 0xa7, 0x0b, 0xa0, 0x0d, 0x47, 0xa2, 0xb1, 0x90, 0x0d, 0xe6,
-	0xd3, 0x96, 0xf9, 0xbc, 0x4f, 0x9b, 0xcf, 0x9c, 0x0f, 0xfd, 0x9e, 0x40, 0x29, 0x59, 0x91, 0xb4,
-	0x3a, 0x13, 0x24, 0xb3, 0xf8, 0xb5, 0x37, 0x0a, 0x68, 0x22, 0xe8, 0x0d, 0x09, 0xfa, 0x36, 0x7d,
-	0x6b, 0x71, 0x50, 0xb9, 0x78, 0x7f, 0x22, 0xb0, 0x62, 0x09, 0x97, 0x5e, 0x99, 0x17, 0x32, 0x65,
-	0xab, 0xce, 0x57, 0x44, 0xb4, 0x96, 0x44, 0x6b, 0xd2, 0xf7, 0x8e, 0x87, 0x26, 0x07, 0x41, 0x4e,
-	0xf5, 0xcf, 0x04, 0xd6, 0xd2, 0x35, 0x38, 0x67, 0x1f, 0x8c, 0x2d, 0xd9, 0x39, 0xfb, 0x60, 0x7c,
-	0xb7, 0xea, 0x96, 0x44, 0xbe, 0x4e, 0xdf, 0x5d, 0x1c, 0x39, 0xdd, 0xab, 0xd6, 0xc7, 0x8f, 0x0f,
-	0x2b, 0xe4, 0xe9, 0x61, 0x85, 0xfc, 0x73, 0x58, 0x21, 0xdf, 0x1e, 0x55, 0x96, 0x9e, 0x1e, 0x55,
-	0x96, 0xfe, 0x3a, 0xaa, 0x2c, 0x7d, 0xb6, 0xeb, 0x89, 0xe8, 0x6e, 0xec, 0x18, 0xdd, 0xa0, 0x67,
-	0x8e, 0xb0, 0x72, 0x31, 0xbe, 0xc8, 0x9d, 0x92, 0x3f, 0xe7, 0xa1, 0xb3, 0x2a, 0xff, 0x4b, 0x36,
-	0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x24, 0x03, 0x21, 0xf9, 0x56, 0x0d, 0x00, 0x00,
+// QueryBidderVestingsRequest is request type for the Query/BidderVestings RPC
+// method.
+type QueryBidderVestingsRequest struct {
+	Bidder     string             `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBidderVestingsRequest) Reset()         { *m = QueryBidderVestingsRequest{} }
+func (m *QueryBidderVestingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderVestingsRequest) ProtoMessage()    {}
+func (*QueryBidderVestingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{16}
+}
+func (m *QueryBidderVestingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderVestingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderVestingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidderVestingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderVestingsRequest.Merge(m, src)
+}
+func (m *QueryBidderVestingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderVestingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderVestingsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBidderVestingsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the fundraising module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Auctions returns all auctions.
-	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
-	// Auction returns the specific auction.
-	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
-	// AllowedBidder returns the specific allowed bidder information.
-	AllowedBidder(ctx context.Context, in *QueryAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllowedBidderResponse, error)
-	// AllowedBidders returns all allowed bidders for the auction.
-	AllowedBidders(ctx context.Context, in *QueryAllowedBiddersRequest, opts ...grpc.CallOption) (*QueryAllowedBiddersResponse, error)
-	// Bids returns all bids.
-	Bids(ctx context.Context, in *QueryBidsRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error)
-	// Bid returns the specific bid from the auction id and bid id.
-	Bid(ctx context.Context, in *QueryBidRequest, opts ...grpc.CallOption) (*QueryBidResponse, error)
-	// Vestings returns all vestings for the auction.
-	Vestings(ctx context.Context, in *QueryVestingsRequest, opts ...grpc.CallOption) (*QueryVestingsResponse, error)
+func (m *QueryBidderVestingsRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBidderVestingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryBidderVestingsResponse is response type for the Query/BidderVestings RPC
+// method.
+type QueryBidderVestingsResponse struct {
+	// vestings specifies the vesting queues of the bidder that are not released
+	Vestings []BidderVestingQueue `protobuf:"bytes,1,rep,name=vestings,proto3" json:"vestings"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBidderVestingsResponse) Reset()         { *m = QueryBidderVestingsResponse{} }
+func (m *QueryBidderVestingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderVestingsResponse) ProtoMessage()    {}
+func (*QueryBidderVestingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{17}
+}
+func (m *QueryBidderVestingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderVestingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderVestingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBidderVestingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderVestingsResponse.Merge(m, src)
+}
+func (m *QueryBidderVestingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderVestingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderVestingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
-	out := new(QueryAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Auctions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBidderVestingsResponse proto.InternalMessageInfo
+
+func (m *QueryBidderVestingsResponse) GetVestings() []BidderVestingQueue {
+	if m != nil {
+		return m.Vestings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
-	out := new(QueryAuctionResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Auction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBidderVestingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllowedBidder(ctx context.Context, in *QueryAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllowedBidderResponse, error) {
-	out := new(QueryAllowedBidderResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AllowedBidder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySimulateBatchMatchRequest is request type for the Query/SimulateBatchMatch RPC method.
+type QuerySimulateBatchMatchRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (c *queryClient) AllowedBidders(ctx context.Context, in *QueryAllowedBiddersRequest, opts ...grpc.CallOption) (*QueryAllowedBiddersResponse, error) {
-	out := new(QueryAllowedBiddersResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AllowedBidders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateBatchMatchRequest) Reset()         { *m = QuerySimulateBatchMatchRequest{} }
+func (m *QuerySimulateBatchMatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBatchMatchRequest) ProtoMessage()    {}
+func (*QuerySimulateBatchMatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{18}
+}
+func (m *QuerySimulateBatchMatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBatchMatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBatchMatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateBatchMatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBatchMatchRequest.Merge(m, src)
+}
+func (m *QuerySimulateBatchMatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBatchMatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBatchMatchRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Bids(ctx context.Context, in *QueryBidsRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error) {
-	out := new(QueryBidsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Bids", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateBatchMatchRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateBatchMatchRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Bid(ctx context.Context, in *QueryBidRequest, opts ...grpc.CallOption) (*QueryBidResponse, error) {
-	out := new(QueryBidResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Bid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateBatchMatchRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Vestings(ctx context.Context, in *QueryVestingsRequest, opts ...grpc.CallOption) (*QueryVestingsResponse, error) {
-	out := new(QueryVestingsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Vestings", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QuerySimulateBatchMatchResponse is response type for the Query/SimulateBatchMatch RPC method.
+type QuerySimulateBatchMatchResponse struct {
+	// matched specifies whether any bid would be matched
+	Matched bool `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	// matched_price specifies the price that the auction would be matched at
+	MatchedPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=matched_price,json=matchedPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"matched_price"`
+	// matched_amount specifies the total amount of selling coin that would be
+	// sold
+	MatchedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=matched_amount,json=matchedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"matched_amount"`
+	// matched_bids_count specifies the number of bids that would be matched
+	MatchedBidsCount uint64 `protobuf:"varint,4,opt,name=matched_bids_count,json=matchedBidsCount,proto3" json:"matched_bids_count,omitempty"`
+	// allocated_amount specifies the amount of selling coin that the bidder
+	// would receive
+	AllocatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=allocated_amount,json=allocatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allocated_amount"`
+	// refund_amount specifies the amount of paying coin that would be refunded
+	// to the bidder
+	RefundAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=refund_amount,json=refundAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refund_amount"`
+	// paid_amount specifies the amount of paying coin that the bidder would pay
+	// for the allocated selling coin
+	PaidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=paid_amount,json=paidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"paid_amount"`
+}
+
+func (m *QuerySimulateBatchMatchResponse) Reset()         { *m = QuerySimulateBatchMatchResponse{} }
+func (m *QuerySimulateBatchMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBatchMatchResponse) ProtoMessage()    {}
+func (*QuerySimulateBatchMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{19}
+}
+func (m *QuerySimulateBatchMatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBatchMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBatchMatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the fundraising module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Auctions returns all auctions.
-	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
-	// Auction returns the specific auction.
-	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
-	// AllowedBidder returns the specific allowed bidder information.
-	AllowedBidder(context.Context, *QueryAllowedBidderRequest) (*QueryAllowedBidderResponse, error)
-	// AllowedBidders returns all allowed bidders for the auction.
-	AllowedBidders(context.Context, *QueryAllowedBiddersRequest) (*QueryAllowedBiddersResponse, error)
-	// Bids returns all bids.
-	Bids(context.Context, *QueryBidsRequest) (*QueryBidsResponse, error)
-	// Bid returns the specific bid from the auction id and bid id.
-	Bid(context.Context, *QueryBidRequest) (*QueryBidResponse, error)
-	// Vestings returns all vestings for the auction.
-	Vestings(context.Context, *QueryVestingsRequest) (*QueryVestingsResponse, error)
+func (m *QuerySimulateBatchMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBatchMatchResponse.Merge(m, src)
+}
+func (m *QuerySimulateBatchMatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBatchMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBatchMatchResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QuerySimulateBatchMatchResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateBatchMatchResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySimulateBatchMatchResponse) GetMatchedBidsCount() uint64 {
+	if m != nil {
+		return m.MatchedBidsCount
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
+
+// QueryAuctionOrderBookRequest is request type for the Query/AuctionOrderBook RPC method.
+type QueryAuctionOrderBookRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// tick_size specifies the price tick that the bid prices are bucketed into;
+	// each bid price is rounded down to a multiple of the tick size and the bid
+	// prices are not bucketed if it is empty
+	TickSize   string             `protobuf:"bytes,2,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionRequest) (*QueryAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+
+func (m *QueryAuctionOrderBookRequest) Reset()         { *m = QueryAuctionOrderBookRequest{} }
+func (m *QueryAuctionOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionOrderBookRequest) ProtoMessage()    {}
+func (*QueryAuctionOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{20}
 }
-func (*UnimplementedQueryServer) AllowedBidder(ctx context.Context, req *QueryAllowedBidderRequest) (*QueryAllowedBidderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedBidder not implemented")
+func (m *QueryAuctionOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllowedBidders(ctx context.Context, req *QueryAllowedBiddersRequest) (*QueryAllowedBiddersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedBidders not implemented")
+func (m *QueryAuctionOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Bids(ctx context.Context, req *QueryBidsRequest) (*QueryBidsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bids not implemented")
+func (m *QueryAuctionOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionOrderBookRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Bid(ctx context.Context, req *QueryBidRequest) (*QueryBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+func (m *QueryAuctionOrderBookRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Vestings(ctx context.Context, req *QueryVestingsRequest) (*QueryVestingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vestings not implemented")
+func (m *QueryAuctionOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionOrderBookRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAuctionOrderBookRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAuctionOrderBookRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuctionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Auctions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/Auctions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+func (m *QueryAuctionOrderBookRequest) GetTickSize() string {
+	if m != nil {
+		return m.TickSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuctionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Auction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/Auction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+func (m *QueryAuctionOrderBookRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedBidderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowedBidder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/AllowedBidder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedBidder(ctx, req.(*QueryAllowedBidderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAuctionOrderBookResponse is response type for the Query/AuctionOrderBook RPC method.
+type QueryAuctionOrderBookResponse struct {
+	// price_levels specifies the price levels in descending order of the price
+	PriceLevels []OrderBookPriceLevel `protobuf:"bytes,1,rep,name=price_levels,json=priceLevels,proto3" json:"price_levels"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_AllowedBidders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedBiddersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowedBidders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/AllowedBidders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedBidders(ctx, req.(*QueryAllowedBiddersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAuctionOrderBookResponse) Reset()         { *m = QueryAuctionOrderBookResponse{} }
+func (m *QueryAuctionOrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionOrderBookResponse) ProtoMessage()    {}
+func (*QueryAuctionOrderBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{21}
 }
-
-func _Query_Bids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBidsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Bids(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/Bids",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Bids(ctx, req.(*QueryBidsRequest))
+func (m *QueryAuctionOrderBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionOrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionOrderBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAuctionOrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionOrderBookResponse.Merge(m, src)
+}
+func (m *QueryAuctionOrderBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionOrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionOrderBookResponse.DiscardUnknown(m)
 }
 
-func _Query_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Bid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/Bid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Bid(ctx, req.(*QueryBidRequest))
+var xxx_messageInfo_QueryAuctionOrderBookResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionOrderBookResponse) GetPriceLevels() []OrderBookPriceLevel {
+	if m != nil {
+		return m.PriceLevels
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Vestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVestingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vestings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Query/Vestings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vestings(ctx, req.(*QueryVestingsRequest))
+func (m *QueryAuctionOrderBookResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.fundraising.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Auctions",
-			Handler:    _Query_Auctions_Handler,
-		},
-		{
-			MethodName: "Auction",
-			Handler:    _Query_Auction_Handler,
-		},
-		{
-			MethodName: "AllowedBidder",
-			Handler:    _Query_AllowedBidder_Handler,
-		},
-		{
-			MethodName: "AllowedBidders",
-			Handler:    _Query_AllowedBidders_Handler,
-		},
-		{
-			MethodName: "Bids",
-			Handler:    _Query_Bids_Handler,
-		},
-		{
-			MethodName: "Bid",
-			Handler:    _Query_Bid_Handler,
-		},
-		{
-			MethodName: "Vestings",
-			Handler:    _Query_Vestings_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fundraising/query.proto",
+// QueryAuctionSettlementRequest is request type for the Query/AuctionSettlement RPC method.
+type QueryAuctionSettlementRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionSettlementRequest) Reset()         { *m = QueryAuctionSettlementRequest{} }
+func (m *QueryAuctionSettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionSettlementRequest) ProtoMessage()    {}
+func (*QueryAuctionSettlementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{22}
+}
+func (m *QueryAuctionSettlementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionSettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionSettlementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAuctionSettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionSettlementRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAuctionSettlementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionSettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionSettlementRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionSettlementRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionSettlementRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAuctionSettlementResponse is response type for the Query/AuctionSettlement RPC method.
+type QueryAuctionSettlementResponse struct {
+	// settlement specifies the settlement record of the auction
+	Settlement AuctionSettlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement"`
+	// cursor specifies the settlement progress of the auction; it is only set
+	// while the auction is settling
+	Cursor *SettlementCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAuctionSettlementResponse) Reset()         { *m = QueryAuctionSettlementResponse{} }
+func (m *QueryAuctionSettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionSettlementResponse) ProtoMessage()    {}
+func (*QueryAuctionSettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{23}
+}
+func (m *QueryAuctionSettlementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionSettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionSettlementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAuctionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAuctionSettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionSettlementResponse.Merge(m, src)
 }
-
-func (m *QueryAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAuctionSettlementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionSettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionSettlementResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAuctionSettlementResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionSettlementResponse) GetSettlement() AuctionSettlement {
+	if m != nil {
+		return m.Settlement
 	}
-	return len(dAtA) - i, nil
+	return AuctionSettlement{}
 }
 
-func (m *QueryAuctionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionSettlementResponse) GetCursor() *SettlementCursor {
+	if m != nil {
+		return m.Cursor
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBidderSettlementsRequest is request type for the Query/BidderSettlements RPC method.
+type QueryBidderSettlementsRequest struct {
+	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Auctions) > 0 {
-		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBidderSettlementsRequest) Reset()         { *m = QueryBidderSettlementsRequest{} }
+func (m *QueryBidderSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderSettlementsRequest) ProtoMessage()    {}
+func (*QueryBidderSettlementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{24}
+}
+func (m *QueryBidderSettlementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderSettlementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAuctionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBidderSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderSettlementsRequest.Merge(m, src)
 }
-
-func (m *QueryAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBidderSettlementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderSettlementsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryBidderSettlementsRequest proto.InternalMessageInfo
+
+func (m *QueryBidderSettlementsRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBidderSettlementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBidderSettlementsResponse is response type for the Query/BidderSettlements RPC method.
+type QueryBidderSettlementsResponse struct {
+	// settlements specifies the settlement records of the bidders
+	Settlements []BidderSettlement `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Auction != nil {
-		{
-			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBidderSettlementsResponse) Reset()         { *m = QueryBidderSettlementsResponse{} }
+func (m *QueryBidderSettlementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderSettlementsResponse) ProtoMessage()    {}
+func (*QueryBidderSettlementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{25}
+}
+func (m *QueryBidderSettlementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderSettlementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllowedBidderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBidderSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderSettlementsResponse.Merge(m, src)
 }
-
-func (m *QueryAllowedBidderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBidderSettlementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderSettlementsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryBidderSettlementsResponse proto.InternalMessageInfo
+
+func (m *QueryBidderSettlementsResponse) GetSettlements() []BidderSettlement {
+	if m != nil {
+		return m.Settlements
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBidderSettlementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAuctionFailureRequest is request type for the Query/AuctionFailure RPC method.
+type QueryAuctionFailureRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *QueryAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAuctionFailureRequest) Reset()         { *m = QueryAuctionFailureRequest{} }
+func (m *QueryAuctionFailureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionFailureRequest) ProtoMessage()    {}
+func (*QueryAuctionFailureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{26}
+}
+func (m *QueryAuctionFailureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionFailureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionFailureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllowedBiddersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAuctionFailureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionFailureRequest.Merge(m, src)
 }
-
-func (m *QueryAllowedBiddersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAuctionFailureRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllowedBiddersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAuctionFailureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionFailureRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedBiddersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionFailureRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionFailureRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllowedBiddersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAuctionFailureResponse is response type for the Query/AuctionFailure RPC method.
+type QueryAuctionFailureResponse struct {
+	// failure specifies the failure record of the auction
+	Failure AuctionFailure `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure"`
 }
 
-func (m *QueryAllowedBiddersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AllowedBidders) > 0 {
-		for iNdEx := len(m.AllowedBidders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowedBidders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAuctionFailureResponse) Reset()         { *m = QueryAuctionFailureResponse{} }
+func (m *QueryAuctionFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionFailureResponse) ProtoMessage()    {}
+func (*QueryAuctionFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{27}
+}
+func (m *QueryAuctionFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAuctionFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionFailureResponse.Merge(m, src)
+}
+func (m *QueryAuctionFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionFailureResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBidsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionFailureResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionFailureResponse) GetFailure() AuctionFailure {
+	if m != nil {
+		return m.Failure
 	}
-	return dAtA[:n], nil
+	return AuctionFailure{}
 }
 
-func (m *QueryBidsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllocationClaimsRequest is request type for the Query/AllocationClaims
+// RPC method.
+type QueryAllocationClaimsRequest struct {
+	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllocationClaimsRequest) Reset()         { *m = QueryAllocationClaimsRequest{} }
+func (m *QueryAllocationClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationClaimsRequest) ProtoMessage()    {}
+func (*QueryAllocationClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{28}
+}
+func (m *QueryAllocationClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IsMatched) > 0 {
-		i -= len(m.IsMatched)
-		copy(dAtA[i:], m.IsMatched)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsMatched)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryAllocationClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationClaimsRequest.Merge(m, src)
+}
+func (m *QueryAllocationClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationClaimsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllocationClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryAllocationClaimsRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBidsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllocationClaimsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBidsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllocationClaimsResponse is response type for the
+// Query/AllocationClaims RPC method.
+type QueryAllocationClaimsResponse struct {
+	// claims specifies the allocation claims that are not claimed yet
+	Claims []AllocationClaim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bids) > 0 {
-		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllocationClaimsResponse) Reset()         { *m = QueryAllocationClaimsResponse{} }
+func (m *QueryAllocationClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationClaimsResponse) ProtoMessage()    {}
+func (*QueryAllocationClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{29}
+}
+func (m *QueryAllocationClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllocationClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationClaimsResponse.Merge(m, src)
 }
-
-func (m *QueryBidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllocationClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationClaimsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BidId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryAllocationClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryAllocationClaimsResponse) GetClaims() []AllocationClaim {
+	if m != nil {
+		return m.Claims
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllocationClaimsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllocationClaimRequest is request type for the Query/AllocationClaim
+// RPC method.
+type QueryAllocationClaimRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *QueryBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllocationClaimRequest) Reset()         { *m = QueryAllocationClaimRequest{} }
+func (m *QueryAllocationClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationClaimRequest) ProtoMessage()    {}
+func (*QueryAllocationClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{30}
+}
+func (m *QueryAllocationClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVestingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllocationClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationClaimRequest.Merge(m, src)
 }
-
-func (m *QueryVestingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllocationClaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationClaimRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVestingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryAllocationClaimRequest proto.InternalMessageInfo
+
+func (m *QueryAllocationClaimRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryVestingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllocationClaimRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVestingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllocationClaimResponse is response type for the Query/AllocationClaim
+// RPC method.
+type QueryAllocationClaimResponse struct {
+	// claim specifies the allocation claim of the bidder
+	Claim AllocationClaim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim"`
 }
 
-func (m *QueryVestingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vestings) > 0 {
-		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllocationClaimResponse) Reset()         { *m = QueryAllocationClaimResponse{} }
+func (m *QueryAllocationClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationClaimResponse) ProtoMessage()    {}
+func (*QueryAllocationClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{31}
+}
+func (m *QueryAllocationClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAllocationClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationClaimResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllocationClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationClaimResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllocationClaimResponse proto.InternalMessageInfo
+
+func (m *QueryAllocationClaimResponse) GetClaim() AllocationClaim {
+	if m != nil {
+		return m.Claim
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return AllocationClaim{}
 }
 
-func (m *QueryAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBidCommitmentsRequest is request type for the Query/BidCommitments RPC
+// method.
+type QueryBidCommitmentsRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address to filter the commitments by;
+	// all commitments are returned if it is empty
+	Bidder     string             `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Auctions) > 0 {
-		for _, e := range m.Auctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBidCommitmentsRequest) Reset()         { *m = QueryBidCommitmentsRequest{} }
+func (m *QueryBidCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidCommitmentsRequest) ProtoMessage()    {}
+func (*QueryBidCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{32}
+}
+func (m *QueryBidCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBidCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidCommitmentsRequest.Merge(m, src)
+}
+func (m *QueryBidCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidCommitmentsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+var xxx_messageInfo_QueryBidCommitmentsRequest proto.InternalMessageInfo
+
+func (m *QueryBidCommitmentsRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Auction != nil {
-		l = m.Auction.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBidCommitmentsRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllowedBidderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidCommitmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	return nil
+}
+
+// QueryBidCommitmentsResponse is response type for the Query/BidCommitments
+// RPC method.
+type QueryBidCommitmentsResponse struct {
+	// commitments specifies the sealed bids that are not revealed yet
+	Commitments []BidCommitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBidCommitmentsResponse) Reset()         { *m = QueryBidCommitmentsResponse{} }
+func (m *QueryBidCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidCommitmentsResponse) ProtoMessage()    {}
+func (*QueryBidCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{33}
+}
+func (m *QueryBidCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryBidCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidCommitmentsResponse.Merge(m, src)
+}
+func (m *QueryBidCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidCommitmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidCommitmentsResponse proto.InternalMessageInfo
+
+func (m *QueryBidCommitmentsResponse) GetCommitments() []BidCommitment {
+	if m != nil {
+		return m.Commitments
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllowedBidderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidCommitmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.AllowedBidder.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllowedBiddersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBidderAggregateRequest is request type for the Query/BidderAggregate
+// RPC method.
+type QueryBidderAggregateRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *QueryAllowedBiddersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AllowedBidders) > 0 {
-		for _, e := range m.AllowedBidders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBidderAggregateRequest) Reset()         { *m = QueryBidderAggregateRequest{} }
+func (m *QueryBidderAggregateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAggregateRequest) ProtoMessage()    {}
+func (*QueryBidderAggregateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{34}
+}
+func (m *QueryBidderAggregateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAggregateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAggregateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBidderAggregateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAggregateRequest.Merge(m, src)
+}
+func (m *QueryBidderAggregateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAggregateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAggregateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBidsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.IsMatched)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBidderAggregateRequest proto.InternalMessageInfo
+
+func (m *QueryBidderAggregateRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryBidsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidderAggregateRequest) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	var l int
-	_ = l
-	if len(m.Bids) > 0 {
-		for _, e := range m.Bids {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryBidderAggregateResponse is response type for the Query/BidderAggregate
+// RPC method.
+type QueryBidderAggregateResponse struct {
+	// aggregate specifies the running totals of the bids of the bidder
+	Aggregate BidderAggregate `protobuf:"bytes,1,opt,name=aggregate,proto3" json:"aggregate"`
+}
+
+func (m *QueryBidderAggregateResponse) Reset()         { *m = QueryBidderAggregateResponse{} }
+func (m *QueryBidderAggregateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAggregateResponse) ProtoMessage()    {}
+func (*QueryBidderAggregateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c670d5b855fb1401, []int{35}
+}
+func (m *QueryBidderAggregateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAggregateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAggregateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryBidderAggregateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAggregateResponse.Merge(m, src)
+}
+func (m *QueryBidderAggregateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAggregateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAggregateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidderAggregateResponse proto.InternalMessageInfo
+
+func (m *QueryBidderAggregateResponse) GetAggregate() BidderAggregate {
+	if m != nil {
+		return m.Aggregate
 	}
-	return n
+	return BidderAggregate{}
 }
 
-func (m *QueryBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.fundraising.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.fundraising.QueryParamsResponse")
+	proto.RegisterType((*QueryAuctionsRequest)(nil), "tendermint.fundraising.QueryAuctionsRequest")
+	proto.RegisterType((*QueryAuctionsResponse)(nil), "tendermint.fundraising.QueryAuctionsResponse")
+	proto.RegisterType((*QueryAuctionRequest)(nil), "tendermint.fundraising.QueryAuctionRequest")
+	proto.RegisterType((*QueryAuctionResponse)(nil), "tendermint.fundraising.QueryAuctionResponse")
+	proto.RegisterType((*QueryAllowedBidderRequest)(nil), "tendermint.fundraising.QueryAllowedBidderRequest")
+	proto.RegisterType((*QueryAllowedBidderResponse)(nil), "tendermint.fundraising.QueryAllowedBidderResponse")
+	proto.RegisterType((*QueryAllowedBiddersRequest)(nil), "tendermint.fundraising.QueryAllowedBiddersRequest")
+	proto.RegisterType((*QueryAllowedBiddersResponse)(nil), "tendermint.fundraising.QueryAllowedBiddersResponse")
+	proto.RegisterType((*QueryBidsRequest)(nil), "tendermint.fundraising.QueryBidsRequest")
+	proto.RegisterType((*QueryBidsResponse)(nil), "tendermint.fundraising.QueryBidsResponse")
+	proto.RegisterType((*QueryBidRequest)(nil), "tendermint.fundraising.QueryBidRequest")
+	proto.RegisterType((*QueryBidResponse)(nil), "tendermint.fundraising.QueryBidResponse")
+	proto.RegisterType((*QueryVestingsRequest)(nil), "tendermint.fundraising.QueryVestingsRequest")
+	proto.RegisterType((*QueryVestingsResponse)(nil), "tendermint.fundraising.QueryVestingsResponse")
+	proto.RegisterType((*QueryBidderVestingsRequest)(nil), "tendermint.fundraising.QueryBidderVestingsRequest")
+	proto.RegisterType((*QueryBidderVestingsResponse)(nil), "tendermint.fundraising.QueryBidderVestingsResponse")
+	proto.RegisterType((*QuerySimulateBatchMatchRequest)(nil), "tendermint.fundraising.QuerySimulateBatchMatchRequest")
+	proto.RegisterType((*QuerySimulateBatchMatchResponse)(nil), "tendermint.fundraising.QuerySimulateBatchMatchResponse")
+	proto.RegisterType((*QueryAuctionOrderBookRequest)(nil), "tendermint.fundraising.QueryAuctionOrderBookRequest")
+	proto.RegisterType((*QueryAuctionOrderBookResponse)(nil), "tendermint.fundraising.QueryAuctionOrderBookResponse")
+	proto.RegisterType((*QueryAuctionSettlementRequest)(nil), "tendermint.fundraising.QueryAuctionSettlementRequest")
+	proto.RegisterType((*QueryAuctionSettlementResponse)(nil), "tendermint.fundraising.QueryAuctionSettlementResponse")
+	proto.RegisterType((*QueryBidderSettlementsRequest)(nil), "tendermint.fundraising.QueryBidderSettlementsRequest")
+	proto.RegisterType((*QueryBidderSettlementsResponse)(nil), "tendermint.fundraising.QueryBidderSettlementsResponse")
+	proto.RegisterType((*QueryAuctionFailureRequest)(nil), "tendermint.fundraising.QueryAuctionFailureRequest")
+	proto.RegisterType((*QueryAuctionFailureResponse)(nil), "tendermint.fundraising.QueryAuctionFailureResponse")
+	proto.RegisterType((*QueryAllocationClaimsRequest)(nil), "tendermint.fundraising.QueryAllocationClaimsRequest")
+	proto.RegisterType((*QueryAllocationClaimsResponse)(nil), "tendermint.fundraising.QueryAllocationClaimsResponse")
+	proto.RegisterType((*QueryAllocationClaimRequest)(nil), "tendermint.fundraising.QueryAllocationClaimRequest")
+	proto.RegisterType((*QueryAllocationClaimResponse)(nil), "tendermint.fundraising.QueryAllocationClaimResponse")
+	proto.RegisterType((*QueryBidCommitmentsRequest)(nil), "tendermint.fundraising.QueryBidCommitmentsRequest")
+	proto.RegisterType((*QueryBidCommitmentsResponse)(nil), "tendermint.fundraising.QueryBidCommitmentsResponse")
+	proto.RegisterType((*QueryBidderAggregateRequest)(nil), "tendermint.fundraising.QueryBidderAggregateRequest")
+	proto.RegisterType((*QueryBidderAggregateResponse)(nil), "tendermint.fundraising.QueryBidderAggregateResponse")
+}
+
+func init() { proto.RegisterFile("fundraising/query.proto", fileDescriptor_c670d5b855fb1401) }
+
+var fileDescriptor_c670d5b855fb1401 = []byte{
+	// 1909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0x1b, 0x5f,
+	0x15, 0xce, 0x4d, 0x9c, 0xd7, 0xc9, 0xb3, 0x97, 0xb4, 0xb8, 0xd3, 0xd6, 0xa9, 0x46, 0x25, 0x4d,
+	0xdb, 0xc4, 0x26, 0x71, 0x92, 0xbe, 0xd3, 0xda, 0x69, 0x13, 0x02, 0x69, 0x93, 0x4e, 0x02, 0x08,
+	0x36, 0xd6, 0xd8, 0x9e, 0xba, 0xa3, 0xd8, 0x33, 0xae, 0x67, 0x5c, 0x68, 0x4b, 0x37, 0x20, 0x58,
+	0x20, 0x21, 0x21, 0x55, 0xb0, 0xe9, 0x82, 0xd7, 0x0e, 0x16, 0x20, 0xb5, 0x48, 0x48, 0x54, 0x08,
+	0x24, 0x10, 0x55, 0x57, 0x15, 0x08, 0x09, 0xb1, 0x28, 0xa8, 0xe5, 0x0f, 0xf9, 0x69, 0xee, 0x9c,
+	0x3b, 0x9e, 0x19, 0xbf, 0x66, 0x6c, 0xab, 0xab, 0x78, 0xee, 0xbd, 0xe7, 0xbb, 0xdf, 0x77, 0xce,
+	0xb9, 0x8f, 0x73, 0x03, 0x9f, 0xbf, 0x5f, 0xd5, 0xf2, 0x15, 0x59, 0x35, 0x54, 0xad, 0x90, 0x78,
+	0x58, 0x55, 0x2a, 0x8f, 0xe3, 0xe5, 0x8a, 0x6e, 0xea, 0xf4, 0x98, 0xa9, 0x68, 0x79, 0xa5, 0x52,
+	0x52, 0x35, 0x33, 0xee, 0x1a, 0x23, 0x9c, 0xcf, 0xe9, 0x46, 0x49, 0x37, 0x12, 0x59, 0xd9, 0x50,
+	0x6c, 0x83, 0xc4, 0xa3, 0xa5, 0xac, 0x62, 0xca, 0x4b, 0x89, 0xb2, 0x5c, 0x50, 0x35, 0xd9, 0x54,
+	0x75, 0xcd, 0xc6, 0x10, 0x62, 0xee, 0xb1, 0x7c, 0x54, 0x4e, 0x57, 0x79, 0xff, 0x71, 0xbb, 0x3f,
+	0xc3, 0xbe, 0x12, 0xf6, 0x07, 0x76, 0xcd, 0x14, 0xf4, 0x82, 0x6e, 0xb7, 0x5b, 0xbf, 0xb8, 0x41,
+	0x41, 0xd7, 0x0b, 0x45, 0x25, 0xc1, 0xbe, 0xb2, 0xd5, 0xfb, 0x09, 0x59, 0x43, 0xbe, 0xc2, 0x49,
+	0xec, 0x92, 0xcb, 0x6a, 0x42, 0xd6, 0x34, 0xdd, 0x64, 0x44, 0x38, 0xdc, 0x29, 0xb7, 0x4c, 0xd7,
+	0x6f, 0xec, 0x8e, 0xba, 0xbb, 0xcb, 0x72, 0x45, 0x2e, 0xa1, 0xa1, 0x38, 0x03, 0xf4, 0x9e, 0x25,
+	0x72, 0x8f, 0x35, 0x4a, 0xca, 0xc3, 0xaa, 0x62, 0x98, 0xe2, 0x3e, 0x7c, 0xce, 0xd3, 0x6a, 0x94,
+	0x75, 0xcd, 0x50, 0xe8, 0x35, 0x18, 0xb2, 0x8d, 0xa3, 0xe4, 0x34, 0x99, 0x1f, 0x5b, 0x8e, 0xc5,
+	0x1b, 0x3b, 0x31, 0x6e, 0xdb, 0xa5, 0x23, 0x6f, 0xde, 0xcf, 0xf6, 0x49, 0x68, 0x23, 0xfe, 0x90,
+	0xc0, 0x0c, 0x43, 0x4d, 0x55, 0x73, 0x8c, 0x3b, 0xce, 0x46, 0x8f, 0xc1, 0x90, 0x61, 0xca, 0x66,
+	0xd5, 0x86, 0x1d, 0x95, 0xf0, 0x8b, 0x52, 0x88, 0x98, 0x8f, 0xcb, 0x4a, 0xb4, 0x9f, 0xb5, 0xb2,
+	0xdf, 0x74, 0x13, 0xa0, 0x16, 0x86, 0xe8, 0x00, 0xa3, 0x31, 0x17, 0x47, 0xd7, 0x5a, 0x71, 0x88,
+	0xdb, 0x41, 0xc6, 0x68, 0xc4, 0xf7, 0xe4, 0x82, 0x82, 0xf3, 0x48, 0x2e, 0x4b, 0xf1, 0xe7, 0x04,
+	0x8e, 0xfa, 0xc8, 0xa0, 0xc8, 0x75, 0x18, 0x91, 0xb1, 0x2d, 0x4a, 0x4e, 0x0f, 0xcc, 0x8f, 0x2d,
+	0xcf, 0xc4, 0x6d, 0xdf, 0xc7, 0x79, 0x58, 0xe2, 0x29, 0xed, 0x71, 0x7a, 0xfc, 0xed, 0xab, 0xc5,
+	0x11, 0xb4, 0xde, 0x96, 0x1c, 0x1b, 0xba, 0xe5, 0x61, 0xd8, 0xcf, 0x18, 0x9e, 0x6d, 0xcb, 0xd0,
+	0x9e, 0xdc, 0x43, 0x71, 0x05, 0x83, 0x80, 0x73, 0x70, 0x6f, 0x9d, 0x02, 0xc0, 0xb9, 0x32, 0x6a,
+	0x9e, 0x79, 0x2c, 0x22, 0x8d, 0x62, 0xcb, 0x76, 0x5e, 0x3c, 0xf0, 0x3a, 0xd9, 0x15, 0xbb, 0x61,
+	0x1c, 0x84, 0xc1, 0x0b, 0xa2, 0x8a, 0x9b, 0x88, 0x12, 0x1c, 0xb7, 0x51, 0x8b, 0x45, 0xfd, 0x5b,
+	0x4a, 0x3e, 0xad, 0xe6, 0xf3, 0x4a, 0x25, 0x18, 0x23, 0x2b, 0xbc, 0x59, 0x36, 0x1e, 0x03, 0x89,
+	0x5f, 0x62, 0x19, 0x84, 0x46, 0x98, 0xc8, 0x57, 0x82, 0x49, 0xd9, 0xee, 0xc8, 0xa0, 0xb5, 0x4d,
+	0xfb, 0x0b, 0xcd, 0x72, 0xce, 0x03, 0x83, 0xa9, 0x37, 0x21, 0xbb, 0x1b, 0xc5, 0xef, 0x91, 0x46,
+	0x53, 0x1a, 0x01, 0x75, 0x6c, 0x36, 0x08, 0x6c, 0x27, 0xa9, 0xf7, 0x9a, 0xc0, 0x89, 0x86, 0x2c,
+	0x50, 0xf9, 0x01, 0x4c, 0x79, 0x95, 0xf3, 0x3c, 0x0c, 0x25, 0x7d, 0xd2, 0x23, 0xbd, 0x87, 0x69,
+	0xf9, 0x3b, 0x02, 0xd3, 0x8c, 0x7e, 0x5a, 0xcd, 0x1b, 0xdd, 0xa5, 0x80, 0x65, 0xa6, 0x1a, 0x99,
+	0x92, 0x6c, 0xe6, 0x1e, 0x28, 0x79, 0xb6, 0x9a, 0x47, 0xa5, 0x51, 0xd5, 0xb8, 0x63, 0x37, 0xf8,
+	0x3c, 0x1e, 0xe9, 0xd8, 0xe3, 0xcf, 0x09, 0x1c, 0x71, 0x51, 0x46, 0x3f, 0xaf, 0x42, 0x24, 0xab,
+	0xe6, 0xb9, 0x73, 0x4f, 0x34, 0x73, 0x6e, 0x5a, 0xcd, 0xa3, 0x4b, 0xd9, 0xf0, 0xde, 0x39, 0x72,
+	0x0b, 0xa6, 0x38, 0xa9, 0x80, 0x6e, 0x3c, 0xca, 0xdc, 0x68, 0x75, 0xf5, 0xb3, 0xae, 0xc1, 0xac,
+	0x9a, 0xdf, 0xce, 0x8b, 0x5b, 0xb5, 0x80, 0x38, 0xe2, 0x92, 0x30, 0x90, 0x45, 0x88, 0x40, 0xda,
+	0xac, 0xd1, 0xe2, 0x2a, 0xee, 0x1d, 0x5f, 0x53, 0x0c, 0x53, 0xd5, 0x0a, 0x01, 0xa3, 0x2b, 0xfe,
+	0xaa, 0x1f, 0x8e, 0xfa, 0xec, 0x90, 0xc5, 0x26, 0x8c, 0x3c, 0xc2, 0x36, 0x74, 0xf3, 0x99, 0x66,
+	0x54, 0xd0, 0xf6, 0x5e, 0x55, 0xa9, 0x2a, 0xc8, 0xc9, 0xb1, 0xa5, 0x3b, 0x30, 0x59, 0x54, 0x35,
+	0x45, 0xae, 0x64, 0xb0, 0x09, 0xfd, 0xde, 0x74, 0x45, 0xec, 0xb0, 0xd1, 0x88, 0x29, 0x4d, 0x14,
+	0xdd, 0x9f, 0xd4, 0x84, 0xa9, 0x5c, 0x51, 0x56, 0x4b, 0x72, 0xb6, 0xa8, 0x64, 0xac, 0xe3, 0xda,
+	0x88, 0x0e, 0x30, 0x72, 0xc7, 0x3d, 0x61, 0xe4, 0x01, 0xdc, 0xd0, 0x55, 0x2d, 0xfd, 0x45, 0x8b,
+	0xd1, 0xaf, 0xff, 0x3b, 0x3b, 0x5f, 0x50, 0xcd, 0x07, 0xd5, 0x6c, 0x3c, 0xa7, 0x97, 0xf0, 0x40,
+	0xc7, 0x3f, 0x8b, 0x46, 0xfe, 0x30, 0x61, 0x1d, 0x51, 0x06, 0x33, 0x30, 0xa4, 0x49, 0x67, 0x0e,
+	0xf6, 0x2d, 0x7e, 0x07, 0xf7, 0x1e, 0x7b, 0x41, 0xfa, 0x5d, 0x5c, 0x5b, 0x21, 0xc4, 0xb3, 0x42,
+	0x7a, 0xb5, 0xe9, 0xbc, 0xe2, 0x9b, 0x8e, 0x7f, 0x7a, 0x8c, 0xd4, 0x4e, 0x5d, 0xa4, 0xce, 0xb7,
+	0x48, 0x9a, 0x1a, 0x42, 0xe3, 0x78, 0xf5, 0x6c, 0x8d, 0x7c, 0x1d, 0x62, 0x8c, 0xf5, 0xbe, 0x5a,
+	0xaa, 0x16, 0x65, 0x53, 0x49, 0x5b, 0x3b, 0x03, 0xdb, 0x1e, 0xba, 0x3c, 0x7c, 0x7e, 0x1a, 0x81,
+	0xd9, 0xa6, 0xc8, 0xe8, 0x93, 0x28, 0x0c, 0xf3, 0xad, 0xc9, 0xc2, 0x1d, 0x91, 0xf8, 0x27, 0xdd,
+	0x87, 0x09, 0xfc, 0x99, 0x29, 0x57, 0xd4, 0x1c, 0x5e, 0x51, 0xd2, 0x71, 0xcb, 0x0d, 0xff, 0x79,
+	0x3f, 0x3b, 0x17, 0x20, 0x49, 0x6e, 0x29, 0x39, 0x69, 0x1c, 0x41, 0xf6, 0x2c, 0x0c, 0xfa, 0x55,
+	0x98, 0xe4, 0xa0, 0x72, 0x49, 0xaf, 0x6a, 0x66, 0x74, 0x20, 0x34, 0xea, 0xb6, 0x66, 0x4a, 0x9c,
+	0x5a, 0x8a, 0x81, 0xd0, 0x05, 0xa0, 0x1c, 0xd6, 0xda, 0xbf, 0x32, 0x39, 0x06, 0x1d, 0x61, 0x8e,
+	0x9a, 0xc6, 0x1e, 0x6b, 0x5f, 0xdc, 0x60, 0xa3, 0xbf, 0x01, 0xd3, 0xd6, 0xc1, 0x91, 0x93, 0xcd,
+	0x1a, 0x8d, 0xc1, 0x8e, 0x68, 0x4c, 0x39, 0x38, 0x48, 0x64, 0x1f, 0x26, 0x2a, 0x8a, 0x95, 0x48,
+	0x1c, 0x77, 0xa8, 0x23, 0xdc, 0x71, 0x1b, 0x04, 0x41, 0x77, 0x61, 0xac, 0x2c, 0xab, 0x0e, 0xe4,
+	0x70, 0x47, 0x90, 0x60, 0x41, 0xd8, 0x80, 0xe2, 0x2f, 0x09, 0x9c, 0x74, 0x5f, 0xa0, 0x76, 0x2b,
+	0xd6, 0x99, 0xaa, 0xeb, 0x87, 0x01, 0x13, 0xee, 0x04, 0x8c, 0x9a, 0x6a, 0xee, 0x30, 0x63, 0xa8,
+	0x4f, 0xf8, 0xcd, 0x75, 0xc4, 0x6a, 0xd8, 0x57, 0x9f, 0xf4, 0xee, 0xf6, 0xfa, 0x27, 0x02, 0xa7,
+	0x9a, 0x90, 0x74, 0x2e, 0x11, 0xe3, 0x2c, 0x33, 0x33, 0x45, 0xe5, 0x91, 0x52, 0xe4, 0x6b, 0xfa,
+	0x42, 0xb3, 0x35, 0xed, 0x00, 0xb0, 0x54, 0xdc, 0xb1, 0x6c, 0x70, 0x51, 0x8f, 0x95, 0x9d, 0x96,
+	0x1e, 0xae, 0xeb, 0x75, 0x2f, 0xff, 0x7d, 0xc5, 0x34, 0x8b, 0x4a, 0x49, 0xd1, 0xcc, 0x80, 0x47,
+	0xce, 0x4b, 0x02, 0xb1, 0x66, 0x00, 0xe8, 0x81, 0x5d, 0x00, 0xc3, 0x69, 0xc5, 0x83, 0xf0, 0x5c,
+	0xd3, 0x1b, 0x94, 0x1f, 0x06, 0xd5, 0xbb, 0x20, 0xe8, 0x4d, 0x18, 0xca, 0x55, 0x2b, 0x86, 0x5e,
+	0x41, 0xe1, 0xf3, 0xcd, 0xc0, 0x6a, 0x28, 0x1b, 0x6c, 0xbc, 0x84, 0x76, 0xe2, 0x0f, 0x78, 0xd8,
+	0xec, 0x2d, 0xb4, 0x36, 0xee, 0x53, 0x5f, 0x41, 0xff, 0xc8, 0xdd, 0xd7, 0x80, 0x08, 0xba, 0x6f,
+	0x0f, 0xc6, 0x6a, 0xda, 0x79, 0xfe, 0xcc, 0xb7, 0x3e, 0x13, 0xea, 0xdc, 0xe7, 0x86, 0xe8, 0x5d,
+	0xf2, 0x5c, 0xe5, 0xb7, 0x78, 0xdb, 0x2f, 0x9b, 0xb2, 0x5a, 0xac, 0x56, 0x94, 0x80, 0x99, 0xa3,
+	0xf0, 0xcb, 0xb7, 0xcf, 0xd8, 0xb9, 0xb1, 0x0c, 0xdf, 0xb7, 0x9b, 0x30, 0x65, 0xe6, 0xda, 0xa4,
+	0x0c, 0x02, 0xa0, 0x60, 0x6e, 0x2c, 0x7e, 0xdf, 0xd9, 0x46, 0xec, 0x5d, 0x50, 0xd5, 0xb5, 0x0d,
+	0xeb, 0x3e, 0xf0, 0xa9, 0x23, 0xfd, 0x5b, 0x67, 0xa7, 0xa8, 0xe3, 0x81, 0x8a, 0x6f, 0xc3, 0x10,
+	0xbb, 0xa9, 0xf0, 0x18, 0x9f, 0x6d, 0x55, 0x65, 0xb8, 0x10, 0x78, 0x75, 0x6f, 0x1b, 0xf7, 0x2e,
+	0xba, 0x07, 0xae, 0xea, 0xc8, 0x35, 0x5d, 0x97, 0xe7, 0x7d, 0xae, 0x71, 0x38, 0x1c, 0x2f, 0x6c,
+	0xc0, 0x20, 0x13, 0x82, 0x51, 0x0f, 0xe9, 0x04, 0xdb, 0x56, 0x7c, 0x41, 0x6a, 0x77, 0xbc, 0x0d,
+	0xbd, 0x54, 0x52, 0xcd, 0x30, 0x8b, 0xbb, 0x59, 0x91, 0xd4, 0xab, 0x43, 0xe3, 0xf7, 0xae, 0x2b,
+	0xa0, 0x87, 0x1d, 0xba, 0xe0, 0x0e, 0x8c, 0xe5, 0x6a, 0xcd, 0xed, 0x6a, 0x4e, 0x0f, 0x08, 0x5f,
+	0xee, 0x2e, 0xfb, 0xde, 0x27, 0x84, 0xbd, 0xc7, 0xa4, 0x0a, 0x85, 0x8a, 0x52, 0x90, 0x4d, 0xa5,
+	0xcb, 0x84, 0x38, 0x84, 0x93, 0x8d, 0x51, 0xd1, 0x1b, 0x5f, 0x81, 0x51, 0x99, 0x37, 0xb6, 0x4b,
+	0x0a, 0x1f, 0x06, 0x7a, 0xa3, 0x66, 0xbf, 0xfc, 0x52, 0x80, 0x41, 0x36, 0x1b, 0xfd, 0x11, 0x81,
+	0x21, 0xfb, 0x75, 0x8c, 0x36, 0xbd, 0x60, 0xd7, 0x3f, 0xc8, 0x09, 0x17, 0x02, 0x8d, 0xb5, 0xa9,
+	0x8b, 0xe7, 0xbf, 0xfb, 0xcf, 0xff, 0x3f, 0xef, 0x3f, 0x43, 0x45, 0x7e, 0xe9, 0x71, 0x19, 0xb8,
+	0x1e, 0x33, 0x19, 0x89, 0x9f, 0x10, 0xe0, 0xcf, 0x3d, 0x06, 0x5d, 0x68, 0x39, 0x8b, 0xef, 0xd9,
+	0x4e, 0x58, 0x0c, 0x38, 0x1a, 0x59, 0x2d, 0x30, 0x56, 0x73, 0xf4, 0x4c, 0x2b, 0x56, 0xce, 0x2b,
+	0xda, 0xcf, 0x08, 0x0c, 0x23, 0x04, 0xbd, 0x10, 0x64, 0x22, 0xce, 0x6a, 0x21, 0xd8, 0x60, 0x24,
+	0x75, 0x99, 0x91, 0x4a, 0xd2, 0xa5, 0x20, 0xa4, 0x12, 0x4f, 0x6b, 0x89, 0xf6, 0x8c, 0xbe, 0x25,
+	0x30, 0xe1, 0x79, 0x78, 0xa1, 0x4b, 0xad, 0xa7, 0x6e, 0xf0, 0x74, 0x26, 0x2c, 0x87, 0x31, 0x41,
+	0xce, 0x12, 0xe3, 0xbc, 0x43, 0xbf, 0x1c, 0x9a, 0x73, 0xc2, 0xf7, 0xae, 0x94, 0x78, 0x6a, 0xff,
+	0x78, 0x46, 0xff, 0x4a, 0x60, 0x32, 0xe5, 0x7d, 0x30, 0x0a, 0x41, 0xcd, 0x49, 0x89, 0x64, 0x28,
+	0x1b, 0xd4, 0xb3, 0xcd, 0xf4, 0x6c, 0xd0, 0x54, 0xd7, 0x7a, 0xe8, 0x0b, 0x02, 0x11, 0xab, 0x96,
+	0xa1, 0xf3, 0x2d, 0x89, 0xb8, 0x5e, 0xae, 0x84, 0x73, 0x01, 0x46, 0x22, 0xd1, 0x75, 0x46, 0xf4,
+	0x12, 0x5d, 0x0b, 0x4f, 0x94, 0xbd, 0x1c, 0xfd, 0x82, 0xc0, 0x40, 0x5a, 0xcd, 0xd3, 0xb3, 0xed,
+	0xa6, 0xe4, 0xdc, 0xe6, 0xdb, 0x0f, 0x44, 0x6a, 0x5b, 0x8c, 0x5a, 0x8a, 0xde, 0xe8, 0x8c, 0x1a,
+	0x4b, 0x04, 0xeb, 0x8b, 0xfe, 0x8b, 0x00, 0xad, 0x2f, 0x89, 0xe9, 0x5a, 0x4b, 0x26, 0x4d, 0xab,
+	0x73, 0xe1, 0x62, 0x68, 0x3b, 0x14, 0x74, 0x97, 0x09, 0xfa, 0x12, 0xdd, 0x0c, 0x2f, 0xc8, 0x40,
+	0xd4, 0x4c, 0xd6, 0x42, 0xb4, 0x5f, 0x17, 0xe9, 0xdf, 0x08, 0x4c, 0xfb, 0x8b, 0x25, 0xba, 0x12,
+	0x64, 0xaf, 0xf0, 0x17, 0x80, 0xc2, 0x6a, 0x48, 0x2b, 0x54, 0x74, 0x8b, 0x29, 0x5a, 0xa7, 0xd7,
+	0xc2, 0x2b, 0xd2, 0x2d, 0xb0, 0x4c, 0xd6, 0xa2, 0xfc, 0x86, 0xc0, 0x91, 0xba, 0x62, 0x85, 0x06,
+	0xa2, 0x54, 0x57, 0x64, 0x09, 0x6b, 0x61, 0xcd, 0xba, 0x97, 0xe2, 0xaa, 0xa7, 0xde, 0x11, 0x38,
+	0x52, 0x57, 0x7f, 0xb4, 0x91, 0xd2, 0xac, 0x70, 0x12, 0xd6, 0xc2, 0x9a, 0xa1, 0x94, 0x1d, 0x26,
+	0x65, 0x93, 0xde, 0xea, 0x46, 0x4a, 0x82, 0xef, 0x3f, 0xaf, 0xad, 0x6d, 0xd4, 0x53, 0x17, 0xb4,
+	0xdb, 0x46, 0x1b, 0x95, 0x30, 0x42, 0x32, 0x94, 0x0d, 0x2a, 0x49, 0x31, 0x25, 0x57, 0xe9, 0xe5,
+	0xf0, 0x4a, 0xb0, 0x68, 0xa1, 0xbf, 0x21, 0x30, 0xc2, 0x5f, 0x06, 0xdb, 0x5c, 0x06, 0x7c, 0xef,
+	0x97, 0xc2, 0x62, 0xc0, 0xd1, 0x48, 0x36, 0xcd, 0xc8, 0x5e, 0xa3, 0x57, 0xc2, 0x93, 0x75, 0x1e,
+	0x19, 0xff, 0x40, 0x60, 0xd2, 0xfb, 0x9a, 0xd9, 0xc6, 0xd9, 0x0d, 0x5f, 0x5e, 0x85, 0x64, 0x28,
+	0x1b, 0xe4, 0x7f, 0x9d, 0xf1, 0xbf, 0x48, 0x57, 0x5b, 0xf1, 0xf7, 0x9f, 0xb2, 0x35, 0xea, 0x7f,
+	0xb1, 0x76, 0x23, 0x5f, 0x41, 0xd6, 0x6e, 0x37, 0x6a, 0x5c, 0x47, 0x0a, 0xab, 0x21, 0xad, 0x50,
+	0xc0, 0x4d, 0x26, 0xe0, 0x0a, 0xbd, 0x14, 0x3e, 0x00, 0x58, 0xf0, 0xfd, 0x9d, 0xc0, 0x94, 0x0f,
+	0x9e, 0x26, 0xc3, 0x90, 0xe1, 0x0a, 0x56, 0xc2, 0x19, 0x75, 0x7f, 0x6b, 0xb0, 0x05, 0xd4, 0x2e,
+	0x3f, 0x7f, 0xb6, 0x13, 0xc9, 0x55, 0x13, 0xb5, 0x4f, 0xa4, 0xfa, 0xf2, 0x4e, 0x48, 0x86, 0xb2,
+	0x41, 0x19, 0xb7, 0x99, 0x8c, 0x1b, 0xf4, 0x7a, 0x07, 0x32, 0x5c, 0x7c, 0xff, 0x41, 0x60, 0xca,
+	0x57, 0x85, 0xd0, 0x20, 0x89, 0xed, 0xaf, 0xa6, 0x84, 0x95, 0x70, 0x46, 0xa8, 0xe2, 0x80, 0xa9,
+	0xb8, 0x4b, 0x77, 0x3a, 0xba, 0x7e, 0x78, 0x17, 0x89, 0x53, 0x35, 0xa5, 0x77, 0xdf, 0x7c, 0x88,
+	0x91, 0x77, 0x1f, 0x62, 0xe4, 0x7f, 0x1f, 0x62, 0xe4, 0xc7, 0x1f, 0x63, 0x7d, 0xef, 0x3e, 0xc6,
+	0xfa, 0xfe, 0xfd, 0x31, 0xd6, 0xf7, 0xcd, 0x55, 0xd7, 0xc3, 0x6e, 0x8d, 0xaf, 0x67, 0xd6, 0x6f,
+	0x7b, 0xbe, 0xd8, 0x5b, 0x6f, 0x76, 0x88, 0xfd, 0xab, 0x3b, 0xf9, 0xd9, 0x00, 0xf6, 0x2c, 0x54,
+	0x0f, 0x15, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the fundraising module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Auctions returns all auctions.
+	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	// Auction returns the specific auction.
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// AllowedBidder returns the specific allowed bidder information.
+	AllowedBidder(ctx context.Context, in *QueryAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllowedBidderResponse, error)
+	// AllowedBidders returns all allowed bidders for the auction.
+	AllowedBidders(ctx context.Context, in *QueryAllowedBiddersRequest, opts ...grpc.CallOption) (*QueryAllowedBiddersResponse, error)
+	// Bids returns all bids.
+	Bids(ctx context.Context, in *QueryBidsRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error)
+	// Bid returns the specific bid from the auction id and bid id.
+	Bid(ctx context.Context, in *QueryBidRequest, opts ...grpc.CallOption) (*QueryBidResponse, error)
+	// SimulateBatchMatch returns the match result that the batch auction would have
+	// if it ended with the current bids.
+	SimulateBatchMatch(ctx context.Context, in *QuerySimulateBatchMatchRequest, opts ...grpc.CallOption) (*QuerySimulateBatchMatchResponse, error)
+	// AuctionOrderBook returns the bids of the batch auction aggregated into
+	// price levels.
+	AuctionOrderBook(ctx context.Context, in *QueryAuctionOrderBookRequest, opts ...grpc.CallOption) (*QueryAuctionOrderBookResponse, error)
+	// AuctionSettlement returns the settlement record of the closed auction.
+	AuctionSettlement(ctx context.Context, in *QueryAuctionSettlementRequest, opts ...grpc.CallOption) (*QueryAuctionSettlementResponse, error)
+	// BidderSettlements returns the settlement records of all bidders for the
+	// closed auction.
+	BidderSettlements(ctx context.Context, in *QueryBidderSettlementsRequest, opts ...grpc.CallOption) (*QueryBidderSettlementsResponse, error)
+	// AuctionFailure returns the failure record of the failed auction.
+	AuctionFailure(ctx context.Context, in *QueryAuctionFailureRequest, opts ...grpc.CallOption) (*QueryAuctionFailureResponse, error)
+	// Vestings returns all vestings for the auction.
+	Vestings(ctx context.Context, in *QueryVestingsRequest, opts ...grpc.CallOption) (*QueryVestingsResponse, error)
+	// BidderVestings returns the pending vesting releases of the bidder across
+	// all auctions.
+	BidderVestings(ctx context.Context, in *QueryBidderVestingsRequest, opts ...grpc.CallOption) (*QueryBidderVestingsResponse, error)
+	// AllocationClaims returns the allocation claims of the auction in claim
+	// mode that are not claimed yet.
+	AllocationClaims(ctx context.Context, in *QueryAllocationClaimsRequest, opts ...grpc.CallOption) (*QueryAllocationClaimsResponse, error)
+	// AllocationClaim returns the allocation claim of the bidder for the auction
+	// in claim mode.
+	AllocationClaim(ctx context.Context, in *QueryAllocationClaimRequest, opts ...grpc.CallOption) (*QueryAllocationClaimResponse, error)
+	// BidCommitments returns the sealed bids of the auction in sealed bid mode
+	// that are not revealed yet.
+	BidCommitments(ctx context.Context, in *QueryBidCommitmentsRequest, opts ...grpc.CallOption) (*QueryBidCommitmentsResponse, error)
+	// BidderAggregate returns the running totals of the bids that the bidder
+	// placed for the auction.
+	BidderAggregate(ctx context.Context, in *QueryBidderAggregateRequest, opts ...grpc.CallOption) (*QueryBidderAggregateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	return out, nil
+}
+
+func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
+	out := new(QueryAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Auctions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.BidId != 0 {
-		n += 1 + sovQuery(uint64(m.BidId))
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AllowedBidder(ctx context.Context, in *QueryAllowedBidderRequest, opts ...grpc.CallOption) (*QueryAllowedBidderResponse, error) {
+	out := new(QueryAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Bid.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryVestingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AllowedBidders(ctx context.Context, in *QueryAllowedBiddersRequest, opts ...grpc.CallOption) (*QueryAllowedBiddersResponse, error) {
+	out := new(QueryAllowedBiddersResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AllowedBidders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+	return out, nil
+}
+
+func (c *queryClient) Bids(ctx context.Context, in *QueryBidsRequest, opts ...grpc.CallOption) (*QueryBidsResponse, error) {
+	out := new(QueryBidsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Bids", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVestingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Bid(ctx context.Context, in *QueryBidRequest, opts ...grpc.CallOption) (*QueryBidResponse, error) {
+	out := new(QueryBidResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Bid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Vestings) > 0 {
-		for _, e := range m.Vestings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SimulateBatchMatch(ctx context.Context, in *QuerySimulateBatchMatchRequest, opts ...grpc.CallOption) (*QuerySimulateBatchMatchResponse, error) {
+	out := new(QuerySimulateBatchMatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/SimulateBatchMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionOrderBook(ctx context.Context, in *QueryAuctionOrderBookRequest, opts ...grpc.CallOption) (*QueryAuctionOrderBookResponse, error) {
+	out := new(QueryAuctionOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AuctionOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionSettlement(ctx context.Context, in *QueryAuctionSettlementRequest, opts ...grpc.CallOption) (*QueryAuctionSettlementResponse, error) {
+	out := new(QueryAuctionSettlementResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AuctionSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderSettlements(ctx context.Context, in *QueryBidderSettlementsRequest, opts ...grpc.CallOption) (*QueryBidderSettlementsResponse, error) {
+	out := new(QueryBidderSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/BidderSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionFailure(ctx context.Context, in *QueryAuctionFailureRequest, opts ...grpc.CallOption) (*QueryAuctionFailureResponse, error) {
+	out := new(QueryAuctionFailureResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AuctionFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vestings(ctx context.Context, in *QueryVestingsRequest, opts ...grpc.CallOption) (*QueryVestingsResponse, error) {
+	out := new(QueryVestingsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/Vestings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderVestings(ctx context.Context, in *QueryBidderVestingsRequest, opts ...grpc.CallOption) (*QueryBidderVestingsResponse, error) {
+	out := new(QueryBidderVestingsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/BidderVestings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllocationClaims(ctx context.Context, in *QueryAllocationClaimsRequest, opts ...grpc.CallOption) (*QueryAllocationClaimsResponse, error) {
+	out := new(QueryAllocationClaimsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AllocationClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllocationClaim(ctx context.Context, in *QueryAllocationClaimRequest, opts ...grpc.CallOption) (*QueryAllocationClaimResponse, error) {
+	out := new(QueryAllocationClaimResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/AllocationClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidCommitments(ctx context.Context, in *QueryBidCommitmentsRequest, opts ...grpc.CallOption) (*QueryBidCommitmentsResponse, error) {
+	out := new(QueryBidCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/BidCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderAggregate(ctx context.Context, in *QueryBidderAggregateRequest, opts ...grpc.CallOption) (*QueryBidderAggregateResponse, error) {
+	out := new(QueryBidderAggregateResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Query/BidderAggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the fundraising module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Auctions returns all auctions.
+	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// Auction returns the specific auction.
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// AllowedBidder returns the specific allowed bidder information.
+	AllowedBidder(context.Context, *QueryAllowedBidderRequest) (*QueryAllowedBidderResponse, error)
+	// AllowedBidders returns all allowed bidders for the auction.
+	AllowedBidders(context.Context, *QueryAllowedBiddersRequest) (*QueryAllowedBiddersResponse, error)
+	// Bids returns all bids.
+	Bids(context.Context, *QueryBidsRequest) (*QueryBidsResponse, error)
+	// Bid returns the specific bid from the auction id and bid id.
+	Bid(context.Context, *QueryBidRequest) (*QueryBidResponse, error)
+	// SimulateBatchMatch returns the match result that the batch auction would have
+	// if it ended with the current bids.
+	SimulateBatchMatch(context.Context, *QuerySimulateBatchMatchRequest) (*QuerySimulateBatchMatchResponse, error)
+	// AuctionOrderBook returns the bids of the batch auction aggregated into
+	// price levels.
+	AuctionOrderBook(context.Context, *QueryAuctionOrderBookRequest) (*QueryAuctionOrderBookResponse, error)
+	// AuctionSettlement returns the settlement record of the closed auction.
+	AuctionSettlement(context.Context, *QueryAuctionSettlementRequest) (*QueryAuctionSettlementResponse, error)
+	// BidderSettlements returns the settlement records of all bidders for the
+	// closed auction.
+	BidderSettlements(context.Context, *QueryBidderSettlementsRequest) (*QueryBidderSettlementsResponse, error)
+	// AuctionFailure returns the failure record of the failed auction.
+	AuctionFailure(context.Context, *QueryAuctionFailureRequest) (*QueryAuctionFailureResponse, error)
+	// Vestings returns all vestings for the auction.
+	Vestings(context.Context, *QueryVestingsRequest) (*QueryVestingsResponse, error)
+	// BidderVestings returns the pending vesting releases of the bidder across
+	// all auctions.
+	BidderVestings(context.Context, *QueryBidderVestingsRequest) (*QueryBidderVestingsResponse, error)
+	// AllocationClaims returns the allocation claims of the auction in claim
+	// mode that are not claimed yet.
+	AllocationClaims(context.Context, *QueryAllocationClaimsRequest) (*QueryAllocationClaimsResponse, error)
+	// AllocationClaim returns the allocation claim of the bidder for the auction
+	// in claim mode.
+	AllocationClaim(context.Context, *QueryAllocationClaimRequest) (*QueryAllocationClaimResponse, error)
+	// BidCommitments returns the sealed bids of the auction in sealed bid mode
+	// that are not revealed yet.
+	BidCommitments(context.Context, *QueryBidCommitmentsRequest) (*QueryBidCommitmentsResponse, error)
+	// BidderAggregate returns the running totals of the bids that the bidder
+	// placed for the auction.
+	BidderAggregate(context.Context, *QueryBidderAggregateRequest) (*QueryBidderAggregateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
+}
+func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (*UnimplementedQueryServer) AllowedBidder(ctx context.Context, req *QueryAllowedBidderRequest) (*QueryAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedBidder not implemented")
+}
+func (*UnimplementedQueryServer) AllowedBidders(ctx context.Context, req *QueryAllowedBiddersRequest) (*QueryAllowedBiddersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedBidders not implemented")
+}
+func (*UnimplementedQueryServer) Bids(ctx context.Context, req *QueryBidsRequest) (*QueryBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bids not implemented")
+}
+func (*UnimplementedQueryServer) Bid(ctx context.Context, req *QueryBidRequest) (*QueryBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+}
+func (*UnimplementedQueryServer) SimulateBatchMatch(ctx context.Context, req *QuerySimulateBatchMatchRequest) (*QuerySimulateBatchMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBatchMatch not implemented")
+}
+func (*UnimplementedQueryServer) AuctionOrderBook(ctx context.Context, req *QueryAuctionOrderBookRequest) (*QueryAuctionOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionOrderBook not implemented")
+}
+func (*UnimplementedQueryServer) AuctionSettlement(ctx context.Context, req *QueryAuctionSettlementRequest) (*QueryAuctionSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionSettlement not implemented")
+}
+func (*UnimplementedQueryServer) BidderSettlements(ctx context.Context, req *QueryBidderSettlementsRequest) (*QueryBidderSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderSettlements not implemented")
+}
+func (*UnimplementedQueryServer) AuctionFailure(ctx context.Context, req *QueryAuctionFailureRequest) (*QueryAuctionFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionFailure not implemented")
+}
+func (*UnimplementedQueryServer) Vestings(ctx context.Context, req *QueryVestingsRequest) (*QueryVestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vestings not implemented")
+}
+func (*UnimplementedQueryServer) BidderVestings(ctx context.Context, req *QueryBidderVestingsRequest) (*QueryBidderVestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderVestings not implemented")
+}
+func (*UnimplementedQueryServer) AllocationClaims(ctx context.Context, req *QueryAllocationClaimsRequest) (*QueryAllocationClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocationClaims not implemented")
+}
+func (*UnimplementedQueryServer) AllocationClaim(ctx context.Context, req *QueryAllocationClaimRequest) (*QueryAllocationClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocationClaim not implemented")
+}
+func (*UnimplementedQueryServer) BidCommitments(ctx context.Context, req *QueryBidCommitmentsRequest) (*QueryBidCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidCommitments not implemented")
+}
+func (*UnimplementedQueryServer) BidderAggregate(ctx context.Context, req *QueryBidderAggregateRequest) (*QueryBidderAggregateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAggregate not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/Auctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedBidderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedBidder(ctx, req.(*QueryAllowedBidderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedBidders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedBiddersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedBidders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AllowedBidders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedBidders(ctx, req.(*QueryAllowedBiddersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/Bids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bids(ctx, req.(*QueryBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/Bid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bid(ctx, req.(*QueryBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateBatchMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBatchMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBatchMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/SimulateBatchMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBatchMatch(ctx, req.(*QuerySimulateBatchMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AuctionOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionOrderBook(ctx, req.(*QueryAuctionOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AuctionSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionSettlement(ctx, req.(*QueryAuctionSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/BidderSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderSettlements(ctx, req.(*QueryBidderSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AuctionFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionFailure(ctx, req.(*QueryAuctionFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/Vestings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vestings(ctx, req.(*QueryVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderVestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderVestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderVestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/BidderVestings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderVestings(ctx, req.(*QueryBidderVestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllocationClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllocationClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AllocationClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllocationClaims(ctx, req.(*QueryAllocationClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllocationClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllocationClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/AllocationClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllocationClaim(ctx, req.(*QueryAllocationClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/BidCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidCommitments(ctx, req.(*QueryBidCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderAggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Query/BidderAggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAggregate(ctx, req.(*QueryBidderAggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.fundraising.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Auctions",
+			Handler:    _Query_Auctions_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "AllowedBidder",
+			Handler:    _Query_AllowedBidder_Handler,
+		},
+		{
+			MethodName: "AllowedBidders",
+			Handler:    _Query_AllowedBidders_Handler,
+		},
+		{
+			MethodName: "Bids",
+			Handler:    _Query_Bids_Handler,
+		},
+		{
+			MethodName: "Bid",
+			Handler:    _Query_Bid_Handler,
+		},
+		{
+			MethodName: "SimulateBatchMatch",
+			Handler:    _Query_SimulateBatchMatch_Handler,
+		},
+		{
+			MethodName: "AuctionOrderBook",
+			Handler:    _Query_AuctionOrderBook_Handler,
+		},
+		{
+			MethodName: "AuctionSettlement",
+			Handler:    _Query_AuctionSettlement_Handler,
+		},
+		{
+			MethodName: "BidderSettlements",
+			Handler:    _Query_BidderSettlements_Handler,
+		},
+		{
+			MethodName: "AuctionFailure",
+			Handler:    _Query_AuctionFailure_Handler,
+		},
+		{
+			MethodName: "Vestings",
+			Handler:    _Query_Vestings_Handler,
+		},
+		{
+			MethodName: "BidderVestings",
+			Handler:    _Query_BidderVestings_Handler,
+		},
+		{
+			MethodName: "AllocationClaims",
+			Handler:    _Query_AllocationClaims_Handler,
+		},
+		{
+			MethodName: "AllocationClaim",
+			Handler:    _Query_AllocationClaim_Handler,
+		},
+		{
+			MethodName: "BidCommitments",
+			Handler:    _Query_BidCommitments_Handler,
+		},
+		{
+			MethodName: "BidderAggregate",
+			Handler:    _Query_BidderAggregate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fundraising/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedBidderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedBidderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedBidderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedBiddersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedBiddersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedBiddersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedBiddersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedBiddersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedBiddersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedBidders) > 0 {
+		for iNdEx := len(m.AllowedBidders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedBidders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IsMatched) > 0 {
+		i -= len(m.IsMatched)
+		copy(dAtA[i:], m.IsMatched)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsMatched)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimableCoins) > 0 {
+		for iNdEx := len(m.ClaimableCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LinearVesting != nil {
+		{
+			size, err := m.LinearVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vestings) > 0 {
+		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderVestingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderVestingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderVestingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderVestingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderVestingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderVestingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vestings) > 0 {
+		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBatchMatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBatchMatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBatchMatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBatchMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBatchMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBatchMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PaidAmount.Size()
+		i -= size
+		if _, err := m.PaidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RefundAmount.Size()
+		i -= size
+		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AllocatedAmount.Size()
+		i -= size
+		if _, err := m.AllocatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MatchedBidsCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchedBidsCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MatchedAmount.Size()
+		i -= size
+		if _, err := m.MatchedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MatchedPrice.Size()
+		i -= size
+		if _, err := m.MatchedPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TickSize) > 0 {
+		i -= len(m.TickSize)
+		copy(dAtA[i:], m.TickSize)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TickSize)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionOrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceLevels) > 0 {
+		for iNdEx := len(m.PriceLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cursor != nil {
+		{
+			size, err := m.Cursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderSettlementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderSettlementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionFailureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionFailureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionFailureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAggregateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAggregateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAggregateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAggregateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAggregateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAggregateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Aggregate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedBidderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllowedBidder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedBiddersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedBiddersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedBidders) > 0 {
+		for _, e := range m.AllowedBidders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IsMatched)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovQuery(uint64(m.BidId))
+	}
+	return n
+}
+
+func (m *QueryBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryVestingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vestings) > 0 {
+		for _, e := range m.Vestings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LinearVesting != nil {
+		l = m.LinearVesting.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ClaimableCoins) > 0 {
+		for _, e := range m.ClaimableCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBidderVestingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderVestingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vestings) > 0 {
+		for _, e := range m.Vestings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateBatchMatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateBatchMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Matched {
+		n += 2
+	}
+	l = m.MatchedPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MatchedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MatchedBidsCount != 0 {
+		n += 1 + sovQuery(uint64(m.MatchedBidsCount))
+	}
+	l = m.AllocatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PaidAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.TickSize)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceLevels) > 0 {
+		for _, e := range m.PriceLevels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryAuctionSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settlement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionFailureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryAuctionFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Failure.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllocationClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Claim.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBidCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAggregateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAggregateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Aggregate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &types.Any{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedBidderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedBidderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedBidderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedBidderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowedBidder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedBiddersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedBiddersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedBiddersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedBiddersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedBiddersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedBiddersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBidders = append(m.AllowedBidders, AllowedBidder{})
+			if err := m.AllowedBidders[len(m.AllowedBidders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsMatched = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			}
+			m.BidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vestings = append(m.Vestings, VestingQueue{})
+			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVesting == nil {
+				m.LinearVesting = &LinearVesting{}
+			}
+			if err := m.LinearVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableCoins = append(m.ClaimableCoins, types1.Coin{})
+			if err := m.ClaimableCoins[len(m.ClaimableCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderVestingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderVestingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderVestingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderVestingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderVestingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderVestingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vestings = append(m.Vestings, BidderVestingQueue{})
+			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBatchMatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBatchMatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBatchMatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateBatchMatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2168,12 +6957,221 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateBatchMatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateBatchMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedBidsCount", wireType)
+			}
+			m.MatchedBidsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchedBidsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2195,7 +7193,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionOrderBookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,15 +7216,66 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionOrderBookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2253,7 +7302,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2278,7 +7330,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionOrderBookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,17 +7353,17 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionOrderBookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLevels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2321,29 +7373,31 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.PriceLevels = append(m.PriceLevels, OrderBookPriceLevel{})
+			if err := m.PriceLevels[len(m.PriceLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2353,29 +7407,83 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionSettlementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionSettlementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2385,28 +7493,11 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2428,7 +7519,7 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionSettlementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2451,15 +7542,15 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionSettlementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2486,14 +7577,13 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, &types.Any{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2520,10 +7610,10 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Cursor == nil {
+				m.Cursor = &SettlementCursor{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2548,7 +7638,7 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidderSettlementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2571,10 +7661,10 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidderSettlementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidderSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2596,6 +7686,42 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2617,7 +7743,7 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidderSettlementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2640,15 +7766,15 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidderSettlementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidderSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2675,10 +7801,44 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auction == nil {
-				m.Auction = &types.Any{}
+			m.Settlements = append(m.Settlements, BidderSettlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2703,7 +7863,7 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedBidderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionFailureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,10 +7886,10 @@ func (m *QueryAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedBidderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionFailureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedBidderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2751,38 +7911,6 @@ func (m *QueryAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2804,7 +7932,7 @@ func (m *QueryAllowedBidderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedBidderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionFailureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,15 +7955,15 @@ func (m *QueryAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedBidderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionFailureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2862,7 +7990,7 @@ func (m *QueryAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllowedBidder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2887,7 +8015,7 @@ func (m *QueryAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedBiddersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationClaimsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2910,10 +8038,10 @@ func (m *QueryAllowedBiddersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedBiddersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationClaimsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedBiddersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2992,7 +8120,7 @@ func (m *QueryAllowedBiddersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedBiddersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationClaimsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,15 +8143,15 @@ func (m *QueryAllowedBiddersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedBiddersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationClaimsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedBiddersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3050,8 +8178,8 @@ func (m *QueryAllowedBiddersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedBidders = append(m.AllowedBidders, AllowedBidder{})
-			if err := m.AllowedBidders[len(m.AllowedBidders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claims = append(m.Claims, AllocationClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3112,7 +8240,7 @@ func (m *QueryAllowedBiddersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationClaimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3135,10 +8263,10 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationClaimRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationClaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3192,41 +8320,59 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMatched", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllocationClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsMatched = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllocationClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllocationClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3253,10 +8399,7 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3281,7 +8424,7 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3304,17 +8447,36 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3324,27 +8486,25 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bids = append(m.Bids, Bid{})
-			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3374,7 +8534,7 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3401,7 +8561,7 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3424,36 +8584,17 @@ func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
-			m.BidId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3463,64 +8604,29 @@ func (m *QueryBidRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BidId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Commitments = append(m.Commitments, BidCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3547,7 +8653,10 @@ func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3572,7 +8681,7 @@ func (m *QueryBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVestingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidderAggregateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3595,10 +8704,10 @@ func (m *QueryVestingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVestingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidderAggregateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVestingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidderAggregateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3620,6 +8729,38 @@ func (m *QueryVestingsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3641,7 +8782,7 @@ func (m *QueryVestingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVestingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidderAggregateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3664,15 +8805,15 @@ func (m *QueryVestingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVestingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidderAggregateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVestingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidderAggregateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3699,8 +8840,7 @@ func (m *QueryVestingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vestings = append(m.Vestings, VestingQueue{})
-			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
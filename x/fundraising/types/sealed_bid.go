@@ -0,0 +1,110 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BidCommitmentLength is the length of the commitment, which is the SHA-256 hash of the sealed bid.
+const BidCommitmentLength = sha256.Size
+
+// ValidateSealedBidConfig validates the sealed bid configuration of the batch auction.
+// The sealed bid auction cannot have extended rounds since the reveal window is fixed before the end time,
+// and the bidding window before the reveal window must not be empty.
+func ValidateSealedBidConfig(config *SealedBidConfig, maxExtendedRound uint32, startTime, endTime time.Time) error {
+	if config == nil {
+		return nil
+	}
+	if config.RevealPeriod <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reveal period must be positive: %s", config.RevealPeriod)
+	}
+	if config.UnrevealedPenaltyRate.IsNil() || config.UnrevealedPenaltyRate.IsNegative() || config.UnrevealedPenaltyRate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unrevealed penalty rate must be between 0 and 1: %s", config.UnrevealedPenaltyRate)
+	}
+	if maxExtendedRound != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sealed bid auction cannot have extended rounds")
+	}
+	if endTime.Sub(startTime) <= config.RevealPeriod {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reveal period %s must be shorter than the auction period", config.RevealPeriod)
+	}
+	return nil
+}
+
+// Validate checks for errors on the batch auction fields.
+func (ba BatchAuction) Validate() error {
+	if err := ba.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	if err := ValidatePartialFillMode(ba.PartialFillMode); err != nil {
+		return err
+	}
+	if err := ValidatePricingRule(ba.PricingRule); err != nil {
+		return err
+	}
+	return ValidateSealedBidConfig(ba.SealedBidConfig, ba.MaxExtendedRound, ba.StartTime, ba.EndTimes[len(ba.EndTimes)-1])
+}
+
+// IsSealed returns true if the batch auction takes commitments of the sealed bids.
+func (ba BatchAuction) IsSealed() bool {
+	return ba.SealedBidConfig != nil
+}
+
+// GetRevealStartTime returns the time when the reveal window of the sealed bid auction starts.
+// Commitments are taken before the time and revealed from the time until the end time.
+func (ba BatchAuction) GetRevealStartTime() time.Time {
+	return ba.EndTimes[len(ba.EndTimes)-1].Add(-ba.SealedBidConfig.RevealPeriod)
+}
+
+// BidCommitmentHash returns the commitment of the sealed bid, which is the SHA-256 hash
+// of the bid fields and the salt chosen by the bidder.
+func BidCommitmentHash(auctionId uint64, bidder sdk.AccAddress, bidType BidType, price sdk.Dec, coin sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s/%s/%s", auctionId, bidder, bidType, price, coin, salt)))
+	return hash[:]
+}
+
+// NewBidCommitment returns a new BidCommitment.
+func NewBidCommitment(auctionId uint64, commitmentId uint64, bidder sdk.AccAddress, commitment []byte, deposit sdk.Coin) BidCommitment {
+	return BidCommitment{
+		AuctionId:  auctionId,
+		Id:         commitmentId,
+		Bidder:     bidder.String(),
+		Commitment: commitment,
+		Deposit:    deposit,
+	}
+}
+
+// GetBidder returns the bidder address of the commitment.
+func (c BidCommitment) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(c.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates BidCommitment.
+func (c BidCommitment) Validate() error {
+	if c.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if c.Id == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commitment id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Bidder); err != nil {
+		return err
+	}
+	if len(c.Commitment) != BidCommitmentLength {
+		return sdkerrors.Wrapf(ErrInvalidBidCommitment, "commitment must be %d bytes long", BidCommitmentLength)
+	}
+	if err := c.Deposit.Validate(); err != nil {
+		return err
+	}
+	if !c.Deposit.Amount.IsPositive() {
+		return fmt.Errorf("deposit amount must be positive: %s", c.Deposit.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate validates AuctionSettlement.
+func (s AuctionSettlement) Validate() error {
+	if s.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if s.MatchedPrice.IsNil() || s.MatchedPrice.IsNegative() {
+		return fmt.Errorf("matched price must not be negative: %s", s.MatchedPrice)
+	}
+	if s.TotalSoldAmount.IsNil() || s.TotalSoldAmount.IsNegative() {
+		return fmt.Errorf("total sold amount must not be negative: %s", s.TotalSoldAmount)
+	}
+	if s.TotalRaisedAmount.IsNil() || s.TotalRaisedAmount.IsNegative() {
+		return fmt.Errorf("total raised amount must not be negative: %s", s.TotalRaisedAmount)
+	}
+	if s.TotalRefundedAmount.IsNil() || s.TotalRefundedAmount.IsNegative() {
+		return fmt.Errorf("total refunded amount must not be negative: %s", s.TotalRefundedAmount)
+	}
+	return nil
+}
+
+// GetBidder returns the bidder address of the settlement.
+func (s BidderSettlement) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(s.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates BidderSettlement.
+func (s BidderSettlement) Validate() error {
+	if s.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Bidder); err != nil {
+		return err
+	}
+	if s.AllocatedAmount.IsNil() || s.AllocatedAmount.IsNegative() {
+		return fmt.Errorf("allocated amount must not be negative: %s", s.AllocatedAmount)
+	}
+	if s.PaidAmount.IsNil() || s.PaidAmount.IsNegative() {
+		return fmt.Errorf("paid amount must not be negative: %s", s.PaidAmount)
+	}
+	if s.RefundedAmount.IsNil() || s.RefundedAmount.IsNegative() {
+		return fmt.Errorf("refunded amount must not be negative: %s", s.RefundedAmount)
+	}
+	return nil
+}
+
+// Validate validates SettlementCursor.
+func (c SettlementCursor) Validate() error {
+	if c.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if c.LastBidder != "" {
+		if _, err := sdk.AccAddressFromBech32(c.LastBidder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewAllocationClaim returns a new AllocationClaim.
+func NewAllocationClaim(auctionId uint64, bidder sdk.AccAddress, allocatedCoins, refundCoins sdk.Coins) AllocationClaim {
+	return AllocationClaim{
+		AuctionId:      auctionId,
+		Bidder:         bidder.String(),
+		AllocatedCoins: allocatedCoins,
+		RefundCoins:    refundCoins,
+		Claimed:        false,
+	}
+}
+
+// ClaimCoins returns all the coins that the bidder receives when the claim is claimed.
+func (c AllocationClaim) ClaimCoins() sdk.Coins {
+	return c.AllocatedCoins.Add(c.RefundCoins...)
+}
+
+// Validate validates AllocationClaim.
+func (c AllocationClaim) Validate() error {
+	if c.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auction id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Bidder); err != nil {
+		return err
+	}
+	if err := c.AllocatedCoins.Validate(); err != nil {
+		return fmt.Errorf("allocated coins are invalid: %v", err)
+	}
+	if err := c.RefundCoins.Validate(); err != nil {
+		return fmt.Errorf("refund coins are invalid: %v", err)
+	}
+	return nil
+}
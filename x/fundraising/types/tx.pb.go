@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -52,6 +53,38 @@ type MsgCreateFixedPriceAuction struct {
 	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// end_time specifies the end time of the plan
 	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// auctioneer_managed_allowlist specifies whether the allowed bidders of the
+	// auction are managed by the auctioneer through the allowed bidder messages
+	// or by an external module
+	AuctioneerManagedAllowlist bool `protobuf:"varint,8,opt,name=auctioneer_managed_allowlist,json=auctioneerManagedAllowlist,proto3" json:"auctioneer_managed_allowlist,omitempty"`
+	// open_bidding specifies whether any address can place a bid for the auction
+	// without being registered as an allowed bidder
+	OpenBidding bool `protobuf:"varint,9,opt,name=open_bidding,json=openBidding,proto3" json:"open_bidding,omitempty"`
+	// default_max_bid_amount specifies the maximum bid amount per bidder for the
+	// open bidding auction
+	DefaultMaxBidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=default_max_bid_amount,json=defaultMaxBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"default_max_bid_amount"`
+	// selling_basket specifies the additional coins that are sold together with
+	// the selling coin in the fixed ratio of their amounts to the selling coin
+	// amount
+	SellingBasket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=selling_basket,json=sellingBasket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"selling_basket"`
+	// paying_coin_rates specifies the additional denoms that bidders can use to
+	// bid for and their fixed conversion rates to the paying coin denom
+	PayingCoinRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,12,rep,name=paying_coin_rates,json=payingCoinRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"paying_coin_rates"`
+	// min_raise_amount specifies the minimum amount of the paying coin denom that
+	// the auction must raise; zero means that the auction has no minimum raise
+	MinRaiseAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=min_raise_amount,json=minRaiseAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_raise_amount"`
+	// close_when_sold_out specifies whether the auction is closed at the next
+	// block once the selling coin is sold out instead of waiting for the end time
+	CloseWhenSoldOut bool `protobuf:"varint,14,opt,name=close_when_sold_out,json=closeWhenSoldOut,proto3" json:"close_when_sold_out,omitempty"`
+	// bidder_vesting_schedules specifies the vesting schedules for the selling
+	// coin allocated to the bidders
+	BidderVestingSchedules []VestingSchedule `protobuf:"bytes,15,rep,name=bidder_vesting_schedules,json=bidderVestingSchedules,proto3" json:"bidder_vesting_schedules"`
+	// linear_vesting_schedule specifies the continuous vesting schedule for the
+	// auctioneer; it cannot be used together with vesting_schedules
+	LinearVestingSchedule *LinearVestingSchedule `protobuf:"bytes,16,opt,name=linear_vesting_schedule,json=linearVestingSchedule,proto3" json:"linear_vesting_schedule,omitempty"`
+	// claim_mode specifies whether the bidders claim the allocated selling coin
+	// and the refunded paying coin with MsgClaimAllocation
+	ClaimMode bool `protobuf:"varint,17,opt,name=claim_mode,json=claimMode,proto3" json:"claim_mode,omitempty"`
 }
 
 func (m *MsgCreateFixedPriceAuction) Reset()         { *m = MsgCreateFixedPriceAuction{} }
@@ -154,6 +187,40 @@ type MsgCreateBatchAuction struct {
 	StartTime time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// end_time specifies the end time of the plan
 	EndTime time.Time `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// auctioneer_managed_allowlist specifies whether the allowed bidders of the
+	// auction are managed by the auctioneer through the allowed bidder messages
+	// or by an external module
+	AuctioneerManagedAllowlist bool `protobuf:"varint,11,opt,name=auctioneer_managed_allowlist,json=auctioneerManagedAllowlist,proto3" json:"auctioneer_managed_allowlist,omitempty"`
+	// open_bidding specifies whether any address can place a bid for the auction
+	// without being registered as an allowed bidder
+	OpenBidding bool `protobuf:"varint,12,opt,name=open_bidding,json=openBidding,proto3" json:"open_bidding,omitempty"`
+	// default_max_bid_amount specifies the maximum bid amount per bidder for the
+	// open bidding auction
+	DefaultMaxBidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=default_max_bid_amount,json=defaultMaxBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"default_max_bid_amount"`
+	// paying_coin_rates specifies the additional denoms that bidders can use to
+	// bid for and their fixed conversion rates to the paying coin denom
+	PayingCoinRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,14,rep,name=paying_coin_rates,json=payingCoinRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"paying_coin_rates"`
+	// min_raise_amount specifies the minimum amount of the paying coin denom that
+	// the auction must raise; zero means that the auction has no minimum raise
+	MinRaiseAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=min_raise_amount,json=minRaiseAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_raise_amount"`
+	// bidder_vesting_schedules specifies the vesting schedules for the selling
+	// coin allocated to the bidders
+	BidderVestingSchedules []VestingSchedule `protobuf:"bytes,16,rep,name=bidder_vesting_schedules,json=bidderVestingSchedules,proto3" json:"bidder_vesting_schedules"`
+	// linear_vesting_schedule specifies the continuous vesting schedule for the
+	// auctioneer; it cannot be used together with vesting_schedules
+	LinearVestingSchedule *LinearVestingSchedule `protobuf:"bytes,17,opt,name=linear_vesting_schedule,json=linearVestingSchedule,proto3" json:"linear_vesting_schedule,omitempty"`
+	// claim_mode specifies whether the bidders claim the allocated selling coin
+	// and the refunded paying coin with MsgClaimAllocation
+	ClaimMode bool `protobuf:"varint,18,opt,name=claim_mode,json=claimMode,proto3" json:"claim_mode,omitempty"`
+	// sealed_bid_config specifies the sealed bid mode of the auction; bids are
+	// committed with MsgCommitBid and revealed with MsgRevealBid when it is set
+	SealedBidConfig *SealedBidConfig `protobuf:"bytes,19,opt,name=sealed_bid_config,json=sealedBidConfig,proto3" json:"sealed_bid_config,omitempty"`
+	// partial_fill_mode specifies how the bids at the matched price are filled
+	// when they can't be filled fully with the remaining selling coin
+	PartialFillMode PartialFillMode `protobuf:"varint,20,opt,name=partial_fill_mode,json=partialFillMode,proto3,enum=tendermint.fundraising.PartialFillMode" json:"partial_fill_mode,omitempty"`
+	// pricing_rule specifies the price that the winning bids pay for the
+	// selling coin
+	PricingRule PricingRule `protobuf:"varint,21,opt,name=pricing_rule,json=pricingRule,proto3,enum=tendermint.fundraising.PricingRule" json:"pricing_rule,omitempty"`
 }
 
 func (m *MsgCreateBatchAuction) Reset()         { *m = MsgCreateBatchAuction{} }
@@ -227,6 +294,126 @@ func (m *MsgCreateBatchAuctionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateBatchAuctionResponse proto.InternalMessageInfo
 
+// MsgCreateDutchAuction defines a SDK message for creating a dutch auction.
+type MsgCreateDutchAuction struct {
+	// auctioneer specifies the bech32-encoded address that creates the auction
+	Auctioneer string `protobuf:"bytes,1,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// start_price specifies the starting price of the auction
+	StartPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=start_price,json=startPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_price"`
+	// floor_price specifies the lowest price that the auction price can decay
+	// to
+	FloorPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=floor_price,json=floorPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"floor_price"`
+	// price_decay_step specifies the amount of price that decreases every price
+	// decay period
+	PriceDecayStep github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_decay_step,json=priceDecayStep,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_decay_step"`
+	// price_decay_period specifies the period of time between price decreases
+	PriceDecayPeriod time.Duration `protobuf:"bytes,5,opt,name=price_decay_period,json=priceDecayPeriod,proto3,stdduration" json:"price_decay_period"`
+	// selling_coin specifies the selling coin for the auction
+	SellingCoin types.Coin `protobuf:"bytes,6,opt,name=selling_coin,json=sellingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"selling_coin"`
+	// paying_coin_denom specifies the paying coin denom that bidders use to bid
+	// for
+	PayingCoinDenom string `protobuf:"bytes,7,opt,name=paying_coin_denom,json=payingCoinDenom,proto3" json:"paying_coin_denom,omitempty"`
+	// vesting_schedules specifies the vesting schedules for the auction
+	VestingSchedules []VestingSchedule `protobuf:"bytes,8,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
+	// start_time specifies the start time of the plan
+	StartTime time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time specifies the end time of the plan
+	EndTime time.Time `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// auctioneer_managed_allowlist specifies whether the allowed bidders of the
+	// auction are managed by the auctioneer through the allowed bidder messages
+	// or by an external module
+	AuctioneerManagedAllowlist bool `protobuf:"varint,11,opt,name=auctioneer_managed_allowlist,json=auctioneerManagedAllowlist,proto3" json:"auctioneer_managed_allowlist,omitempty"`
+	// open_bidding specifies whether any address can place a bid for the auction
+	// without being registered as an allowed bidder
+	OpenBidding bool `protobuf:"varint,12,opt,name=open_bidding,json=openBidding,proto3" json:"open_bidding,omitempty"`
+	// default_max_bid_amount specifies the maximum bid amount per bidder for the
+	// open bidding auction
+	DefaultMaxBidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=default_max_bid_amount,json=defaultMaxBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"default_max_bid_amount"`
+	// paying_coin_rates specifies the additional denoms that bidders can use to
+	// bid for and their fixed conversion rates to the paying coin denom
+	PayingCoinRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,14,rep,name=paying_coin_rates,json=payingCoinRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"paying_coin_rates"`
+	// bidder_vesting_schedules specifies the vesting schedules for the selling
+	// coin allocated to the bidders
+	BidderVestingSchedules []VestingSchedule `protobuf:"bytes,15,rep,name=bidder_vesting_schedules,json=bidderVestingSchedules,proto3" json:"bidder_vesting_schedules"`
+	// linear_vesting_schedule specifies the continuous vesting schedule for the
+	// auctioneer; it cannot be used together with vesting_schedules
+	LinearVestingSchedule *LinearVestingSchedule `protobuf:"bytes,16,opt,name=linear_vesting_schedule,json=linearVestingSchedule,proto3" json:"linear_vesting_schedule,omitempty"`
+	// claim_mode specifies whether the bidders claim the allocated selling coin
+	// and the refunded paying coin with MsgClaimAllocation
+	ClaimMode bool `protobuf:"varint,17,opt,name=claim_mode,json=claimMode,proto3" json:"claim_mode,omitempty"`
+}
+
+func (m *MsgCreateDutchAuction) Reset()         { *m = MsgCreateDutchAuction{} }
+func (m *MsgCreateDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDutchAuction) ProtoMessage()    {}
+func (*MsgCreateDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{4}
+}
+func (m *MsgCreateDutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDutchAuction.Merge(m, src)
+}
+func (m *MsgCreateDutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDutchAuction proto.InternalMessageInfo
+
+// MsgCreateDutchAuctionResponse defines the
+// Msg/MsgCreateDutchAuctionResponse response type.
+type MsgCreateDutchAuctionResponse struct {
+}
+
+func (m *MsgCreateDutchAuctionResponse) Reset()         { *m = MsgCreateDutchAuctionResponse{} }
+func (m *MsgCreateDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDutchAuctionResponse) ProtoMessage()    {}
+func (*MsgCreateDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{5}
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDutchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDutchAuctionResponse.Merge(m, src)
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDutchAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDutchAuctionResponse proto.InternalMessageInfo
+
 // MsgCancelAuction defines a SDK message for cancelling the auction.
 // Cancelling is only allowed when the auction hasn't started yet.
 type MsgCancelAuction struct {
@@ -241,7 +428,7 @@ func (m *MsgCancelAuction) Reset()         { *m = MsgCancelAuction{} }
 func (m *MsgCancelAuction) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelAuction) ProtoMessage()    {}
 func (*MsgCancelAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{4}
+	return fileDescriptor_f041af45fa02962b, []int{6}
 }
 func (m *MsgCancelAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +466,7 @@ func (m *MsgCancelAuctionResponse) Reset()         { *m = MsgCancelAuctionRespon
 func (m *MsgCancelAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelAuctionResponse) ProtoMessage()    {}
 func (*MsgCancelAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{5}
+	return fileDescriptor_f041af45fa02962b, []int{7}
 }
 func (m *MsgCancelAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,11 +502,13 @@ type MsgPlaceBid struct {
 	// bidder specifies the bech32-encoded address that bids for the auction
 	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 	// type specifies the bid type; type 1 is fixed price, 2 is how-much-worth, 3
-	// is how-many-coins
+	// is how-many-coins, and 4 is dutch
 	BidType BidType `protobuf:"varint,3,opt,name=bid_type,json=bidType,proto3,enum=tendermint.fundraising.BidType" json:"bid_type,omitempty"`
 	// price specifies the bid price.
 	// The bid price must be the start price for fixed price auction whereas
 	// the bide price can be any value that the bidder places.
+	// For dutch auction, the bid price is the maximum price that the bidder is
+	// willing to pay and the bid is filled at the current auction price.
 	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 	// coin specifies the paying amount of coin or the selling amount that the
 	// bidder bids
@@ -330,7 +519,7 @@ func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
 func (m *MsgPlaceBid) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceBid) ProtoMessage()    {}
 func (*MsgPlaceBid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{6}
+	return fileDescriptor_f041af45fa02962b, []int{8}
 }
 func (m *MsgPlaceBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +556,7 @@ func (m *MsgPlaceBidResponse) Reset()         { *m = MsgPlaceBidResponse{} }
 func (m *MsgPlaceBidResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceBidResponse) ProtoMessage()    {}
 func (*MsgPlaceBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{7}
+	return fileDescriptor_f041af45fa02962b, []int{9}
 }
 func (m *MsgPlaceBidResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,8 +595,8 @@ type MsgModifyBid struct {
 	// bid_id specifies the bid id
 	BidId uint64 `protobuf:"varint,3,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
 	// price specifies the bid price.
-	// the bide price must be above or equal to the original value that the bidder
-	// placed.
+	// the bid price can be lower than the original value that the bidder placed
+	// only before the bid cancellation cutoff of the auction.
 	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
 	// coin specifies the paying amount of coin or the selling amount that the
 	// bidder bids
@@ -418,7 +607,7 @@ func (m *MsgModifyBid) Reset()         { *m = MsgModifyBid{} }
 func (m *MsgModifyBid) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyBid) ProtoMessage()    {}
 func (*MsgModifyBid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{8}
+	return fileDescriptor_f041af45fa02962b, []int{10}
 }
 func (m *MsgModifyBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,7 +644,7 @@ func (m *MsgModifyBidResponse) Reset()         { *m = MsgModifyBidResponse{} }
 func (m *MsgModifyBidResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyBidResponse) ProtoMessage()    {}
 func (*MsgModifyBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{9}
+	return fileDescriptor_f041af45fa02962b, []int{11}
 }
 func (m *MsgModifyBidResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,28 +673,29 @@ func (m *MsgModifyBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgModifyBidResponse proto.InternalMessageInfo
 
-// MsgAddAllowedBidder defines a SDK message for adding an allowed bidder to the
-// auction.
-type MsgAddAllowedBidder struct {
+// MsgCancelBid defines a SDK message for cancelling an existing bid for the
+// batch auction.
+type MsgCancelBid struct {
 	// auction_id specifies the auction id
 	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	// allowed_bidder specifies the bidder who is allowed to bid and their maximum
-	// bid amount
-	AllowedBidder AllowedBidder `protobuf:"bytes,2,opt,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
+	// bidder specifies the bech32-encoded address that bids for the auction
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_id specifies the bid id
+	BidId uint64 `protobuf:"varint,3,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
 }
 
-func (m *MsgAddAllowedBidder) Reset()         { *m = MsgAddAllowedBidder{} }
-func (m *MsgAddAllowedBidder) String() string { return proto.CompactTextString(m) }
-func (*MsgAddAllowedBidder) ProtoMessage()    {}
-func (*MsgAddAllowedBidder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{10}
+func (m *MsgCancelBid) Reset()         { *m = MsgCancelBid{} }
+func (m *MsgCancelBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBid) ProtoMessage()    {}
+func (*MsgCancelBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{12}
 }
-func (m *MsgAddAllowedBidder) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddAllowedBidder.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelBid.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -515,33 +705,34 @@ func (m *MsgAddAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddAllowedBidder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddAllowedBidder.Merge(m, src)
+func (m *MsgCancelBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBid.Merge(m, src)
 }
-func (m *MsgAddAllowedBidder) XXX_Size() int {
+func (m *MsgCancelBid) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddAllowedBidder) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddAllowedBidder.DiscardUnknown(m)
+func (m *MsgCancelBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBid.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddAllowedBidder proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelBid proto.InternalMessageInfo
 
-type MsgAddAllowedBidderResponse struct {
+// MsgCancelBidResponse defines the Msg/MsgCancelBidResponse response type.
+type MsgCancelBidResponse struct {
 }
 
-func (m *MsgAddAllowedBidderResponse) Reset()         { *m = MsgAddAllowedBidderResponse{} }
-func (m *MsgAddAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddAllowedBidderResponse) ProtoMessage()    {}
-func (*MsgAddAllowedBidderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f041af45fa02962b, []int{11}
+func (m *MsgCancelBidResponse) Reset()         { *m = MsgCancelBidResponse{} }
+func (m *MsgCancelBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBidResponse) ProtoMessage()    {}
+func (*MsgCancelBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{13}
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelBidResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddAllowedBidderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelBidResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,1120 +742,7068 @@ func (m *MsgAddAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddAllowedBidderResponse.Merge(m, src)
+func (m *MsgCancelBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBidResponse.Merge(m, src)
 }
-func (m *MsgAddAllowedBidderResponse) XXX_Size() int {
+func (m *MsgCancelBidResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddAllowedBidderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddAllowedBidderResponse.DiscardUnknown(m)
+func (m *MsgCancelBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBidResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddAllowedBidderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelBidResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFixedPriceAuction)(nil), "tendermint.fundraising.MsgCreateFixedPriceAuction")
-	proto.RegisterType((*MsgCreateFixedPriceAuctionResponse)(nil), "tendermint.fundraising.MsgCreateFixedPriceAuctionResponse")
-	proto.RegisterType((*MsgCreateBatchAuction)(nil), "tendermint.fundraising.MsgCreateBatchAuction")
-	proto.RegisterType((*MsgCreateBatchAuctionResponse)(nil), "tendermint.fundraising.MsgCreateBatchAuctionResponse")
-	proto.RegisterType((*MsgCancelAuction)(nil), "tendermint.fundraising.MsgCancelAuction")
-	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "tendermint.fundraising.MsgCancelAuctionResponse")
-	proto.RegisterType((*MsgPlaceBid)(nil), "tendermint.fundraising.MsgPlaceBid")
-	proto.RegisterType((*MsgPlaceBidResponse)(nil), "tendermint.fundraising.MsgPlaceBidResponse")
-	proto.RegisterType((*MsgModifyBid)(nil), "tendermint.fundraising.MsgModifyBid")
-	proto.RegisterType((*MsgModifyBidResponse)(nil), "tendermint.fundraising.MsgModifyBidResponse")
-	proto.RegisterType((*MsgAddAllowedBidder)(nil), "tendermint.fundraising.MsgAddAllowedBidder")
-	proto.RegisterType((*MsgAddAllowedBidderResponse)(nil), "tendermint.fundraising.MsgAddAllowedBidderResponse")
+// MsgCommitBid defines a SDK message for committing a sealed bid for the
+// batch auction in sealed bid mode.
+type MsgCommitBid struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that commits the bid
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment specifies the SHA-256 hash of the bid and the salt
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit specifies the paying coin deposited as collateral
+	Deposit types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
 }
 
-func init() { proto.RegisterFile("fundraising/tx.proto", fileDescriptor_f041af45fa02962b) }
-
-var fileDescriptor_f041af45fa02962b = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0xdb, 0x36,
-	0x14, 0xb6, 0x62, 0x3b, 0xb1, 0x9f, 0x9b, 0x2e, 0x61, 0x93, 0xcc, 0xd3, 0x10, 0x3b, 0xc8, 0xba,
-	0x35, 0xd8, 0x5a, 0x69, 0x75, 0xd1, 0x4b, 0x2e, 0x43, 0x94, 0x6c, 0x40, 0x0f, 0x46, 0x0b, 0xad,
-	0xd8, 0x80, 0x62, 0xa8, 0x40, 0x8b, 0x8c, 0x42, 0xd4, 0x12, 0x0d, 0x91, 0xce, 0x9c, 0xfd, 0x82,
-	0xee, 0x32, 0xe4, 0x27, 0xec, 0x3c, 0x0c, 0xd8, 0xdf, 0xe8, 0xb1, 0xc7, 0x61, 0x87, 0x76, 0x48,
-	0xce, 0xfb, 0x0f, 0x03, 0x29, 0x59, 0x95, 0x3d, 0x3b, 0xb5, 0xd3, 0x02, 0xc1, 0x4e, 0xa6, 0x1e,
-	0xbf, 0xf7, 0xbd, 0xc7, 0x8f, 0xef, 0x93, 0x0c, 0x6b, 0x87, 0xfd, 0x88, 0xc4, 0x98, 0x09, 0x16,
-	0x05, 0xb6, 0x1c, 0x58, 0xbd, 0x98, 0x4b, 0x8e, 0x36, 0x24, 0x8d, 0x08, 0x8d, 0x43, 0x16, 0x49,
-	0x2b, 0x07, 0x30, 0x1b, 0x3e, 0x17, 0x21, 0x17, 0x76, 0x07, 0x0b, 0x6a, 0x1f, 0xdf, 0xed, 0x50,
-	0x89, 0xef, 0xda, 0x3e, 0x67, 0x51, 0x92, 0x67, 0xae, 0x05, 0x3c, 0xe0, 0x7a, 0x69, 0xab, 0x55,
-	0x1a, 0x6d, 0x06, 0x9c, 0x07, 0x5d, 0x6a, 0xeb, 0xa7, 0x4e, 0xff, 0xd0, 0x96, 0x2c, 0xa4, 0x42,
-	0xe2, 0xb0, 0x97, 0x02, 0x36, 0xf3, 0x4d, 0xe4, 0xd6, 0xc9, 0xf6, 0xf6, 0x69, 0x09, 0xcc, 0xb6,
-	0x08, 0xf6, 0x63, 0x8a, 0x25, 0xfd, 0x86, 0x0d, 0x28, 0x79, 0x14, 0x33, 0x9f, 0xee, 0xf5, 0x7d,
-	0xc9, 0x78, 0x84, 0x1a, 0x00, 0x38, 0x59, 0x52, 0x1a, 0xd7, 0x8d, 0x2d, 0x63, 0xa7, 0xea, 0xe6,
-	0x22, 0xe8, 0x21, 0xd4, 0x84, 0xc4, 0xb1, 0xf4, 0x7a, 0x2a, 0xab, 0xbe, 0xa0, 0x00, 0x8e, 0xf5,
-	0xe2, 0x55, 0xb3, 0xf0, 0xd7, 0xab, 0xe6, 0x67, 0x01, 0x93, 0x47, 0xfd, 0x8e, 0xe5, 0xf3, 0xd0,
-	0x4e, 0x0f, 0x97, 0xfc, 0xdc, 0x11, 0xe4, 0x99, 0x2d, 0x4f, 0x7a, 0x54, 0x58, 0x07, 0xd4, 0x77,
-	0x41, 0x53, 0xe8, 0xba, 0x28, 0x84, 0x6b, 0x82, 0x76, 0xbb, 0x2c, 0x0a, 0x3c, 0x75, 0xf6, 0x7a,
-	0x71, 0xcb, 0xd8, 0xa9, 0xb5, 0x3e, 0xb2, 0x92, 0x44, 0x4b, 0x89, 0x63, 0xa5, 0xe2, 0x58, 0xfb,
-	0x9c, 0x45, 0x8e, 0xad, 0x8a, 0xfd, 0xf6, 0xba, 0x79, 0x6b, 0x86, 0x62, 0x2a, 0xc1, 0xad, 0xa5,
-	0xfc, 0xea, 0x01, 0x7d, 0x0e, 0xab, 0x3d, 0x7c, 0x32, 0xac, 0xe6, 0x11, 0x1a, 0xf1, 0xb0, 0x5e,
-	0xd2, 0xc7, 0xfc, 0x20, 0xd9, 0x50, 0xb0, 0x03, 0x15, 0x46, 0x4f, 0x60, 0xf5, 0x98, 0x0a, 0xa9,
-	0xc0, 0xc2, 0x3f, 0xa2, 0xa4, 0xdf, 0xa5, 0xa2, 0x5e, 0xde, 0x2a, 0xee, 0xd4, 0x5a, 0xb7, 0xac,
-	0xc9, 0x97, 0x6a, 0x7d, 0x97, 0x24, 0x7c, 0x9b, 0xe2, 0x9d, 0x92, 0xea, 0xd6, 0x5d, 0x39, 0x1e,
-	0x0d, 0x0b, 0xb4, 0x0f, 0x89, 0x08, 0x9e, 0xba, 0xbe, 0xfa, 0xa2, 0x3e, 0xb4, 0x69, 0x25, 0x77,
-	0x6b, 0x0d, 0xef, 0xd6, 0x7a, 0x3c, 0xbc, 0x5b, 0xa7, 0xa2, 0x78, 0x4e, 0x5f, 0x37, 0x0d, 0xb7,
-	0xaa, 0xf3, 0xd4, 0x0e, 0xfa, 0x0a, 0x2a, 0x34, 0x22, 0x09, 0xc5, 0xd2, 0x1c, 0x14, 0x4b, 0x34,
-	0x22, 0x2a, 0xbe, 0x5b, 0x7a, 0xfe, 0x6b, 0xb3, 0xb0, 0x7d, 0x13, 0xb6, 0xa7, 0x4f, 0x84, 0x4b,
-	0x45, 0x8f, 0x47, 0x82, 0x6e, 0xff, 0x53, 0x86, 0xf5, 0x0c, 0xe6, 0x60, 0xe9, 0x1f, 0x5d, 0xd9,
-	0xcc, 0xb8, 0xb0, 0x1c, 0xb2, 0xc8, 0xeb, 0x30, 0x92, 0x52, 0x16, 0x2f, 0x45, 0x59, 0x0b, 0x59,
-	0xe4, 0x30, 0x32, 0x79, 0x0e, 0x4b, 0x57, 0x30, 0x87, 0xe5, 0x39, 0xe6, 0x70, 0xf1, 0xfd, 0xcc,
-	0xe1, 0x6d, 0x40, 0x21, 0x1e, 0x78, 0x74, 0xa0, 0x79, 0x88, 0x17, 0xf3, 0x7e, 0x44, 0xf4, 0x30,
-	0x2d, 0xbb, 0x2b, 0x21, 0x1e, 0x7c, 0x9d, 0x6e, 0xb8, 0x2a, 0x8e, 0x9e, 0xc2, 0x8d, 0x51, 0xa4,
-	0x17, 0x63, 0x49, 0xeb, 0x95, 0x4b, 0xc9, 0xbf, 0x4a, 0xf3, 0xdc, 0x2e, 0x96, 0x74, 0xcc, 0x15,
-	0xd5, 0x77, 0x77, 0x05, 0x5c, 0xde, 0x15, 0x4d, 0xd8, 0x9c, 0x38, 0xee, 0x99, 0x21, 0xbe, 0x87,
-	0x15, 0x05, 0xc0, 0x91, 0x4f, 0xbb, 0xb3, 0x5a, 0x61, 0x33, 0xdb, 0xf7, 0x18, 0xd1, 0x4e, 0x28,
-	0xb9, 0xd5, 0x34, 0xf2, 0x80, 0xa4, 0x95, 0x4d, 0xa8, 0x8f, 0x13, 0x67, 0x45, 0x7f, 0x5f, 0x80,
-	0x5a, 0x5b, 0x04, 0x8f, 0xba, 0xd8, 0xa7, 0x0e, 0x23, 0x63, 0x84, 0xc6, 0x18, 0x21, 0xda, 0x80,
-	0xc5, 0x0e, 0x23, 0x84, 0xc6, 0x89, 0xeb, 0xdc, 0xf4, 0x09, 0xed, 0x42, 0x45, 0xb9, 0x47, 0x5d,
-	0x86, 0x36, 0xcf, 0xf5, 0x56, 0x73, 0xda, 0x24, 0x39, 0x8c, 0x3c, 0x3e, 0xe9, 0x51, 0x77, 0xa9,
-	0x93, 0x2c, 0xd0, 0x01, 0x94, 0x13, 0xd7, 0x95, 0x2e, 0x75, 0xed, 0x49, 0x32, 0x7a, 0x0a, 0x25,
-	0xed, 0xb3, 0xf2, 0x7b, 0xf7, 0x99, 0xe6, 0x4d, 0xa5, 0x5c, 0x87, 0x1b, 0x39, 0xb5, 0x32, 0x15,
-	0x9f, 0x2f, 0xc0, 0xb5, 0xb6, 0x08, 0xda, 0x9c, 0xb0, 0xc3, 0x93, 0x77, 0x90, 0x71, 0x5d, 0xc7,
-	0x55, 0x4a, 0x51, 0xa7, 0x94, 0x3b, 0x8c, 0x3c, 0x20, 0xff, 0x2b, 0x85, 0x36, 0x60, 0x2d, 0xaf,
-	0x44, 0x26, 0xd1, 0x2f, 0x86, 0x96, 0x6e, 0x8f, 0x90, 0xbd, 0x6e, 0x97, 0xff, 0x48, 0x89, 0x93,
-	0x1c, 0xf9, 0x2d, 0x4a, 0xb9, 0x70, 0x1d, 0x27, 0x78, 0x2f, 0xa7, 0x58, 0xad, 0xf5, 0xe9, 0xb4,
-	0xf1, 0x1a, 0x61, 0x4f, 0x5f, 0x53, 0xcb, 0x38, 0x1f, 0x4c, 0x1b, 0xdd, 0x84, 0x8f, 0x27, 0xf4,
-	0x33, 0xec, 0xb7, 0xf5, 0x47, 0x19, 0x8a, 0x6d, 0x11, 0xa0, 0x9f, 0x0d, 0xf8, 0x70, 0xda, 0x9f,
-	0x9b, 0xd6, 0xb4, 0x26, 0xa6, 0x7f, 0xfe, 0xcc, 0xdd, 0xf9, 0x73, 0x86, 0x3d, 0xa1, 0x9f, 0x00,
-	0x4d, 0xf8, 0x5c, 0xde, 0x79, 0x2b, 0x63, 0x1e, 0x6e, 0xde, 0x9f, 0x0b, 0x9e, 0xd5, 0x7e, 0x06,
-	0xcb, 0xa3, 0xaf, 0xa6, 0x9d, 0x8b, 0x78, 0xf2, 0x48, 0xf3, 0xcb, 0x59, 0x91, 0x59, 0xb1, 0x1f,
-	0xa0, 0x92, 0xbd, 0x91, 0x3e, 0xb9, 0x20, 0x7b, 0x08, 0x32, 0xbf, 0x98, 0x01, 0x94, 0xb1, 0x7b,
-	0x50, 0x7d, 0xe3, 0xd4, 0x9b, 0x17, 0x64, 0x66, 0x28, 0xf3, 0xf6, 0x2c, 0xa8, 0xac, 0x80, 0x84,
-	0x95, 0xff, 0xcc, 0xf9, 0x45, 0x1d, 0x8e, 0x83, 0xcd, 0x7b, 0x73, 0x80, 0x87, 0x55, 0x9d, 0x87,
-	0x2f, 0xce, 0x1a, 0xc6, 0xcb, 0xb3, 0x86, 0xf1, 0xf7, 0x59, 0xc3, 0x38, 0x3d, 0x6f, 0x14, 0x5e,
-	0x9e, 0x37, 0x0a, 0x7f, 0x9e, 0x37, 0x0a, 0x4f, 0xee, 0xe7, 0x8c, 0xfc, 0x86, 0x38, 0xff, 0x67,
-	0xde, 0x1e, 0x8c, 0x3c, 0x69, 0x6f, 0x77, 0x16, 0xf5, 0xe7, 0xed, 0xde, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x32, 0x70, 0x3d, 0x5b, 0x87, 0x0c, 0x00, 0x00,
+func (m *MsgCommitBid) Reset()         { *m = MsgCommitBid{} }
+func (m *MsgCommitBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBid) ProtoMessage()    {}
+func (*MsgCommitBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{14}
+}
+func (m *MsgCommitBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBid.Merge(m, src)
+}
+func (m *MsgCommitBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBid.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgCommitBid proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// this line is used by Starport scaffolding # proto/tx/rpc
-	// Submit a create fixed price auction message.
-	CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error)
-	// Submit a create batch auction message.
-	CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error)
-	// CancelAuction defines a method to cancel the auction message.
-	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
-	// PlaceBid defines a method to place a bid message.
-	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
-	// ModifyBid defines a method to modify the bid message.
-	ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error)
-	// AddAllowedBidder defines a method sto add a single allowed bidder message.
-	// This is for the testing purpose and it must not be used in mainnet.
-	AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error)
+// MsgCommitBidResponse defines the Msg/MsgCommitBidResponse response type.
+type MsgCommitBidResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCommitBidResponse) Reset()         { *m = MsgCommitBidResponse{} }
+func (m *MsgCommitBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBidResponse) ProtoMessage()    {}
+func (*MsgCommitBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{15}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCommitBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error) {
-	out := new(MsgCreateFixedPriceAuctionResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CreateFixedPriceAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCommitBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCommitBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBidResponse.Merge(m, src)
+}
+func (m *MsgCommitBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBidResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error) {
-	out := new(MsgCreateBatchAuctionResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CreateBatchAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCommitBidResponse proto.InternalMessageInfo
+
+// MsgRevealBid defines a SDK message for revealing the committed bid in the
+// reveal window of the batch auction in sealed bid mode.
+type MsgRevealBid struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address that committed the bid
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment_id specifies the id of the commitment
+	CommitmentId uint64 `protobuf:"varint,3,opt,name=commitment_id,json=commitmentId,proto3" json:"commitment_id,omitempty"`
+	// bid_type specifies the bid type; only the batch bid types are allowed
+	BidType BidType `protobuf:"varint,4,opt,name=bid_type,json=bidType,proto3,enum=tendermint.fundraising.BidType" json:"bid_type,omitempty"`
+	// price specifies the bid price
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// coin specifies the paying amount of coin or the selling amount that the
+	// bidder bids
+	Coin types.Coin `protobuf:"bytes,6,opt,name=coin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
+	// salt specifies the secret that is hashed together with the bid
+	Salt string `protobuf:"bytes,7,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
-	out := new(MsgCancelAuctionResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CancelAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBid) Reset()         { *m = MsgRevealBid{} }
+func (m *MsgRevealBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBid) ProtoMessage()    {}
+func (*MsgRevealBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{16}
+}
+func (m *MsgRevealBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevealBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBid.Merge(m, src)
+}
+func (m *MsgRevealBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBid.DiscardUnknown(m)
 }
 
-func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
-	out := new(MsgPlaceBidResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/PlaceBid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRevealBid proto.InternalMessageInfo
+
+// MsgRevealBidResponse defines the Msg/MsgRevealBidResponse response type.
+type MsgRevealBidResponse struct {
 }
 
-func (c *msgClient) ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error) {
-	out := new(MsgModifyBidResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/ModifyBid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealBidResponse) Reset()         { *m = MsgRevealBidResponse{} }
+func (m *MsgRevealBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidResponse) ProtoMessage()    {}
+func (*MsgRevealBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{17}
+}
+func (m *MsgRevealBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevealBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidResponse.Merge(m, src)
+}
+func (m *MsgRevealBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error) {
-	out := new(MsgAddAllowedBidderResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/AddAllowedBidder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRevealBidResponse proto.InternalMessageInfo
+
+// MsgAddAllowedBidders defines a SDK message for the auctioneer to add allowed
+// bidders to the auction.
+type MsgAddAllowedBidders struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// auctioneer specifies the bech32-encoded address of the auctioneer
+	Auctioneer string `protobuf:"bytes,2,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// allowed_bidders specifies the bidders who are allowed to bid and their
+	// maximum bid amounts
+	AllowedBidders []AllowedBidder `protobuf:"bytes,3,rep,name=allowed_bidders,json=allowedBidders,proto3" json:"allowed_bidders"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// this line is used by Starport scaffolding # proto/tx/rpc
-	// Submit a create fixed price auction message.
-	CreateFixedPriceAuction(context.Context, *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error)
-	// Submit a create batch auction message.
-	CreateBatchAuction(context.Context, *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error)
-	// CancelAuction defines a method to cancel the auction message.
-	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
-	// PlaceBid defines a method to place a bid message.
-	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
-	// ModifyBid defines a method to modify the bid message.
-	ModifyBid(context.Context, *MsgModifyBid) (*MsgModifyBidResponse, error)
-	// AddAllowedBidder defines a method sto add a single allowed bidder message.
-	// This is for the testing purpose and it must not be used in mainnet.
-	AddAllowedBidder(context.Context, *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error)
+func (m *MsgAddAllowedBidders) Reset()         { *m = MsgAddAllowedBidders{} }
+func (m *MsgAddAllowedBidders) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedBidders) ProtoMessage()    {}
+func (*MsgAddAllowedBidders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{18}
+}
+func (m *MsgAddAllowedBidders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedBidders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedBidders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAllowedBidders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedBidders.Merge(m, src)
+}
+func (m *MsgAddAllowedBidders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedBidders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedBidders.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgAddAllowedBidders proto.InternalMessageInfo
+
+// MsgAddAllowedBiddersResponse defines the Msg/MsgAddAllowedBiddersResponse
+// response type.
+type MsgAddAllowedBiddersResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateFixedPriceAuction(ctx context.Context, req *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedPriceAuction not implemented")
+func (m *MsgAddAllowedBiddersResponse) Reset()         { *m = MsgAddAllowedBiddersResponse{} }
+func (m *MsgAddAllowedBiddersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedBiddersResponse) ProtoMessage()    {}
+func (*MsgAddAllowedBiddersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{19}
 }
-func (*UnimplementedMsgServer) CreateBatchAuction(ctx context.Context, req *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBatchAuction not implemented")
+func (m *MsgAddAllowedBiddersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+func (m *MsgAddAllowedBiddersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedBiddersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+func (m *MsgAddAllowedBiddersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedBiddersResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ModifyBid(ctx context.Context, req *MsgModifyBid) (*MsgModifyBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyBid not implemented")
+func (m *MsgAddAllowedBiddersResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddAllowedBidder(ctx context.Context, req *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBidder not implemented")
+func (m *MsgAddAllowedBiddersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedBiddersResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgAddAllowedBiddersResponse proto.InternalMessageInfo
 
-func _Msg_CreateFixedPriceAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFixedPriceAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFixedPriceAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Msg/CreateFixedPriceAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFixedPriceAuction(ctx, req.(*MsgCreateFixedPriceAuction))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgClaimVested defines a SDK message for the auctioneer to claim the paying
+// coin vested so far by the linear vesting schedule of the auction.
+type MsgClaimVested struct {
+	// auctioneer specifies the bech32-encoded address that is in charge of the
+	// auction
+	Auctioneer string `protobuf:"bytes,1,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func _Msg_CreateBatchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBatchAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBatchAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Msg/CreateBatchAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBatchAuction(ctx, req.(*MsgCreateBatchAuction))
+func (m *MsgClaimVested) Reset()         { *m = MsgClaimVested{} }
+func (m *MsgClaimVested) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVested) ProtoMessage()    {}
+func (*MsgClaimVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{20}
+}
+func (m *MsgClaimVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVested.Merge(m, src)
+}
+func (m *MsgClaimVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVested.DiscardUnknown(m)
 }
 
-func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Msg/CancelAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgClaimVested proto.InternalMessageInfo
+
+// MsgClaimVestedResponse defines the Msg/MsgClaimVestedResponse response type.
+type MsgClaimVestedResponse struct {
 }
 
-func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Msg/PlaceBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+func (m *MsgClaimVestedResponse) Reset()         { *m = MsgClaimVestedResponse{} }
+func (m *MsgClaimVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedResponse) ProtoMessage()    {}
+func (*MsgClaimVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{21}
+}
+func (m *MsgClaimVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedResponse.DiscardUnknown(m)
 }
 
-func _Msg_ModifyBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Msg/ModifyBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyBid(ctx, req.(*MsgModifyBid))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
+
+// MsgClaimAllocation defines a SDK message for claiming the allocated selling
+// coin and the refunded paying coin of a bidder for the auction in claim mode.
+// Anyone can send it on behalf of the bidder, but the coins are always sent to
+// the bidder.
+type MsgClaimAllocation struct {
+	// sender specifies the bech32-encoded address that sends the message
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder specifies the bech32-encoded address of the bidder who receives the
+	// coins
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func _Msg_AddAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAllowedBidder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAllowedBidder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.fundraising.Msg/AddAllowedBidder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAllowedBidder(ctx, req.(*MsgAddAllowedBidder))
+func (m *MsgClaimAllocation) Reset()         { *m = MsgClaimAllocation{} }
+func (m *MsgClaimAllocation) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllocation) ProtoMessage()    {}
+func (*MsgClaimAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{22}
+}
+func (m *MsgClaimAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllocation.Merge(m, src)
+}
+func (m *MsgClaimAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllocation.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.fundraising.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFixedPriceAuction",
-			Handler:    _Msg_CreateFixedPriceAuction_Handler,
-		},
-		{
-			MethodName: "CreateBatchAuction",
-			Handler:    _Msg_CreateBatchAuction_Handler,
-		},
-		{
-			MethodName: "CancelAuction",
-			Handler:    _Msg_CancelAuction_Handler,
-		},
-		{
-			MethodName: "PlaceBid",
-			Handler:    _Msg_PlaceBid_Handler,
-		},
-		{
-			MethodName: "ModifyBid",
-			Handler:    _Msg_ModifyBid_Handler,
-		},
-		{
-			MethodName: "AddAllowedBidder",
-			Handler:    _Msg_AddAllowedBidder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fundraising/tx.proto",
+var xxx_messageInfo_MsgClaimAllocation proto.InternalMessageInfo
+
+// MsgClaimAllocationResponse defines the Msg/MsgClaimAllocationResponse
+// response type.
+type MsgClaimAllocationResponse struct {
 }
 
-func (m *MsgCreateFixedPriceAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimAllocationResponse) Reset()         { *m = MsgClaimAllocationResponse{} }
+func (m *MsgClaimAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllocationResponse) ProtoMessage()    {}
+func (*MsgClaimAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{23}
 }
-
-func (m *MsgCreateFixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateFixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x3a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x32
-	if len(m.VestingSchedules) > 0 {
-		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PayingCoinDenom) > 0 {
-		i -= len(m.PayingCoinDenom)
-		copy(dAtA[i:], m.PayingCoinDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.StartPrice.Size()
-		i -= size
-		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateFixedPriceAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllocationResponse.Merge(m, src)
 }
-
-func (m *MsgCreateFixedPriceAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllocationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateFixedPriceAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllocationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateBatchAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgClaimAllocationResponse proto.InternalMessageInfo
 
-func (m *MsgCreateBatchAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateAllowedBidder defines a SDK message for the auctioneer to update
+// the maximum bid amount of the allowed bidder.
+type MsgUpdateAllowedBidder struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// auctioneer specifies the bech32-encoded address of the auctioneer
+	Auctioneer string `protobuf:"bytes,2,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// bidder specifies the bech32-encoded address of the allowed bidder
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// max_bid_amount specifies the new maximum bid amount of the allowed bidder
+	MaxBidAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_bid_amount,json=maxBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_bid_amount"`
 }
 
-func (m *MsgCreateBatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTx(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x52
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.ExtendedRoundRate.Size()
-		i -= size
-		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.MaxExtendedRound != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxExtendedRound))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.VestingSchedules) > 0 {
-		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.PayingCoinDenom) > 0 {
-		i -= len(m.PayingCoinDenom)
-		copy(dAtA[i:], m.PayingCoinDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateAllowedBidder) Reset()         { *m = MsgUpdateAllowedBidder{} }
+func (m *MsgUpdateAllowedBidder) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedBidder) ProtoMessage()    {}
+func (*MsgUpdateAllowedBidder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{24}
+}
+func (m *MsgUpdateAllowedBidder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedBidder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.MinBidPrice.Size()
-		i -= size
-		if _, err := m.MinBidPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.StartPrice.Size()
-		i -= size
-		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateBatchAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateAllowedBidder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedBidder.Merge(m, src)
 }
-
-func (m *MsgCreateBatchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateAllowedBidder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedBidder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedBidder.DiscardUnknown(m)
 }
 
-func (m *MsgCreateBatchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateAllowedBidder proto.InternalMessageInfo
+
+// MsgUpdateAllowedBidderResponse defines the Msg/MsgUpdateAllowedBidderResponse
+// response type.
+type MsgUpdateAllowedBidderResponse struct {
 }
 
-func (m *MsgCancelAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAllowedBidderResponse) Reset()         { *m = MsgUpdateAllowedBidderResponse{} }
+func (m *MsgUpdateAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedBidderResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowedBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{25}
+}
+func (m *MsgUpdateAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateAllowedBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedBidderResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowedBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedBidderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateAllowedBidderResponse proto.InternalMessageInfo
+
+// MsgRemoveAllowedBidder defines a SDK message for the auctioneer to remove the
+// allowed bidder from the auction.
+type MsgRemoveAllowedBidder struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// auctioneer specifies the bech32-encoded address of the auctioneer
+	Auctioneer string `protobuf:"bytes,2,opt,name=auctioneer,proto3" json:"auctioneer,omitempty"`
+	// bidder specifies the bech32-encoded address of the allowed bidder
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-func (m *MsgCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Auctioneer) > 0 {
-		i -= len(m.Auctioneer)
-		copy(dAtA[i:], m.Auctioneer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRemoveAllowedBidder) Reset()         { *m = MsgRemoveAllowedBidder{} }
+func (m *MsgRemoveAllowedBidder) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedBidder) ProtoMessage()    {}
+func (*MsgRemoveAllowedBidder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{26}
 }
-
-func (m *MsgCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAllowedBidder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedBidder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveAllowedBidder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedBidder.Merge(m, src)
 }
-
-func (m *MsgCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveAllowedBidder) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveAllowedBidder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedBidder.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveAllowedBidder proto.InternalMessageInfo
+
+// MsgRemoveAllowedBidderResponse defines the Msg/MsgRemoveAllowedBidderResponse
+// response type.
+type MsgRemoveAllowedBidderResponse struct {
 }
 
-func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRemoveAllowedBidderResponse) Reset()         { *m = MsgRemoveAllowedBidderResponse{} }
+func (m *MsgRemoveAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedBidderResponse) ProtoMessage()    {}
+func (*MsgRemoveAllowedBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{27}
+}
+func (m *MsgRemoveAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.BidType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BidType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveAllowedBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedBidderResponse.Merge(m, src)
 }
-
-func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveAllowedBidderResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveAllowedBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedBidderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgModifyBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRemoveAllowedBidderResponse proto.InternalMessageInfo
 
-func (m *MsgModifyBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAddAllowedBidder defines a SDK message for adding an allowed bidder to the
+// auction.
+type MsgAddAllowedBidder struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// allowed_bidder specifies the bidder who is allowed to bid and their maximum
+	// bid amount
+	AllowedBidder AllowedBidder `protobuf:"bytes,2,opt,name=allowed_bidder,json=allowedBidder,proto3" json:"allowed_bidder"`
 }
 
-func (m *MsgModifyBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAddAllowedBidder) Reset()         { *m = MsgAddAllowedBidder{} }
+func (m *MsgAddAllowedBidder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedBidder) ProtoMessage()    {}
+func (*MsgAddAllowedBidder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{28}
+}
+func (m *MsgAddAllowedBidder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedBidder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.BidId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgModifyBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddAllowedBidder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedBidder.Merge(m, src)
 }
-
-func (m *MsgModifyBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddAllowedBidder) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgModifyBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAddAllowedBidder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedBidder.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedBidder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgAddAllowedBidder proto.InternalMessageInfo
 
-func (m *MsgAddAllowedBidder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAddAllowedBidderResponse struct {
 }
 
-func (m *MsgAddAllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgAddAllowedBidderResponse) Reset()         { *m = MsgAddAllowedBidderResponse{} }
+func (m *MsgAddAllowedBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedBidderResponse) ProtoMessage()    {}
+func (*MsgAddAllowedBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{29}
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedBidderResponse.Merge(m, src)
+}
+func (m *MsgAddAllowedBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedBidderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgAddAllowedBidderResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a SDK message for updating the module parameters.
+type MsgUpdateParams struct {
+	// authority specifies the bech32-encoded address that controls the module
+	// (defaults to x/gov unless overwritten)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params specifies the module parameters to update.
+	// all parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgAddAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{30}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the Msg/MsgUpdateParamsResponse response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{31}
 }
-func (m *MsgCreateFixedPriceAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.StartPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SellingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.PayingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgResolveFailedAuction defines a SDK message for retrying or
+// force-refunding the failed auction.
+type MsgResolveFailedAuction struct {
+	// authority specifies the bech32-encoded address that controls the module
+	// (defaults to x/gov unless overwritten)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// auction_id specifies the id of the failed auction
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// force_refund specifies whether to return the reserved coins of the
+	// auction to their owners instead of retrying the failed execution
+	ForceRefund bool `protobuf:"varint,3,opt,name=force_refund,json=forceRefund,proto3" json:"force_refund,omitempty"`
+}
+
+func (m *MsgResolveFailedAuction) Reset()         { *m = MsgResolveFailedAuction{} }
+func (m *MsgResolveFailedAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveFailedAuction) ProtoMessage()    {}
+func (*MsgResolveFailedAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{32}
+}
+func (m *MsgResolveFailedAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveFailedAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveFailedAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.VestingSchedules) > 0 {
-		for _, e := range m.VestingSchedules {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgResolveFailedAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveFailedAuction.Merge(m, src)
+}
+func (m *MsgResolveFailedAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveFailedAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveFailedAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveFailedAuction proto.InternalMessageInfo
+
+// MsgResolveFailedAuctionResponse defines the Msg/MsgResolveFailedAuction
+// response type.
+type MsgResolveFailedAuctionResponse struct {
+}
+
+func (m *MsgResolveFailedAuctionResponse) Reset()         { *m = MsgResolveFailedAuctionResponse{} }
+func (m *MsgResolveFailedAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveFailedAuctionResponse) ProtoMessage()    {}
+func (*MsgResolveFailedAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f041af45fa02962b, []int{33}
+}
+func (m *MsgResolveFailedAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveFailedAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveFailedAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgResolveFailedAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveFailedAuctionResponse.Merge(m, src)
+}
+func (m *MsgResolveFailedAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveFailedAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveFailedAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFixedPriceAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgResolveFailedAuctionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFixedPriceAuction)(nil), "tendermint.fundraising.MsgCreateFixedPriceAuction")
+	proto.RegisterType((*MsgCreateFixedPriceAuctionResponse)(nil), "tendermint.fundraising.MsgCreateFixedPriceAuctionResponse")
+	proto.RegisterType((*MsgCreateBatchAuction)(nil), "tendermint.fundraising.MsgCreateBatchAuction")
+	proto.RegisterType((*MsgCreateBatchAuctionResponse)(nil), "tendermint.fundraising.MsgCreateBatchAuctionResponse")
+	proto.RegisterType((*MsgCreateDutchAuction)(nil), "tendermint.fundraising.MsgCreateDutchAuction")
+	proto.RegisterType((*MsgCreateDutchAuctionResponse)(nil), "tendermint.fundraising.MsgCreateDutchAuctionResponse")
+	proto.RegisterType((*MsgCancelAuction)(nil), "tendermint.fundraising.MsgCancelAuction")
+	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "tendermint.fundraising.MsgCancelAuctionResponse")
+	proto.RegisterType((*MsgPlaceBid)(nil), "tendermint.fundraising.MsgPlaceBid")
+	proto.RegisterType((*MsgPlaceBidResponse)(nil), "tendermint.fundraising.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgModifyBid)(nil), "tendermint.fundraising.MsgModifyBid")
+	proto.RegisterType((*MsgModifyBidResponse)(nil), "tendermint.fundraising.MsgModifyBidResponse")
+	proto.RegisterType((*MsgCancelBid)(nil), "tendermint.fundraising.MsgCancelBid")
+	proto.RegisterType((*MsgCancelBidResponse)(nil), "tendermint.fundraising.MsgCancelBidResponse")
+	proto.RegisterType((*MsgCommitBid)(nil), "tendermint.fundraising.MsgCommitBid")
+	proto.RegisterType((*MsgCommitBidResponse)(nil), "tendermint.fundraising.MsgCommitBidResponse")
+	proto.RegisterType((*MsgRevealBid)(nil), "tendermint.fundraising.MsgRevealBid")
+	proto.RegisterType((*MsgRevealBidResponse)(nil), "tendermint.fundraising.MsgRevealBidResponse")
+	proto.RegisterType((*MsgAddAllowedBidders)(nil), "tendermint.fundraising.MsgAddAllowedBidders")
+	proto.RegisterType((*MsgAddAllowedBiddersResponse)(nil), "tendermint.fundraising.MsgAddAllowedBiddersResponse")
+	proto.RegisterType((*MsgClaimVested)(nil), "tendermint.fundraising.MsgClaimVested")
+	proto.RegisterType((*MsgClaimVestedResponse)(nil), "tendermint.fundraising.MsgClaimVestedResponse")
+	proto.RegisterType((*MsgClaimAllocation)(nil), "tendermint.fundraising.MsgClaimAllocation")
+	proto.RegisterType((*MsgClaimAllocationResponse)(nil), "tendermint.fundraising.MsgClaimAllocationResponse")
+	proto.RegisterType((*MsgUpdateAllowedBidder)(nil), "tendermint.fundraising.MsgUpdateAllowedBidder")
+	proto.RegisterType((*MsgUpdateAllowedBidderResponse)(nil), "tendermint.fundraising.MsgUpdateAllowedBidderResponse")
+	proto.RegisterType((*MsgRemoveAllowedBidder)(nil), "tendermint.fundraising.MsgRemoveAllowedBidder")
+	proto.RegisterType((*MsgRemoveAllowedBidderResponse)(nil), "tendermint.fundraising.MsgRemoveAllowedBidderResponse")
+	proto.RegisterType((*MsgAddAllowedBidder)(nil), "tendermint.fundraising.MsgAddAllowedBidder")
+	proto.RegisterType((*MsgAddAllowedBidderResponse)(nil), "tendermint.fundraising.MsgAddAllowedBidderResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.fundraising.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.fundraising.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResolveFailedAuction)(nil), "tendermint.fundraising.MsgResolveFailedAuction")
+	proto.RegisterType((*MsgResolveFailedAuctionResponse)(nil), "tendermint.fundraising.MsgResolveFailedAuctionResponse")
+}
+
+func init() { proto.RegisterFile("fundraising/tx.proto", fileDescriptor_f041af45fa02962b) }
+
+var fileDescriptor_f041af45fa02962b = []byte{
+	// 2011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xc7, 0xe3, 0xaf, 0x37, 0xe3, 0xf1, 0xb8, 0xfc, 0xb1, 0x9d, 0x26, 0x1e, 0x3b, 0x1f,
+	0x6c, 0xac, 0x4d, 0x3c, 0xb3, 0xeb, 0xb0, 0x41, 0x8a, 0x90, 0xc0, 0x93, 0x21, 0x52, 0x24, 0xac,
+	0x98, 0x76, 0xf6, 0x43, 0x2b, 0xb4, 0xad, 0x9a, 0xae, 0xf2, 0xb8, 0x94, 0xfe, 0x18, 0xba, 0x7a,
+	0x1c, 0x1b, 0x69, 0x25, 0x8e, 0x8b, 0x04, 0x68, 0x8f, 0x70, 0xe3, 0x8c, 0xb8, 0x20, 0xf1, 0x0f,
+	0x70, 0x40, 0x5a, 0x89, 0xcb, 0xc2, 0x09, 0x71, 0xd8, 0x45, 0x89, 0xc4, 0x9d, 0xff, 0x00, 0x55,
+	0x75, 0x4d, 0x4f, 0xf7, 0x7c, 0xcf, 0xd8, 0x5e, 0x6b, 0x05, 0xa7, 0x74, 0x57, 0xfd, 0xea, 0xf7,
+	0x7b, 0xf5, 0xea, 0xd5, 0x7b, 0xaf, 0xc7, 0x81, 0xd5, 0xa3, 0xa6, 0x47, 0x02, 0xcc, 0x38, 0xf3,
+	0xea, 0xe5, 0xf0, 0xb4, 0xd4, 0x08, 0xfc, 0xd0, 0x47, 0xeb, 0x21, 0xf5, 0x08, 0x0d, 0x5c, 0xe6,
+	0x85, 0xa5, 0x04, 0xc0, 0x28, 0xda, 0x3e, 0x77, 0x7d, 0x5e, 0xae, 0x61, 0x4e, 0xcb, 0x27, 0xef,
+	0xd4, 0x68, 0x88, 0xdf, 0x29, 0xdb, 0x3e, 0xf3, 0xa2, 0x75, 0xc6, 0xf5, 0x68, 0xde, 0x92, 0x6f,
+	0xe5, 0xe8, 0x45, 0x4d, 0xad, 0xd6, 0xfd, 0xba, 0x1f, 0x8d, 0x8b, 0x27, 0x35, 0x5a, 0xac, 0xfb,
+	0x7e, 0xdd, 0xa1, 0x65, 0xf9, 0x56, 0x6b, 0x1e, 0x95, 0x49, 0x33, 0xc0, 0x21, 0xf3, 0x5b, 0x84,
+	0x9b, 0x9d, 0xf3, 0x21, 0x73, 0x29, 0x0f, 0xb1, 0xdb, 0x50, 0x80, 0x8d, 0xa4, 0xfd, 0x89, 0x67,
+	0x35, 0xad, 0x27, 0xa7, 0x1b, 0x38, 0xc0, 0xae, 0xb2, 0xe7, 0xd6, 0x5f, 0x00, 0x8c, 0x7d, 0x5e,
+	0x7f, 0x1c, 0x50, 0x1c, 0xd2, 0x27, 0xec, 0x94, 0x92, 0x83, 0x80, 0xd9, 0x74, 0xaf, 0x69, 0x0b,
+	0x79, 0x54, 0x04, 0xc0, 0xd1, 0x23, 0xa5, 0x81, 0xae, 0x6d, 0x69, 0xdb, 0x0b, 0x66, 0x62, 0x04,
+	0x3d, 0x83, 0x2c, 0x0f, 0x71, 0x10, 0x5a, 0x0d, 0xb1, 0x4a, 0xbf, 0x26, 0x00, 0x95, 0xd2, 0xe7,
+	0x5f, 0x6e, 0x4e, 0xfd, 0xf3, 0xcb, 0xcd, 0x37, 0xeb, 0x2c, 0x3c, 0x6e, 0xd6, 0x4a, 0xb6, 0xef,
+	0x2a, 0x27, 0xa8, 0x7f, 0x76, 0x38, 0x79, 0x51, 0x0e, 0xcf, 0x1a, 0x94, 0x97, 0xaa, 0xd4, 0x36,
+	0x41, 0x52, 0x48, 0x5d, 0xe4, 0x42, 0x8e, 0x53, 0xc7, 0x61, 0x5e, 0xdd, 0x12, 0x0e, 0xd5, 0xa7,
+	0xb7, 0xb4, 0xed, 0xec, 0xee, 0xf5, 0x92, 0x72, 0xa2, 0xf0, 0x78, 0x49, 0x79, 0xbc, 0xf4, 0xd8,
+	0x67, 0x5e, 0xa5, 0x2c, 0xc4, 0x7e, 0xff, 0xd5, 0xe6, 0xdd, 0x11, 0xc4, 0xc4, 0x02, 0x33, 0xab,
+	0xf8, 0xc5, 0x0b, 0x7a, 0x0b, 0x96, 0x1b, 0xf8, 0xac, 0xa5, 0x66, 0x11, 0xea, 0xf9, 0xae, 0x9e,
+	0x91, 0xdb, 0x5c, 0x8a, 0x26, 0x04, 0xac, 0x2a, 0x86, 0xd1, 0x47, 0xb0, 0x7c, 0x42, 0x79, 0x28,
+	0xc0, 0xdc, 0x3e, 0xa6, 0xa4, 0xe9, 0x50, 0xae, 0xcf, 0x6c, 0x4d, 0x6f, 0x67, 0x77, 0xef, 0x96,
+	0x7a, 0x47, 0x4a, 0xe9, 0xfd, 0x68, 0xc1, 0xa1, 0xc2, 0x57, 0x32, 0xc2, 0x5a, 0xb3, 0x70, 0x92,
+	0x1e, 0xe6, 0xe8, 0x31, 0x44, 0x4e, 0xb0, 0xc4, 0xc1, 0xea, 0xb3, 0x72, 0xd3, 0x46, 0x29, 0x3a,
+	0xf5, 0x52, 0xeb, 0xd4, 0x4b, 0xcf, 0x5b, 0xa7, 0x5e, 0x99, 0x17, 0x3c, 0x9f, 0x7d, 0xb5, 0xa9,
+	0x99, 0x0b, 0x72, 0x9d, 0x98, 0x41, 0xdf, 0x87, 0x79, 0xea, 0x91, 0x88, 0x62, 0x6e, 0x0c, 0x8a,
+	0x39, 0xea, 0x11, 0x49, 0xf0, 0x03, 0xb8, 0xd1, 0x3e, 0x5b, 0xcb, 0xc5, 0x1e, 0xae, 0x53, 0x62,
+	0x61, 0xc7, 0xf1, 0x5f, 0x3a, 0x8c, 0x87, 0xfa, 0xfc, 0x96, 0xb6, 0x3d, 0x6f, 0x1a, 0x6d, 0xcc,
+	0x7e, 0x04, 0xd9, 0x6b, 0x21, 0xd0, 0x4d, 0xc8, 0xf9, 0x0d, 0xea, 0x59, 0x35, 0x46, 0x08, 0xf3,
+	0xea, 0xfa, 0x82, 0x5c, 0x91, 0x15, 0x63, 0x95, 0x68, 0x08, 0xd9, 0xb0, 0x4e, 0xe8, 0x11, 0x6e,
+	0x3a, 0xa1, 0xe5, 0xe2, 0x53, 0x81, 0xb4, 0xb0, 0xeb, 0x37, 0xbd, 0x50, 0x87, 0xb1, 0xa3, 0xe7,
+	0xa9, 0x17, 0x9a, 0x2b, 0x8a, 0x6d, 0x1f, 0x9f, 0x56, 0x18, 0xd9, 0x93, 0x54, 0x28, 0x80, 0x7c,
+	0x2b, 0x8c, 0x6a, 0x98, 0xbf, 0xa0, 0xa1, 0x9e, 0xdd, 0x9a, 0x1e, 0x1c, 0x48, 0x6f, 0xab, 0x40,
+	0xda, 0x1e, 0x31, 0x90, 0xb8, 0xb9, 0xa8, 0x24, 0x2a, 0x52, 0x01, 0x7d, 0x92, 0x8e, 0xa5, 0x00,
+	0x87, 0x94, 0xeb, 0x39, 0x29, 0x7b, 0xa3, 0xa7, 0x6c, 0x95, 0xda, 0x52, 0xf9, 0x81, 0x52, 0xbe,
+	0x37, 0xda, 0x7d, 0x89, 0xc4, 0x13, 0xe1, 0x69, 0x0a, 0x25, 0xf4, 0x21, 0x14, 0x5c, 0x29, 0xcb,
+	0x38, 0x6d, 0x79, 0x74, 0x71, 0x22, 0x8f, 0xe6, 0x5d, 0xc1, 0xc9, 0x38, 0x55, 0xce, 0xdc, 0x81,
+	0x15, 0xdb, 0xf1, 0x39, 0xb5, 0x5e, 0x1e, 0x53, 0xcf, 0xe2, 0xbe, 0x43, 0x2c, 0xbf, 0x19, 0xea,
+	0x79, 0x79, 0xb6, 0x05, 0x39, 0xf5, 0xc1, 0x31, 0xf5, 0x0e, 0x7d, 0x87, 0x3c, 0x6b, 0x86, 0xa8,
+	0x0e, 0xba, 0x38, 0x7e, 0x1a, 0x58, 0xdd, 0xd7, 0x65, 0x69, 0x92, 0xeb, 0xb2, 0x1e, 0xd1, 0xbd,
+	0xdf, 0x79, 0x69, 0x28, 0xbc, 0xe1, 0x30, 0x8f, 0xe2, 0x6e, 0x21, 0xbd, 0x20, 0xc3, 0x7f, 0xa7,
+	0x9f, 0xce, 0x8f, 0xe4, 0xb2, 0x0e, 0x42, 0x73, 0xcd, 0xe9, 0x35, 0x8c, 0x36, 0x00, 0x6c, 0x07,
+	0x33, 0xd7, 0x72, 0x7d, 0x42, 0xf5, 0x65, 0xb9, 0xeb, 0x05, 0x39, 0xb2, 0xef, 0x13, 0xfa, 0x28,
+	0xf3, 0xe9, 0xef, 0x36, 0xa7, 0x6e, 0xdd, 0x81, 0x5b, 0xfd, 0xd3, 0xa8, 0x49, 0x79, 0xc3, 0xf7,
+	0x38, 0xbd, 0xf5, 0x9f, 0x1c, 0xac, 0xc5, 0xb0, 0x0a, 0x0e, 0xed, 0xe3, 0x2b, 0x4b, 0xb4, 0x26,
+	0x2c, 0x8a, 0x70, 0x11, 0xd7, 0x2f, 0xa2, 0x9c, 0x9e, 0x88, 0x32, 0xeb, 0x32, 0x71, 0xb3, 0x7b,
+	0x27, 0xef, 0xcc, 0x15, 0x24, 0xef, 0x99, 0x31, 0x92, 0xf7, 0xec, 0xc5, 0x24, 0xef, 0xfb, 0x80,
+	0x44, 0x26, 0xa3, 0xa7, 0x92, 0x87, 0x58, 0x81, 0xdf, 0xf4, 0x88, 0xcc, 0xc0, 0x8b, 0x66, 0xc1,
+	0xc5, 0xa7, 0x3f, 0x54, 0x13, 0xa6, 0x18, 0x47, 0x1f, 0xc3, 0x4a, 0x1a, 0x29, 0x33, 0x85, 0x3e,
+	0x3f, 0x91, 0xfb, 0x97, 0x69, 0x92, 0x5b, 0x24, 0x82, 0x8e, 0x52, 0xb2, 0x70, 0xfe, 0x52, 0x02,
+	0x97, 0x51, 0x4a, 0xb2, 0x63, 0x97, 0x92, 0xdc, 0x38, 0xa5, 0x64, 0xf1, 0xe2, 0x4a, 0x49, 0xcf,
+	0xb4, 0x9e, 0xbf, 0xd2, 0xb4, 0xbe, 0x74, 0x21, 0x69, 0x7d, 0x50, 0x9e, 0x2e, 0x7c, 0x4d, 0x79,
+	0x7a, 0xf9, 0xd2, 0xf2, 0x34, 0xea, 0xc8, 0xd3, 0xe8, 0x10, 0x96, 0x39, 0xc5, 0x0e, 0x25, 0x32,
+	0x4e, 0x6c, 0xdf, 0x3b, 0x62, 0x75, 0x7d, 0x65, 0x4b, 0x1b, 0xb4, 0xcf, 0x43, 0xb9, 0xa0, 0xc2,
+	0xc8, 0x63, 0x09, 0x37, 0x97, 0x78, 0x7a, 0x40, 0x90, 0x36, 0x70, 0x10, 0x32, 0xec, 0x58, 0x47,
+	0xcc, 0x71, 0x22, 0xe9, 0xd5, 0x2d, 0x6d, 0x3b, 0xdf, 0x9f, 0xf4, 0x20, 0x5a, 0xf0, 0x84, 0x39,
+	0x8e, 0x30, 0x4c, 0x1c, 0x79, 0x6a, 0x00, 0x3d, 0x81, 0x9c, 0x48, 0xc9, 0xc2, 0x51, 0x81, 0x70,
+	0xd2, 0x9a, 0xe4, 0xbb, 0xdd, 0x97, 0x2f, 0xc2, 0x9a, 0xc2, 0x35, 0xd9, 0x46, 0xfb, 0x45, 0x55,
+	0xa6, 0x4d, 0xd8, 0xe8, 0x59, 0x72, 0xe2, 0xa2, 0xf4, 0x67, 0x48, 0x14, 0xa5, 0x6a, 0xf3, 0x2a,
+	0x8b, 0xd2, 0x33, 0xc8, 0x1e, 0x39, 0xbe, 0x1f, 0x9c, 0xab, 0x24, 0x81, 0xa4, 0x88, 0x08, 0x3f,
+	0x84, 0x82, 0xa4, 0xb2, 0x08, 0xb5, 0xf1, 0x99, 0xc5, 0x43, 0xda, 0xd0, 0x33, 0x13, 0xb1, 0xe6,
+	0x25, 0x4f, 0x55, 0xd0, 0x1c, 0x86, 0xb4, 0x81, 0x7e, 0x0c, 0x28, 0xc9, 0xdc, 0xa0, 0x01, 0xf3,
+	0x89, 0x3e, 0xa3, 0x2a, 0x5e, 0x67, 0xae, 0xac, 0xaa, 0xef, 0xb9, 0x28, 0x55, 0xfe, 0x46, 0xa4,
+	0xca, 0x42, 0x9b, 0xf0, 0x40, 0x2e, 0xee, 0x2a, 0x9f, 0xb3, 0x57, 0x50, 0x3e, 0xe7, 0xc6, 0x28,
+	0x9f, 0xf3, 0x97, 0xf1, 0xed, 0xf3, 0xff, 0x82, 0xf5, 0x0d, 0x2f, 0x58, 0xff, 0x93, 0xed, 0x7f,
+	0x32, 0xc9, 0x56, 0x9b, 0x3d, 0x92, 0xec, 0x07, 0x50, 0x10, 0x00, 0xec, 0xd9, 0xd4, 0x19, 0x35,
+	0xbd, 0x6e, 0xc4, 0xf3, 0x16, 0x23, 0x32, 0xbb, 0x66, 0xcc, 0x05, 0x35, 0xf2, 0x94, 0x28, 0x65,
+	0x03, 0xf4, 0x4e, 0xe2, 0x58, 0xf4, 0x0f, 0xd7, 0x20, 0xbb, 0xcf, 0xeb, 0x07, 0x0e, 0xb6, 0x69,
+	0x85, 0x91, 0x0e, 0x42, 0xad, 0x83, 0x10, 0xad, 0xc3, 0x6c, 0xe4, 0xea, 0x28, 0x93, 0x9b, 0xea,
+	0x0d, 0x3d, 0x82, 0x79, 0x11, 0xa9, 0xe2, 0xe0, 0x65, 0x4a, 0xce, 0xef, 0x6e, 0xf6, 0xf3, 0x6c,
+	0x85, 0x91, 0xe7, 0x67, 0x0d, 0x6a, 0xce, 0xd5, 0xa2, 0x07, 0x54, 0x85, 0x99, 0x28, 0x97, 0x4f,
+	0x96, 0x75, 0xa3, 0xc5, 0xe8, 0x63, 0xc8, 0xc8, 0x8c, 0x38, 0x73, 0xe1, 0x19, 0x51, 0xf2, 0x2a,
+	0x57, 0xae, 0xc1, 0x4a, 0xc2, 0x5b, 0xb1, 0x17, 0x3f, 0xbd, 0x06, 0xb9, 0x7d, 0x5e, 0xdf, 0xf7,
+	0x09, 0x3b, 0x3a, 0x3b, 0x87, 0x1b, 0xd7, 0xe4, 0xb8, 0x58, 0x32, 0x2d, 0x97, 0xcc, 0xd4, 0x18,
+	0x79, 0x4a, 0xbe, 0x51, 0x1e, 0x5a, 0x87, 0xd5, 0xa4, 0x27, 0x62, 0x17, 0xd5, 0x20, 0x17, 0x07,
+	0xe1, 0x85, 0x7b, 0x28, 0xa5, 0x1d, 0x6b, 0xc4, 0xda, 0x7f, 0xd3, 0x22, 0x71, 0xdf, 0x75, 0x59,
+	0x78, 0x0e, 0xf1, 0x22, 0x80, 0x2d, 0x39, 0x5c, 0xea, 0x85, 0xd2, 0x80, 0x9c, 0x99, 0x18, 0x41,
+	0x04, 0xe6, 0x08, 0x6d, 0xf8, 0x9c, 0x85, 0x97, 0xf0, 0x5d, 0xdb, 0xa2, 0x4e, 0xef, 0xb5, 0xb5,
+	0xa5, 0x78, 0xaf, 0xff, 0x8e, 0x42, 0xd1, 0xa4, 0x27, 0x14, 0x9f, 0xc7, 0xd1, 0xb7, 0x61, 0xb1,
+	0xbd, 0xb3, 0xb6, 0xbf, 0x73, 0xed, 0xc1, 0xa7, 0x24, 0x75, 0xed, 0x33, 0x93, 0x5e, 0xfb, 0x99,
+	0x8b, 0x08, 0xea, 0xd9, 0xcb, 0x09, 0x6a, 0x84, 0x20, 0xc3, 0xb1, 0x13, 0xaa, 0xa6, 0x47, 0x3e,
+	0xa7, 0x0e, 0x20, 0xf6, 0x73, 0x7c, 0x00, 0x7f, 0xd4, 0xe4, 0xc4, 0x1e, 0x89, 0xea, 0xbe, 0xfc,
+	0x14, 0x20, 0x34, 0xe0, 0xc3, 0x0e, 0x22, 0x9d, 0xea, 0xaf, 0x75, 0xa5, 0xfa, 0xe7, 0xb0, 0x84,
+	0x23, 0x42, 0x2b, 0x3a, 0x22, 0xae, 0x4f, 0xcb, 0x5a, 0xf9, 0xed, 0x7e, 0x2e, 0x4f, 0xe9, 0xab,
+	0x4a, 0x99, 0xc7, 0x29, 0xa3, 0xd4, 0x5e, 0x8a, 0x70, 0xa3, 0x97, 0xc9, 0xf1, 0x9e, 0xde, 0x83,
+	0xbc, 0x08, 0x36, 0x51, 0xd1, 0x44, 0xed, 0xa3, 0xe4, 0x62, 0x0a, 0x93, 0x0e, 0xeb, 0x69, 0xda,
+	0x58, 0x90, 0x01, 0x6a, 0xcd, 0x08, 0x93, 0x6c, 0xd9, 0x19, 0x8b, 0x58, 0xe5, 0x72, 0xab, 0x4a,
+	0x50, 0xbd, 0x0d, 0x11, 0x4b, 0x84, 0xf8, 0x74, 0x32, 0xc4, 0x95, 0x11, 0x37, 0xc0, 0xe8, 0x96,
+	0x8a, 0x0d, 0xf9, 0xab, 0x26, 0x6d, 0x7c, 0xaf, 0x41, 0x70, 0x48, 0x53, 0xde, 0x39, 0xef, 0x79,
+	0xf6, 0xb1, 0x0a, 0x3d, 0x87, 0x7c, 0x47, 0xe3, 0x97, 0x99, 0xa8, 0xf1, 0xcb, 0xb9, 0x89, 0x8e,
+	0x4f, 0xed, 0x75, 0x0b, 0x8a, 0xbd, 0x37, 0x13, 0xef, 0xb7, 0x29, 0xb7, 0x6b, 0x52, 0xd7, 0x3f,
+	0xf9, 0x5a, 0xb6, 0x9b, 0x32, 0xac, 0x87, 0x6c, 0x6c, 0xd8, 0xaf, 0x35, 0x58, 0xe9, 0x11, 0xa3,
+	0xc3, 0xcc, 0x32, 0x21, 0x9f, 0xbe, 0x35, 0xd2, 0xb4, 0x31, 0x2f, 0xcd, 0x62, 0xea, 0xd2, 0x28,
+	0x93, 0x37, 0xe0, 0x5b, 0x3d, 0xec, 0x89, 0xed, 0xfd, 0x95, 0x06, 0x4b, 0xb1, 0xaf, 0x0f, 0xe4,
+	0xdf, 0xd3, 0xd0, 0x43, 0x58, 0xc0, 0xcd, 0xf0, 0xd8, 0x0f, 0x58, 0x78, 0x16, 0x85, 0x70, 0x45,
+	0xff, 0xfb, 0x9f, 0x76, 0x56, 0x55, 0xd2, 0xda, 0x23, 0x24, 0xa0, 0x9c, 0x1f, 0x86, 0x81, 0xf8,
+	0x3c, 0x6f, 0x43, 0xd1, 0xf7, 0x60, 0x36, 0xfa, 0x8b, 0x9c, 0x32, 0xbe, 0x38, 0xe0, 0x77, 0x03,
+	0xec, 0x72, 0x65, 0xb5, 0x5a, 0xa3, 0xcc, 0xbd, 0x0e, 0x6f, 0x74, 0x98, 0x13, 0x9b, 0xfa, 0x5b,
+	0x4d, 0xce, 0x99, 0x94, 0xfb, 0xce, 0x09, 0x7d, 0x82, 0x99, 0x43, 0x49, 0xab, 0x01, 0x9d, 0xd4,
+	0xe4, 0x21, 0x57, 0xf2, 0x26, 0xe4, 0x8e, 0xfc, 0xc0, 0xa6, 0x56, 0x40, 0x85, 0xfd, 0x32, 0x26,
+	0xe6, 0xcd, 0xac, 0x1c, 0x33, 0xe5, 0x90, 0x32, 0xfb, 0x26, 0x6c, 0xf6, 0x31, 0xad, 0x65, 0xfe,
+	0xee, 0x2f, 0x97, 0x60, 0x7a, 0x9f, 0xd7, 0xd1, 0x2f, 0x34, 0x78, 0xa3, 0xdf, 0x1f, 0x29, 0x77,
+	0xfb, 0x79, 0xac, 0xff, 0x2f, 0xf2, 0xc6, 0xa3, 0xf1, 0xd7, 0xb4, 0x6c, 0x42, 0x3f, 0x03, 0xd4,
+	0xe3, 0x17, 0xfc, 0x9d, 0xa1, 0x8c, 0x49, 0xb8, 0xf1, 0xee, 0x58, 0xf0, 0x6e, 0xed, 0x6a, 0x73,
+	0x2c, 0xed, 0x6a, 0x73, 0x2c, 0xed, 0x5e, 0xdf, 0x30, 0xe8, 0x05, 0x2c, 0xa6, 0x3f, 0x60, 0xb6,
+	0x07, 0xf1, 0x24, 0x91, 0xc6, 0xdb, 0xa3, 0x22, 0x63, 0xb1, 0x9f, 0xc0, 0x7c, 0xfc, 0xdd, 0x72,
+	0x7b, 0xc0, 0xea, 0x16, 0xc8, 0xb8, 0x37, 0x02, 0x28, 0x66, 0xb7, 0x60, 0xa1, 0xdd, 0xcf, 0xdf,
+	0x19, 0xb0, 0x32, 0x46, 0x19, 0xf7, 0x47, 0x41, 0x25, 0x05, 0xda, 0xed, 0xf0, 0x9d, 0xa1, 0xbb,
+	0x1f, 0x26, 0xd0, 0xd5, 0xf6, 0x4a, 0x81, 0xb8, 0xe5, 0x1d, 0x28, 0xd0, 0x42, 0x19, 0xf7, 0x47,
+	0x41, 0x25, 0x05, 0xda, 0x7d, 0xe6, 0x20, 0x81, 0x18, 0x65, 0xdc, 0x1f, 0x05, 0x15, 0x0b, 0x1c,
+	0x43, 0x2e, 0x95, 0x40, 0xef, 0x0e, 0x58, 0x9d, 0x04, 0x1a, 0xe5, 0x11, 0x81, 0xb1, 0xd2, 0xcf,
+	0x35, 0x58, 0xed, 0x99, 0x00, 0xcb, 0x03, 0x0d, 0xee, 0x5e, 0x60, 0x7c, 0x77, 0xcc, 0x05, 0xb1,
+	0x09, 0x2f, 0x61, 0xb9, 0xbb, 0x69, 0x1c, 0xe4, 0xaf, 0x2e, 0xb4, 0xf1, 0x9d, 0x71, 0xd0, 0xb1,
+	0x30, 0x85, 0x6c, 0xb2, 0xb5, 0x7b, 0x73, 0x50, 0x0c, 0xb4, 0x71, 0x46, 0x69, 0x34, 0x5c, 0x2c,
+	0xf3, 0x53, 0x58, 0xea, 0x6c, 0xe8, 0xde, 0x1a, 0x46, 0xd1, 0xc6, 0x1a, 0xbb, 0xa3, 0x63, 0x63,
+	0xc9, 0x4f, 0x60, 0xa5, 0x57, 0xe7, 0x56, 0x1a, 0x1a, 0x1d, 0x29, 0xbc, 0xf1, 0x70, 0x3c, 0x7c,
+	0x52, 0xbe, 0x57, 0x27, 0x55, 0x1a, 0x18, 0x21, 0x5d, 0x78, 0xe3, 0xe1, 0x78, 0xf8, 0x58, 0x3e,
+	0x84, 0x42, 0x57, 0xbb, 0x74, 0x6f, 0x8c, 0x08, 0x31, 0x1e, 0x8c, 0x01, 0x6e, 0xa9, 0x56, 0x9e,
+	0x7d, 0xfe, 0xaa, 0xa8, 0x7d, 0xf1, 0xaa, 0xa8, 0xfd, 0xeb, 0x55, 0x51, 0xfb, 0xec, 0x75, 0x71,
+	0xea, 0x8b, 0xd7, 0xc5, 0xa9, 0x7f, 0xbc, 0x2e, 0x4e, 0x7d, 0xf4, 0x6e, 0xa2, 0x73, 0x6d, 0x13,
+	0x27, 0xff, 0x2f, 0x52, 0xf9, 0x34, 0xf5, 0x26, 0x9b, 0xd9, 0xda, 0xac, 0xfc, 0x75, 0xf6, 0xc1,
+	0x7f, 0x07, 0x00, 0x3f, 0xea, 0x5d, 0xd3, 0x81, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// this line is used by Starport scaffolding # proto/tx/rpc
+	// Submit a create fixed price auction message.
+	CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error)
+	// Submit a create batch auction message.
+	CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error)
+	// Submit a create dutch auction message.
+	CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error)
+	// CancelAuction defines a method to cancel the auction message.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	// PlaceBid defines a method to place a bid message.
+	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// ModifyBid defines a method to modify the bid message.
+	ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error)
+	// CancelBid defines a method to cancel the bid message.
+	CancelBid(ctx context.Context, in *MsgCancelBid, opts ...grpc.CallOption) (*MsgCancelBidResponse, error)
+	// CommitBid defines a method for committing a sealed bid with a collateral
+	// deposit for the batch auction in sealed bid mode.
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method for revealing the committed bid in the reveal
+	// window of the batch auction in sealed bid mode.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	// UpdateParams defines a governance operation for updating the module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResolveFailedAuction defines a governance operation for retrying or
+	// force-refunding the failed auction. The authority is defined in the keeper.
+	ResolveFailedAuction(ctx context.Context, in *MsgResolveFailedAuction, opts ...grpc.CallOption) (*MsgResolveFailedAuctionResponse, error)
+	// AddAllowedBidders defines a method for the auctioneer to add allowed
+	// bidders to the auction.
+	AddAllowedBidders(ctx context.Context, in *MsgAddAllowedBidders, opts ...grpc.CallOption) (*MsgAddAllowedBiddersResponse, error)
+	// ClaimVested defines a method for the auctioneer to claim the paying coin
+	// vested so far by the linear vesting schedule of the auction.
+	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// ClaimAllocation defines a method for claiming the allocated selling coin
+	// and the refunded paying coin of a bidder for the auction in claim mode.
+	ClaimAllocation(ctx context.Context, in *MsgClaimAllocation, opts ...grpc.CallOption) (*MsgClaimAllocationResponse, error)
+	// UpdateAllowedBidder defines a method for the auctioneer to update the
+	// maximum bid amount of the allowed bidder.
+	UpdateAllowedBidder(ctx context.Context, in *MsgUpdateAllowedBidder, opts ...grpc.CallOption) (*MsgUpdateAllowedBidderResponse, error)
+	// RemoveAllowedBidder defines a method for the auctioneer to remove the
+	// allowed bidder from the auction.
+	RemoveAllowedBidder(ctx context.Context, in *MsgRemoveAllowedBidder, opts ...grpc.CallOption) (*MsgRemoveAllowedBidderResponse, error)
+	// AddAllowedBidder defines a method sto add a single allowed bidder message.
+	// This is for the testing purpose and it must not be used in mainnet.
+	AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateFixedPriceAuction(ctx context.Context, in *MsgCreateFixedPriceAuction, opts ...grpc.CallOption) (*MsgCreateFixedPriceAuctionResponse, error) {
+	out := new(MsgCreateFixedPriceAuctionResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CreateFixedPriceAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateBatchAuction) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateBatchAuction(ctx context.Context, in *MsgCreateBatchAuction, opts ...grpc.CallOption) (*MsgCreateBatchAuctionResponse, error) {
+	out := new(MsgCreateBatchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CreateBatchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error) {
+	out := new(MsgCreateDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CreateDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.StartPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinBidPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.SellingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.PayingCoinDenom)
+	return out, nil
+}
+
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
+	out := new(MsgPlaceBidResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ModifyBid(ctx context.Context, in *MsgModifyBid, opts ...grpc.CallOption) (*MsgModifyBidResponse, error) {
+	out := new(MsgModifyBidResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/ModifyBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBid(ctx context.Context, in *MsgCancelBid, opts ...grpc.CallOption) (*MsgCancelBidResponse, error) {
+	out := new(MsgCancelBidResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CancelBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/CommitBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/RevealBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveFailedAuction(ctx context.Context, in *MsgResolveFailedAuction, opts ...grpc.CallOption) (*MsgResolveFailedAuctionResponse, error) {
+	out := new(MsgResolveFailedAuctionResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/ResolveFailedAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedBidders(ctx context.Context, in *MsgAddAllowedBidders, opts ...grpc.CallOption) (*MsgAddAllowedBiddersResponse, error) {
+	out := new(MsgAddAllowedBiddersResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/AddAllowedBidders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error) {
+	out := new(MsgClaimVestedResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/ClaimVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAllocation(ctx context.Context, in *MsgClaimAllocation, opts ...grpc.CallOption) (*MsgClaimAllocationResponse, error) {
+	out := new(MsgClaimAllocationResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/ClaimAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAllowedBidder(ctx context.Context, in *MsgUpdateAllowedBidder, opts ...grpc.CallOption) (*MsgUpdateAllowedBidderResponse, error) {
+	out := new(MsgUpdateAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/UpdateAllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedBidder(ctx context.Context, in *MsgRemoveAllowedBidder, opts ...grpc.CallOption) (*MsgRemoveAllowedBidderResponse, error) {
+	out := new(MsgRemoveAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/RemoveAllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedBidder(ctx context.Context, in *MsgAddAllowedBidder, opts ...grpc.CallOption) (*MsgAddAllowedBidderResponse, error) {
+	out := new(MsgAddAllowedBidderResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.fundraising.Msg/AddAllowedBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// this line is used by Starport scaffolding # proto/tx/rpc
+	// Submit a create fixed price auction message.
+	CreateFixedPriceAuction(context.Context, *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error)
+	// Submit a create batch auction message.
+	CreateBatchAuction(context.Context, *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error)
+	// Submit a create dutch auction message.
+	CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error)
+	// CancelAuction defines a method to cancel the auction message.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	// PlaceBid defines a method to place a bid message.
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// ModifyBid defines a method to modify the bid message.
+	ModifyBid(context.Context, *MsgModifyBid) (*MsgModifyBidResponse, error)
+	// CancelBid defines a method to cancel the bid message.
+	CancelBid(context.Context, *MsgCancelBid) (*MsgCancelBidResponse, error)
+	// CommitBid defines a method for committing a sealed bid with a collateral
+	// deposit for the batch auction in sealed bid mode.
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	// RevealBid defines a method for revealing the committed bid in the reveal
+	// window of the batch auction in sealed bid mode.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	// UpdateParams defines a governance operation for updating the module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResolveFailedAuction defines a governance operation for retrying or
+	// force-refunding the failed auction. The authority is defined in the keeper.
+	ResolveFailedAuction(context.Context, *MsgResolveFailedAuction) (*MsgResolveFailedAuctionResponse, error)
+	// AddAllowedBidders defines a method for the auctioneer to add allowed
+	// bidders to the auction.
+	AddAllowedBidders(context.Context, *MsgAddAllowedBidders) (*MsgAddAllowedBiddersResponse, error)
+	// ClaimVested defines a method for the auctioneer to claim the paying coin
+	// vested so far by the linear vesting schedule of the auction.
+	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// ClaimAllocation defines a method for claiming the allocated selling coin
+	// and the refunded paying coin of a bidder for the auction in claim mode.
+	ClaimAllocation(context.Context, *MsgClaimAllocation) (*MsgClaimAllocationResponse, error)
+	// UpdateAllowedBidder defines a method for the auctioneer to update the
+	// maximum bid amount of the allowed bidder.
+	UpdateAllowedBidder(context.Context, *MsgUpdateAllowedBidder) (*MsgUpdateAllowedBidderResponse, error)
+	// RemoveAllowedBidder defines a method for the auctioneer to remove the
+	// allowed bidder from the auction.
+	RemoveAllowedBidder(context.Context, *MsgRemoveAllowedBidder) (*MsgRemoveAllowedBidderResponse, error)
+	// AddAllowedBidder defines a method sto add a single allowed bidder message.
+	// This is for the testing purpose and it must not be used in mainnet.
+	AddAllowedBidder(context.Context, *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateFixedPriceAuction(ctx context.Context, req *MsgCreateFixedPriceAuction) (*MsgCreateFixedPriceAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedPriceAuction not implemented")
+}
+func (*UnimplementedMsgServer) CreateBatchAuction(ctx context.Context, req *MsgCreateBatchAuction) (*MsgCreateBatchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatchAuction not implemented")
+}
+func (*UnimplementedMsgServer) CreateDutchAuction(ctx context.Context, req *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDutchAuction not implemented")
+}
+func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (*UnimplementedMsgServer) ModifyBid(ctx context.Context, req *MsgModifyBid) (*MsgModifyBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBid not implemented")
+}
+func (*UnimplementedMsgServer) CancelBid(ctx context.Context, req *MsgCancelBid) (*MsgCancelBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBid not implemented")
+}
+func (*UnimplementedMsgServer) CommitBid(ctx context.Context, req *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (*UnimplementedMsgServer) RevealBid(ctx context.Context, req *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResolveFailedAuction(ctx context.Context, req *MsgResolveFailedAuction) (*MsgResolveFailedAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFailedAuction not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowedBidders(ctx context.Context, req *MsgAddAllowedBidders) (*MsgAddAllowedBiddersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBidders not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAllocation(ctx context.Context, req *MsgClaimAllocation) (*MsgClaimAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllocation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAllowedBidder(ctx context.Context, req *MsgUpdateAllowedBidder) (*MsgUpdateAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowedBidder not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowedBidder(ctx context.Context, req *MsgRemoveAllowedBidder) (*MsgRemoveAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedBidder not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowedBidder(ctx context.Context, req *MsgAddAllowedBidder) (*MsgAddAllowedBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBidder not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateFixedPriceAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFixedPriceAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFixedPriceAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/CreateFixedPriceAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFixedPriceAuction(ctx, req.(*MsgCreateFixedPriceAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBatchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBatchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBatchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/CreateBatchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBatchAuction(ctx, req.(*MsgCreateBatchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/CreateDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDutchAuction(ctx, req.(*MsgCreateDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/CancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/PlaceBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/ModifyBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyBid(ctx, req.(*MsgModifyBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/CancelBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBid(ctx, req.(*MsgCancelBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/CommitBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveFailedAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveFailedAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveFailedAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/ResolveFailedAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveFailedAuction(ctx, req.(*MsgResolveFailedAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedBidders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedBidders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedBidders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/AddAllowedBidders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedBidders(ctx, req.(*MsgAddAllowedBidders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/ClaimVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVested(ctx, req.(*MsgClaimVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/ClaimAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllocation(ctx, req.(*MsgClaimAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowedBidder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/UpdateAllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowedBidder(ctx, req.(*MsgUpdateAllowedBidder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedBidder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/RemoveAllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedBidder(ctx, req.(*MsgRemoveAllowedBidder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedBidder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.fundraising.Msg/AddAllowedBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedBidder(ctx, req.(*MsgAddAllowedBidder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.fundraising.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFixedPriceAuction",
+			Handler:    _Msg_CreateFixedPriceAuction_Handler,
+		},
+		{
+			MethodName: "CreateBatchAuction",
+			Handler:    _Msg_CreateBatchAuction_Handler,
+		},
+		{
+			MethodName: "CreateDutchAuction",
+			Handler:    _Msg_CreateDutchAuction_Handler,
+		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _Msg_PlaceBid_Handler,
+		},
+		{
+			MethodName: "ModifyBid",
+			Handler:    _Msg_ModifyBid_Handler,
+		},
+		{
+			MethodName: "CancelBid",
+			Handler:    _Msg_CancelBid_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResolveFailedAuction",
+			Handler:    _Msg_ResolveFailedAuction_Handler,
+		},
+		{
+			MethodName: "AddAllowedBidders",
+			Handler:    _Msg_AddAllowedBidders_Handler,
+		},
+		{
+			MethodName: "ClaimVested",
+			Handler:    _Msg_ClaimVested_Handler,
+		},
+		{
+			MethodName: "ClaimAllocation",
+			Handler:    _Msg_ClaimAllocation_Handler,
+		},
+		{
+			MethodName: "UpdateAllowedBidder",
+			Handler:    _Msg_UpdateAllowedBidder_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedBidder",
+			Handler:    _Msg_RemoveAllowedBidder_Handler,
+		},
+		{
+			MethodName: "AddAllowedBidder",
+			Handler:    _Msg_AddAllowedBidder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fundraising/tx.proto",
+}
+
+func (m *MsgCreateFixedPriceAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedPriceAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedPriceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimMode {
+		i--
+		if m.ClaimMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LinearVestingSchedule != nil {
+		{
+			size, err := m.LinearVestingSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.BidderVestingSchedules) > 0 {
+		for iNdEx := len(m.BidderVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.CloseWhenSoldOut {
+		i--
+		if m.CloseWhenSoldOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MinRaiseAmount.Size()
+		i -= size
+		if _, err := m.MinRaiseAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.PayingCoinRates) > 0 {
+		for iNdEx := len(m.PayingCoinRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayingCoinRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SellingBasket) > 0 {
+		for iNdEx := len(m.SellingBasket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellingBasket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.DefaultMaxBidAmount.Size()
+		i -= size
+		if _, err := m.DefaultMaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.OpenBidding {
+		i--
+		if m.OpenBidding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AuctioneerManagedAllowlist {
+		i--
+		if m.AuctioneerManagedAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PricingRule != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.PartialFillMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PartialFillMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.SealedBidConfig != nil {
+		{
+			size, err := m.SealedBidConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ClaimMode {
+		i--
+		if m.ClaimMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LinearVestingSchedule != nil {
+		{
+			size, err := m.LinearVestingSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.BidderVestingSchedules) > 0 {
+		for iNdEx := len(m.BidderVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.MinRaiseAmount.Size()
+		i -= size
+		if _, err := m.MinRaiseAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.PayingCoinRates) > 0 {
+		for iNdEx := len(m.PayingCoinRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayingCoinRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.DefaultMaxBidAmount.Size()
+		i -= size
+		if _, err := m.DefaultMaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.OpenBidding {
+		i--
+		if m.OpenBidding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AuctioneerManagedAllowlist {
+		i--
+		if m.AuctioneerManagedAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x52
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ExtendedRoundRate.Size()
+		i -= size
+		if _, err := m.ExtendedRoundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxExtendedRound != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxExtendedRound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBidPrice.Size()
+		i -= size
+		if _, err := m.MinBidPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBatchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBatchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBatchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimMode {
+		i--
+		if m.ClaimMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LinearVestingSchedule != nil {
+		{
+			size, err := m.LinearVestingSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.BidderVestingSchedules) > 0 {
+		for iNdEx := len(m.BidderVestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderVestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PayingCoinRates) > 0 {
+		for iNdEx := len(m.PayingCoinRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayingCoinRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.DefaultMaxBidAmount.Size()
+		i -= size
+		if _, err := m.DefaultMaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.OpenBidding {
+		i--
+		if m.OpenBidding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AuctioneerManagedAllowlist {
+		i--
+		if m.AuctioneerManagedAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x52
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PayingCoinDenom) > 0 {
+		i -= len(m.PayingCoinDenom)
+		copy(dAtA[i:], m.PayingCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayingCoinDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.SellingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PriceDecayPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceDecayPeriod):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PriceDecayStep.Size()
+		i -= size
+		if _, err := m.PriceDecayStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FloorPrice.Size()
+		i -= size
+		if _, err := m.FloorPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BidType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BidType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitmentId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedBidders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedBidders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedBidders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedBidders) > 0 {
+		for iNdEx := len(m.AllowedBidders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedBidders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedBiddersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedBiddersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedBiddersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedBidder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedBidder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBidAmount.Size()
+		i -= size
+		if _, err := m.MaxBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedBidder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedBidder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Auctioneer) > 0 {
+		i -= len(m.Auctioneer)
+		copy(dAtA[i:], m.Auctioneer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Auctioneer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedBidder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedBidder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowedBidder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveFailedAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveFailedAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveFailedAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForceRefund {
+		i--
+		if m.ForceRefund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveFailedAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveFailedAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveFailedAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFixedPriceAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.AuctioneerManagedAllowlist {
+		n += 2
+	}
+	if m.OpenBidding {
+		n += 2
+	}
+	l = m.DefaultMaxBidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SellingBasket) > 0 {
+		for _, e := range m.SellingBasket {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PayingCoinRates) > 0 {
+		for _, e := range m.PayingCoinRates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinRaiseAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CloseWhenSoldOut {
+		n += 2
+	}
+	if len(m.BidderVestingSchedules) > 0 {
+		for _, e := range m.BidderVestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LinearVestingSchedule != nil {
+		l = m.LinearVestingSchedule.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.ClaimMode {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgCreateFixedPriceAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinBidPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxExtendedRound != 0 {
+		n += 1 + sovTx(uint64(m.MaxExtendedRound))
+	}
+	l = m.ExtendedRoundRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.AuctioneerManagedAllowlist {
+		n += 2
+	}
+	if m.OpenBidding {
+		n += 2
+	}
+	l = m.DefaultMaxBidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PayingCoinRates) > 0 {
+		for _, e := range m.PayingCoinRates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinRaiseAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BidderVestingSchedules) > 0 {
+		for _, e := range m.BidderVestingSchedules {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LinearVestingSchedule != nil {
+		l = m.LinearVestingSchedule.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.ClaimMode {
+		n += 3
+	}
+	if m.SealedBidConfig != nil {
+		l = m.SealedBidConfig.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.PartialFillMode != 0 {
+		n += 2 + sovTx(uint64(m.PartialFillMode))
+	}
+	if m.PricingRule != 0 {
+		n += 2 + sovTx(uint64(m.PricingRule))
+	}
+	return n
+}
+
+func (m *MsgCreateBatchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PriceDecayStep.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceDecayPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SellingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PayingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.AuctioneerManagedAllowlist {
+		n += 2
+	}
+	if m.OpenBidding {
+		n += 2
+	}
+	l = m.DefaultMaxBidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PayingCoinRates) > 0 {
+		for _, e := range m.PayingCoinRates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BidderVestingSchedules) > 0 {
+		for _, e := range m.BidderVestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LinearVestingSchedule != nil {
+		l = m.LinearVestingSchedule.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.ClaimMode {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgCreateDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgCancelAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidType != 0 {
+		n += 1 + sovTx(uint64(m.BidType))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovTx(uint64(m.BidId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidId != 0 {
+		n += 1 + sovTx(uint64(m.BidId))
+	}
+	return n
+}
+
+func (m *MsgCancelBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCommitBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommitmentId != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentId))
+	}
+	if m.BidType != 0 {
+		n += 1 + sovTx(uint64(m.BidType))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowedBidders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedBidders) > 0 {
+		for _, e := range m.AllowedBidders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAllowedBiddersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgClaimVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAllowedBidder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxBidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowedBidder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Auctioneer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowedBidder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = m.AllowedBidder.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddAllowedBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveFailedAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.VestingSchedules) > 0 {
-		for _, e := range m.VestingSchedules {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	if m.ForceRefund {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveFailedAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctioneerManagedAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuctioneerManagedAllowlist = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenBidding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OpenBidding = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingBasket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellingBasket = append(m.SellingBasket, types.Coin{})
+			if err := m.SellingBasket[len(m.SellingBasket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinRates = append(m.PayingCoinRates, types.DecCoin{})
+			if err := m.PayingCoinRates[len(m.PayingCoinRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRaiseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRaiseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseWhenSoldOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloseWhenSoldOut = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderVestingSchedules = append(m.BidderVestingSchedules, VestingSchedule{})
+			if err := m.BidderVestingSchedules[len(m.BidderVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVestingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVestingSchedule == nil {
+				m.LinearVestingSchedule = &LinearVestingSchedule{}
+			}
+			if err := m.LinearVestingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBatchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+			}
+			m.MaxExtendedRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctioneerManagedAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuctioneerManagedAllowlist = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenBidding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OpenBidding = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinRates = append(m.PayingCoinRates, types.DecCoin{})
+			if err := m.PayingCoinRates[len(m.PayingCoinRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRaiseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRaiseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderVestingSchedules = append(m.BidderVestingSchedules, VestingSchedule{})
+			if err := m.BidderVestingSchedules[len(m.BidderVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVestingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVestingSchedule == nil {
+				m.LinearVestingSchedule = &LinearVestingSchedule{}
+			}
+			if err := m.LinearVestingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimMode = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SealedBidConfig == nil {
+				m.SealedBidConfig = &SealedBidConfig{}
+			}
+			if err := m.SealedBidConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialFillMode", wireType)
+			}
+			m.PartialFillMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartialFillMode |= PartialFillMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecayStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceDecayStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PriceDecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctioneerManagedAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuctioneerManagedAllowlist = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenBidding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OpenBidding = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultMaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayingCoinRates = append(m.PayingCoinRates, types.DecCoin{})
+			if err := m.PayingCoinRates[len(m.PayingCoinRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderVestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderVestingSchedules = append(m.BidderVestingSchedules, VestingSchedule{})
+			if err := m.BidderVestingSchedules[len(m.BidderVestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVestingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinearVestingSchedule == nil {
+				m.LinearVestingSchedule = &LinearVestingSchedule{}
+			}
+			if err := m.LinearVestingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidType", wireType)
+			}
+			m.BidType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidType |= BidType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			}
+			m.BidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
+			}
+			m.BidId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.MaxExtendedRound != 0 {
-		n += 1 + sovTx(uint64(m.MaxExtendedRound))
-	}
-	l = m.ExtendedRoundRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgCreateBatchAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCancelAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Auctioneer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	return n
-}
-
-func (m *MsgCancelAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgPlaceBid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BidType != 0 {
-		n += 1 + sovTx(uint64(m.BidType))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgPlaceBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgModifyBid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
-	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BidId != 0 {
-		n += 1 + sovTx(uint64(m.BidId))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgModifyBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgAddAllowedBidder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
+func (m *MsgCommitBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.AllowedBidder.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgAddAllowedBidderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,15 +7826,34 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1707,27 +7865,65 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentId", wireType)
+			}
+			m.CommitmentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidType", wireType)
+			}
+			m.BidType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidType |= BidType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Auctioneer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,13 +7951,13 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1788,13 +7984,13 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1822,13 +8018,113 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedBidders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedBidders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedBidders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1838,31 +8134,16 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
-			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1872,28 +8153,27 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1920,7 +8200,8 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowedBidders = append(m.AllowedBidders, AllowedBidder{})
+			if err := m.AllowedBidders[len(m.AllowedBidders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1945,7 +8226,7 @@ func (m *MsgCreateFixedPriceAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAllowedBiddersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1968,10 +8249,10 @@ func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAllowedBiddersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedPriceAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAllowedBiddersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1995,7 +8276,7 @@ func (m *MsgCreateFixedPriceAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,10 +8299,10 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBatchAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2057,10 +8338,10 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			m.Auctioneer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var stringLen uint64
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2070,29 +8351,114 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBidPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2120,15 +8486,13 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellingCoin", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var msglen int
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2138,28 +8502,14 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SellingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2187,47 +8537,113 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
-			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowedBidder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowedBidder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowedBidder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtendedRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			m.MaxExtendedRound = 0
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2237,14 +8653,14 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxExtendedRound |= uint32(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedRoundRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2272,15 +8688,13 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExtendedRoundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Auctioneer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2290,30 +8704,29 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBidAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2323,22 +8736,23 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2363,7 +8777,7 @@ func (m *MsgCreateBatchAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,10 +8800,10 @@ func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAllowedBidderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBatchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2413,7 +8827,7 @@ func (m *MsgCreateBatchAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAllowedBidder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2436,13 +8850,32 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAllowedBidder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAllowedBidder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Auctioneer", wireType)
 			}
@@ -2474,11 +8907,11 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 			}
 			m.Auctioneer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			m.AuctionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2488,11 +8921,24 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2514,7 +8960,7 @@ func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2537,10 +8983,10 @@ func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAllowedBidderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2564,7 +9010,7 @@ func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAllowedBidder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2587,10 +9033,10 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAllowedBidder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAllowedBidder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2614,92 +9060,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidType", wireType)
-			}
-			m.BidType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BidType |= BidType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2726,7 +9087,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedBidder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2751,7 +9112,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2774,10 +9135,10 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAllowedBidderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2801,7 +9162,7 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2824,85 +9185,15 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidId", wireType)
-			}
-			m.BidId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BidId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2930,13 +9221,11 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2963,7 +9252,7 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2988,7 +9277,7 @@ func (m *MsgModifyBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3011,10 +9300,10 @@ func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3038,7 +9327,7 @@ func (m *MsgModifyBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllowedBidder) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveFailedAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3061,13 +9350,45 @@ func (m *MsgAddAllowedBidder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllowedBidder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveFailedAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllowedBidder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveFailedAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
@@ -3086,11 +9407,11 @@ func (m *MsgAddAllowedBidder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidder", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRefund", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3100,25 +9421,12 @@ func (m *MsgAddAllowedBidder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AllowedBidder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ForceRefund = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3140,7 +9448,7 @@ func (m *MsgAddAllowedBidder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllowedBidderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveFailedAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3163,10 +9471,10 @@ func (m *MsgAddAllowedBidderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllowedBidderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveFailedAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllowedBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveFailedAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -47,6 +47,84 @@ func ValidateVestingSchedules(schedules []VestingSchedule, endTime time.Time) er
 	return nil
 }
 
+// ValidateLinearVestingSchedule validates the linear vesting schedule.
+// The schedule is optional, but it cannot be used together with the discrete vesting schedules.
+func ValidateLinearVestingSchedule(schedule *LinearVestingSchedule, vestingSchedules []VestingSchedule, endTime time.Time) error {
+	if schedule == nil {
+		return nil
+	}
+
+	if len(vestingSchedules) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting schedule cannot be used with vesting schedules")
+	}
+
+	if schedule.StartTime.Before(endTime) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting start time must not be before the end time")
+	}
+
+	if !schedule.EndTime.After(schedule.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting end time must be set after the start time")
+	}
+
+	if schedule.HasCliff() && (schedule.CliffTime.Before(schedule.StartTime) || schedule.CliffTime.After(schedule.EndTime)) {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedules, "linear vesting cliff time must be between the start time and the end time")
+	}
+
+	return nil
+}
+
+// HasCliff returns true if the linear vesting schedule has the cliff time.
+func (s LinearVestingSchedule) HasCliff() bool {
+	return !s.CliffTime.IsZero()
+}
+
+// VestedCoins returns the portion of the total coins that is vested at the given time t.
+// Nothing is vested before the cliff time and the amount vested between the start time
+// and the end time grows linearly and is truncated.
+func (s LinearVestingSchedule) VestedCoins(totalCoins sdk.Coins, t time.Time) sdk.Coins {
+	if !t.After(s.StartTime) || (s.HasCliff() && t.Before(s.CliffTime)) {
+		return sdk.Coins{}
+	}
+
+	if !t.Before(s.EndTime) {
+		return totalCoins
+	}
+
+	elapsed := t.Sub(s.StartTime).Nanoseconds()
+	duration := s.EndTime.Sub(s.StartTime).Nanoseconds()
+
+	vestedCoins := sdk.Coins{}
+	for _, coin := range totalCoins {
+		vestedAmt := coin.Amount.MulRaw(elapsed).QuoRaw(duration)
+		vestedCoins = vestedCoins.Add(sdk.NewCoin(coin.Denom, vestedAmt))
+	}
+	return vestedCoins
+}
+
+// NewLinearVesting returns a new LinearVesting.
+func NewLinearVesting(auctionId uint64, auctioneer sdk.AccAddress, totalCoins, claimedCoins sdk.Coins) LinearVesting {
+	return LinearVesting{
+		AuctionId:    auctionId,
+		Auctioneer:   auctioneer.String(),
+		TotalCoins:   totalCoins,
+		ClaimedCoins: claimedCoins,
+	}
+}
+
+// ClaimableCoins returns the vested coins that are not claimed yet at the given time t.
+func (lv LinearVesting) ClaimableCoins(schedule LinearVestingSchedule, t time.Time) sdk.Coins {
+	claimableCoins, hasNeg := schedule.VestedCoins(lv.TotalCoins, t).SafeSub(lv.ClaimedCoins...)
+	if hasNeg {
+		return sdk.Coins{}
+	}
+	return claimableCoins
+}
+
+// UnclaimedCoins returns the coins that are not claimed yet regardless of the vesting schedule.
+func (lv LinearVesting) UnclaimedCoins() sdk.Coins {
+	return lv.TotalCoins.Sub(lv.ClaimedCoins...)
+}
+
 // NewVestingQueue returns a new VestingQueue.
 func NewVestingQueue(auctionId uint64, auctioneer sdk.AccAddress, payingCoin sdk.Coin, releaseTime time.Time, released bool) VestingQueue {
 	return VestingQueue{
@@ -68,3 +146,25 @@ func (vq VestingQueue) ShouldRelease(t time.Time) bool {
 func (vq *VestingQueue) SetReleased(status bool) {
 	vq.Released = status
 }
+
+// NewBidderVestingQueue returns a new BidderVestingQueue.
+func NewBidderVestingQueue(auctionId uint64, bidder sdk.AccAddress, releaseCoins sdk.Coins, releaseTime time.Time, released bool) BidderVestingQueue {
+	return BidderVestingQueue{
+		AuctionId:    auctionId,
+		Bidder:       bidder.String(),
+		ReleaseCoins: releaseCoins,
+		ReleaseTime:  releaseTime,
+		Released:     released,
+	}
+}
+
+// ShouldRelease returns true when the bidder vesting queue is ready to release the selling coin.
+// It checks if the release time is equal or before the given time t and released value is false.
+func (q BidderVestingQueue) ShouldRelease(t time.Time) bool {
+	return !q.GetReleaseTime().After(t) && !q.Released
+}
+
+// SetReleased sets released status of the bidder vesting queue.
+func (q *BidderVestingQueue) SetReleased(status bool) {
+	q.Released = status
+}
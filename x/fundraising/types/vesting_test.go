@@ -149,3 +149,80 @@ func TestSetReleased(t *testing.T) {
 	vestingQueue.SetReleased(true)
 	require.True(t, vestingQueue.Released)
 }
+
+func TestLinearVestingSchedule_VestedCoins(t *testing.T) {
+	totalCoins := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000), sdk.NewInt64Coin("denom2", 333))
+
+	schedule := types.LinearVestingSchedule{
+		StartTime: types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		EndTime:   types.MustParseRFC3339("2022-01-11T00:00:00Z"),
+		CliffTime: types.MustParseRFC3339("2022-01-03T00:00:00Z"),
+	}
+
+	for _, tc := range []struct {
+		name        string
+		schedule    types.LinearVestingSchedule
+		t           time.Time
+		vestedCoins sdk.Coins
+	}{
+		{
+			"before the start time",
+			schedule,
+			types.MustParseRFC3339("2021-12-31T00:00:00Z"),
+			sdk.Coins{},
+		},
+		{
+			"before the cliff time",
+			schedule,
+			types.MustParseRFC3339("2022-01-02T00:00:00Z"),
+			sdk.Coins{},
+		},
+		{
+			"at the cliff time",
+			schedule,
+			types.MustParseRFC3339("2022-01-03T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 200_000), sdk.NewInt64Coin("denom2", 66)),
+		},
+		{
+			"without the cliff time",
+			types.LinearVestingSchedule{StartTime: schedule.StartTime, EndTime: schedule.EndTime},
+			types.MustParseRFC3339("2022-01-02T00:00:00Z"),
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 100_000), sdk.NewInt64Coin("denom2", 33)),
+		},
+		{
+			"at the end time",
+			schedule,
+			types.MustParseRFC3339("2022-01-11T00:00:00Z"),
+			totalCoins,
+		},
+		{
+			"after the end time",
+			schedule,
+			types.MustParseRFC3339("2023-01-01T00:00:00Z"),
+			totalCoins,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.vestedCoins.IsEqual(tc.schedule.VestedCoins(totalCoins, tc.t)))
+		})
+	}
+}
+
+func TestLinearVesting_ClaimableCoins(t *testing.T) {
+	schedule := types.LinearVestingSchedule{
+		StartTime: types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		EndTime:   types.MustParseRFC3339("2022-01-11T00:00:00Z"),
+	}
+
+	vesting := types.NewLinearVesting(
+		1,
+		sdk.AccAddress(crypto.AddressHash([]byte("Auctioneer"))),
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 300_000)),
+	)
+
+	require.True(t, vesting.ClaimableCoins(schedule, types.MustParseRFC3339("2022-01-02T00:00:00Z")).IsZero())
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 200_000)).IsEqual(
+		vesting.ClaimableCoins(schedule, types.MustParseRFC3339("2022-01-06T00:00:00Z"))))
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 700_000)).IsEqual(vesting.UnclaimedCoins()))
+}